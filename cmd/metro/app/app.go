@@ -17,7 +17,10 @@ import (
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/cron"
+	"github.com/iov-one/weave/x/currency"
+	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/paychan"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/utils"
 	"github.com/iov-one/weave/x/validators"
@@ -46,14 +49,17 @@ func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
 		utils.NewSavepoint().OnCheck(),
 		sigs.NewDecorator(),
 		multisig.NewDecorator(authFn),
-		cash.NewFeeDecorator(authFn, CashControl()),
+		// cash.NewDynamicFeeDecorator embeds utils.NewSavepoint().OnDeliver()
+		cash.NewDynamicFeeDecorator(authFn, CashControl()),
+		// minFee is a node local setting, only enforced on CheckTx
+		msgfee.NewAntispamFeeDecorator(minFee),
+		msgfee.NewFeeDecorator(),
 		batch.NewDecorator(),
-		utils.NewSavepoint().OnDeliver(),
 	)
 }
 
 // Router returns a default router
-func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
+func Router(authFn x.Authenticator) *app.Router {
 	r := app.NewRouter()
 
 	cash.RegisterRoutes(r, authFn, CashControl())
@@ -61,12 +67,17 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 	multisig.RegisterRoutes(r, authFn)
 	migration.RegisterRoutes(r, authFn)
 	validators.RegisterRoutes(r, authFn)
-	metro.RegisterRoutes(r, authFn)
+	msgfee.RegisterRoutes(r, authFn)
+	// Currency issuer is part of the metro configuration.
+	metro.RegisterCurrencyRoutes(r, authFn)
+	paychan.RegisterRoutes(r, authFn, CashControl())
+	metro.RegisterRoutes(r, authFn, CashControl(), cron.NewScheduler(CronTaskMarshaler))
 	return r
 }
 
 // QueryRouter returns a default query router,
-// allowing access to "/metro", "/auth", "/contracts", "/wallets", "/validators" and "/"
+// allowing access to "/metro", "/auth", "/contracts", "/wallets", "/validators",
+// "/tokens", "/paychans" and "/"
 func QueryRouter() weave.QueryRouter {
 	r := weave.NewQueryRouter()
 	r.RegisterAll(
@@ -76,6 +87,8 @@ func QueryRouter() weave.QueryRouter {
 		migration.RegisterQuery,
 		orm.RegisterQuery,
 		validators.RegisterQuery,
+		currency.RegisterQuery,
+		paychan.RegisterQuery,
 		metro.RegisterQuery,
 	)
 	return r
@@ -83,9 +96,11 @@ func QueryRouter() weave.QueryRouter {
 
 // Stack wires up a standard router with a standard decorator
 // chain. This can be passed into BaseApp.
-func Stack(issuer weave.Address, minFee coin.Coin) weave.Handler {
+// minFee is the minimal fee this node requires to accept a transaction into
+// its mempool.
+func Stack(minFee coin.Coin) weave.Handler {
 	authFn := Authenticator()
-	return Chain(authFn, minFee).WithHandler(Router(authFn, issuer))
+	return Chain(authFn, minFee).WithHandler(Router(authFn))
 }
 
 // CronStack wires up a standard router with a cron specific decorator chain.
@@ -96,6 +111,9 @@ func Stack(issuer weave.Address, minFee coin.Coin) weave.Handler {
 func CronStack() weave.Handler {
 	rt := app.NewRouter()
 
+	authFn := cron.Authenticator{}
+	metro.RegisterCronRoutes(rt, authFn, CashControl())
+
 	decorators := app.ChainDecorators(
 		utils.NewLogging(),
 		utils.NewRecovery(),
@@ -0,0 +1,200 @@
+package metro
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
+	"github.com/iov-one/weave/x/msgfee"
+	"github.com/iov-one/weave/x/sigs"
+	"github.com/orkunkl/metro-app/x/metro"
+)
+
+func TestChainMsgFee(t *testing.T) {
+	cases := map[string]struct {
+		fee     coin.Coin
+		wantErr *errors.Error
+	}{
+		"message fee is paid": {
+			fee: coin.NewCoin(1, 0, "METR"),
+		},
+		"fee is below the message fee": {
+			fee:     coin.NewCoin(0, 500000000, "METR"),
+			wantErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			key := crypto.GenPrivKeyEd25519()
+			db := newFeeTestStore(t, key)
+			if _, err := msgfee.NewMsgFeeBucket().Put(db, []byte("metro/register_passenger"), &msgfee.MsgFee{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "metro/register_passenger",
+				Fee:      coin.NewCoin(1, 0, "METR"),
+			}); err != nil {
+				t.Fatalf("cannot set message fee: %s", err)
+			}
+
+			stack := Chain(Authenticator(), coin.Coin{}).WithHandler(&weavetest.Handler{})
+			_, err := stack.Deliver(feeTestContext(), db, signedFeeTx(t, key, tc.fee))
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("want %v error, got %+v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			assertCollected(t, db, tc.fee)
+		})
+	}
+}
+
+func TestChainMinFee(t *testing.T) {
+	minFee := coin.NewCoin(0, 100000000, "METR")
+
+	cases := map[string]struct {
+		fee          coin.Coin
+		wantCheckErr *errors.Error
+	}{
+		"fee matches the node minimum": {
+			fee: minFee,
+		},
+		"fee is above the node minimum": {
+			fee: coin.NewCoin(1, 0, "METR"),
+		},
+		"fee is below the node minimum": {
+			fee:          coin.NewCoin(0, 1000, "METR"),
+			wantCheckErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			key := crypto.GenPrivKeyEd25519()
+			stack := Chain(Authenticator(), minFee).WithHandler(&weavetest.Handler{})
+			tx := signedFeeTx(t, key, tc.fee)
+
+			mempool := newFeeTestStore(t, key)
+			if _, err := stack.Check(feeTestContext(), mempool, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("want %v check error, got %+v", tc.wantCheckErr, err)
+			}
+
+			// The node minimum is not part of the consensus rules, a
+			// block containing such transaction must still be accepted.
+			db := newFeeTestStore(t, key)
+			if _, err := stack.Deliver(feeTestContext(), db, tx); err != nil {
+				t.Fatalf("cannot deliver: %+v", err)
+			}
+			assertCollected(t, db, tc.fee)
+		})
+	}
+}
+
+func TestRouterCurrencyIssuer(t *testing.T) {
+	issuer := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		signer  weave.Condition
+		wantErr *errors.Error
+	}{
+		"issuer registers a token": {
+			signer: issuer,
+		},
+		"signer is not the issuer": {
+			signer:  weavetest.NewCondition(),
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "currency")
+			if err := gconf.Save(db, "metro", &metro.Configuration{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Admin:          weavetest.NewCondition().Address(),
+				CurrencyIssuer: issuer.Address(),
+			}); err != nil {
+				t.Fatalf("cannot save metro configuration: %s", err)
+			}
+
+			router := Router(&weavetest.Auth{Signer: tc.signer})
+			_, err := router.Deliver(context.Background(), db, &weavetest.Tx{Msg: &currency.CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "FARE",
+				Name:     "fare credit",
+			}})
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("want %v error, got %+v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+// newFeeTestStore returns a store with the fee collector configured and the
+// account of given key funded.
+func newFeeTestStore(t testing.TB, key *crypto.PrivateKey) weave.KVStore {
+	t.Helper()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash", "msgfee", "sigs")
+	if err := gconf.Save(db, "cash", &cash.Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		CollectorAddress: feeCollector,
+	}); err != nil {
+		t.Fatalf("cannot save cash configuration: %s", err)
+	}
+	payer := key.PublicKey().Condition().Address()
+	if err := cash.NewController(cash.NewBucket()).CoinMint(db, payer, coin.NewCoin(10, 0, "METR")); err != nil {
+		t.Fatalf("cannot fund payer: %s", err)
+	}
+	return db
+}
+
+var feeCollector = weavetest.NewCondition().Address()
+
+func feeTestContext() weave.Context {
+	ctx := weave.WithHeight(context.Background(), 1)
+	return weave.WithChainID(ctx, "metro-test")
+}
+
+// signedFeeTx returns a passenger registration transaction paying given fee,
+// signed by the key owner.
+func signedFeeTx(t testing.TB, key *crypto.PrivateKey, fee coin.Coin) *Tx {
+	t.Helper()
+
+	tx := &Tx{
+		Fees: &cash.FeeInfo{Fees: &fee},
+		Sum: &Tx_MetroRegisterPassengerMsg{
+			MetroRegisterPassengerMsg: &metro.RegisterPassengerMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+		},
+	}
+	sig, err := sigs.SignTx(key, tx, "metro-test", 0)
+	if err != nil {
+		t.Fatalf("cannot sign transaction: %s", err)
+	}
+	tx.Signatures = append(tx.Signatures, sig)
+	return tx
+}
+
+func assertCollected(t testing.TB, db weave.KVStore, want coin.Coin) {
+	t.Helper()
+	coins, err := CashControl().Balance(db, feeCollector)
+	if err != nil {
+		t.Fatalf("cannot get collector balance: %s", err)
+	}
+	if !coins.Equals(coin.Coins{&want}) {
+		t.Fatalf("want %v collected, got %v", want, coins)
+	}
+}
@@ -10,8 +10,11 @@ import (
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	migration "github.com/iov-one/weave/migration"
 	cash "github.com/iov-one/weave/x/cash"
+	currency "github.com/iov-one/weave/x/currency"
 	escrow "github.com/iov-one/weave/x/escrow"
+	msgfee "github.com/iov-one/weave/x/msgfee"
 	multisig "github.com/iov-one/weave/x/multisig"
+	paychan "github.com/iov-one/weave/x/paychan"
 	sigs "github.com/iov-one/weave/x/sigs"
 	validators "github.com/iov-one/weave/x/validators"
 	metro "github.com/orkunkl/metro-app/x/metro"
@@ -55,10 +58,46 @@ type Tx struct {
 	//	*Tx_MultisigCreateMsg
 	//	*Tx_MultisigUpdateMsg
 	//	*Tx_ValidatorsApplyDiffMsg
+	//	*Tx_CurrencyCreateMsg
 	//	*Tx_ExecuteBatchMsg
+	//	*Tx_PaychanCreateMsg
+	//	*Tx_PaychanTransferMsg
+	//	*Tx_PaychanCloseMsg
 	//	*Tx_MigrationUpgradeSchemaMsg
 	//	*Tx_MetroRegisterPassengerMsg
 	//	*Tx_MetroTrainArriveStationEventMsg
+	//	*Tx_MetroCreateLineMsg
+	//	*Tx_MetroUpdateLineMsg
+	//	*Tx_MetroTapInMsg
+	//	*Tx_MetroTapOutMsg
+	//	*Tx_MetroUpdateFareTableMsg
+	//	*Tx_MetroSetPassengerCategoryMsg
+	//	*Tx_MetroPayFareMsg
+	//	*Tx_MetroUpdatePassengerMsg
+	//	*Tx_MetroDeregisterPassengerMsg
+	//	*Tx_MetroCreateStationMsg
+	//	*Tx_MetroUpdateStationMsg
+	//	*Tx_MetroRetireStationMsg
+	//	*Tx_MetroRegisterTrainMsg
+	//	*Tx_MetroReassignTrainMsg
+	//	*Tx_MetroDecommissionTrainMsg
+	//	*Tx_MetroReportEquipmentStatusMsg
+	//	*Tx_MetroSetConnectionMsg
+	//	*Tx_MetroPublishTimetableMsg
+	//	*Tx_MetroSupersedeTimetableMsg
+	//	*Tx_MetroScheduleStopMsg
+	//	*Tx_MetroTrainDepartStationEventMsg
+	//	*Tx_MetroOpenIncidentMsg
+	//	*Tx_MetroUpdateIncidentMsg
+	//	*Tx_MetroResolveIncidentMsg
+	//	*Tx_MetroScheduleFareChangeMsg
+	//	*Tx_MetroStartJourneyMsg
+	//	*Tx_MetroEndJourneyMsg
+	//	*Tx_MsgfeeSetMsgFeeMsg
+	//	*Tx_MetroPurchasePassMsg
+	//	*Tx_MetroCancelPassMsg
+	//	*Tx_MsgfeeUpdateConfigurationMsg
+	//	*Tx_MetroReleasePassMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -113,9 +152,21 @@ type Tx_MultisigUpdateMsg struct {
 type Tx_ValidatorsApplyDiffMsg struct {
 	ValidatorsApplyDiffMsg *validators.ApplyDiffMsg `protobuf:"bytes,58,opt,name=validators_apply_diff_msg,json=validatorsApplyDiffMsg,proto3,oneof"`
 }
+type Tx_CurrencyCreateMsg struct {
+	CurrencyCreateMsg *currency.CreateMsg `protobuf:"bytes,59,opt,name=currency_create_msg,json=currencyCreateMsg,proto3,oneof"`
+}
 type Tx_ExecuteBatchMsg struct {
 	ExecuteBatchMsg *ExecuteBatchMsg `protobuf:"bytes,60,opt,name=execute_batch_msg,json=executeBatchMsg,proto3,oneof"`
 }
+type Tx_PaychanCreateMsg struct {
+	PaychanCreateMsg *paychan.CreateMsg `protobuf:"bytes,62,opt,name=paychan_create_msg,json=paychanCreateMsg,proto3,oneof"`
+}
+type Tx_PaychanTransferMsg struct {
+	PaychanTransferMsg *paychan.TransferMsg `protobuf:"bytes,63,opt,name=paychan_transfer_msg,json=paychanTransferMsg,proto3,oneof"`
+}
+type Tx_PaychanCloseMsg struct {
+	PaychanCloseMsg *paychan.CloseMsg `protobuf:"bytes,64,opt,name=paychan_close_msg,json=paychanCloseMsg,proto3,oneof"`
+}
 type Tx_MigrationUpgradeSchemaMsg struct {
 	MigrationUpgradeSchemaMsg *migration.UpgradeSchemaMsg `protobuf:"bytes,69,opt,name=migration_upgrade_schema_msg,json=migrationUpgradeSchemaMsg,proto3,oneof"`
 }
@@ -125,15 +176,147 @@ type Tx_MetroRegisterPassengerMsg struct {
 type Tx_MetroTrainArriveStationEventMsg struct {
 	MetroTrainArriveStationEventMsg *metro.TrainArriveStationEventMsg `protobuf:"bytes,71,opt,name=metro_train_arrive_station_event_msg,json=metroTrainArriveStationEventMsg,proto3,oneof"`
 }
+type Tx_MetroCreateLineMsg struct {
+	MetroCreateLineMsg *metro.CreateLineMsg `protobuf:"bytes,72,opt,name=metro_create_line_msg,json=metroCreateLineMsg,proto3,oneof"`
+}
+type Tx_MetroUpdateLineMsg struct {
+	MetroUpdateLineMsg *metro.UpdateLineMsg `protobuf:"bytes,73,opt,name=metro_update_line_msg,json=metroUpdateLineMsg,proto3,oneof"`
+}
+type Tx_MetroTapInMsg struct {
+	MetroTapInMsg *metro.TapInMsg `protobuf:"bytes,74,opt,name=metro_tap_in_msg,json=metroTapInMsg,proto3,oneof"`
+}
+type Tx_MetroTapOutMsg struct {
+	MetroTapOutMsg *metro.TapOutMsg `protobuf:"bytes,75,opt,name=metro_tap_out_msg,json=metroTapOutMsg,proto3,oneof"`
+}
+type Tx_MetroUpdateFareTableMsg struct {
+	MetroUpdateFareTableMsg *metro.UpdateFareTableMsg `protobuf:"bytes,76,opt,name=metro_update_fare_table_msg,json=metroUpdateFareTableMsg,proto3,oneof"`
+}
+type Tx_MetroSetPassengerCategoryMsg struct {
+	MetroSetPassengerCategoryMsg *metro.SetPassengerCategoryMsg `protobuf:"bytes,77,opt,name=metro_set_passenger_category_msg,json=metroSetPassengerCategoryMsg,proto3,oneof"`
+}
+type Tx_MetroPayFareMsg struct {
+	MetroPayFareMsg *metro.PayFareMsg `protobuf:"bytes,78,opt,name=metro_pay_fare_msg,json=metroPayFareMsg,proto3,oneof"`
+}
+type Tx_MetroUpdatePassengerMsg struct {
+	MetroUpdatePassengerMsg *metro.UpdatePassengerMsg `protobuf:"bytes,79,opt,name=metro_update_passenger_msg,json=metroUpdatePassengerMsg,proto3,oneof"`
+}
+type Tx_MetroDeregisterPassengerMsg struct {
+	MetroDeregisterPassengerMsg *metro.DeregisterPassengerMsg `protobuf:"bytes,80,opt,name=metro_deregister_passenger_msg,json=metroDeregisterPassengerMsg,proto3,oneof"`
+}
+type Tx_MetroCreateStationMsg struct {
+	MetroCreateStationMsg *metro.CreateStationMsg `protobuf:"bytes,81,opt,name=metro_create_station_msg,json=metroCreateStationMsg,proto3,oneof"`
+}
+type Tx_MetroUpdateStationMsg struct {
+	MetroUpdateStationMsg *metro.UpdateStationMsg `protobuf:"bytes,82,opt,name=metro_update_station_msg,json=metroUpdateStationMsg,proto3,oneof"`
+}
+type Tx_MetroRetireStationMsg struct {
+	MetroRetireStationMsg *metro.RetireStationMsg `protobuf:"bytes,83,opt,name=metro_retire_station_msg,json=metroRetireStationMsg,proto3,oneof"`
+}
+type Tx_MetroRegisterTrainMsg struct {
+	MetroRegisterTrainMsg *metro.RegisterTrainMsg `protobuf:"bytes,84,opt,name=metro_register_train_msg,json=metroRegisterTrainMsg,proto3,oneof"`
+}
+type Tx_MetroReassignTrainMsg struct {
+	MetroReassignTrainMsg *metro.ReassignTrainMsg `protobuf:"bytes,85,opt,name=metro_reassign_train_msg,json=metroReassignTrainMsg,proto3,oneof"`
+}
+type Tx_MetroDecommissionTrainMsg struct {
+	MetroDecommissionTrainMsg *metro.DecommissionTrainMsg `protobuf:"bytes,86,opt,name=metro_decommission_train_msg,json=metroDecommissionTrainMsg,proto3,oneof"`
+}
+type Tx_MetroReportEquipmentStatusMsg struct {
+	MetroReportEquipmentStatusMsg *metro.ReportEquipmentStatusMsg `protobuf:"bytes,87,opt,name=metro_report_equipment_status_msg,json=metroReportEquipmentStatusMsg,proto3,oneof"`
+}
+type Tx_MetroSetConnectionMsg struct {
+	MetroSetConnectionMsg *metro.SetConnectionMsg `protobuf:"bytes,88,opt,name=metro_set_connection_msg,json=metroSetConnectionMsg,proto3,oneof"`
+}
+type Tx_MetroPublishTimetableMsg struct {
+	MetroPublishTimetableMsg *metro.PublishTimetableMsg `protobuf:"bytes,89,opt,name=metro_publish_timetable_msg,json=metroPublishTimetableMsg,proto3,oneof"`
+}
+type Tx_MetroSupersedeTimetableMsg struct {
+	MetroSupersedeTimetableMsg *metro.SupersedeTimetableMsg `protobuf:"bytes,90,opt,name=metro_supersede_timetable_msg,json=metroSupersedeTimetableMsg,proto3,oneof"`
+}
+type Tx_MetroScheduleStopMsg struct {
+	MetroScheduleStopMsg *metro.ScheduleStopMsg `protobuf:"bytes,91,opt,name=metro_schedule_stop_msg,json=metroScheduleStopMsg,proto3,oneof"`
+}
+type Tx_MetroTrainDepartStationEventMsg struct {
+	MetroTrainDepartStationEventMsg *metro.TrainDepartStationEventMsg `protobuf:"bytes,92,opt,name=metro_train_depart_station_event_msg,json=metroTrainDepartStationEventMsg,proto3,oneof"`
+}
+type Tx_MetroOpenIncidentMsg struct {
+	MetroOpenIncidentMsg *metro.OpenIncidentMsg `protobuf:"bytes,93,opt,name=metro_open_incident_msg,json=metroOpenIncidentMsg,proto3,oneof"`
+}
+type Tx_MetroUpdateIncidentMsg struct {
+	MetroUpdateIncidentMsg *metro.UpdateIncidentMsg `protobuf:"bytes,94,opt,name=metro_update_incident_msg,json=metroUpdateIncidentMsg,proto3,oneof"`
+}
+type Tx_MetroResolveIncidentMsg struct {
+	MetroResolveIncidentMsg *metro.ResolveIncidentMsg `protobuf:"bytes,95,opt,name=metro_resolve_incident_msg,json=metroResolveIncidentMsg,proto3,oneof"`
+}
+type Tx_MetroScheduleFareChangeMsg struct {
+	MetroScheduleFareChangeMsg *metro.ScheduleFareChangeMsg `protobuf:"bytes,97,opt,name=metro_schedule_fare_change_msg,json=metroScheduleFareChangeMsg,proto3,oneof"`
+}
+type Tx_MetroStartJourneyMsg struct {
+	MetroStartJourneyMsg *metro.StartJourneyMsg `protobuf:"bytes,99,opt,name=metro_start_journey_msg,json=metroStartJourneyMsg,proto3,oneof"`
+}
+type Tx_MetroEndJourneyMsg struct {
+	MetroEndJourneyMsg *metro.EndJourneyMsg `protobuf:"bytes,100,opt,name=metro_end_journey_msg,json=metroEndJourneyMsg,proto3,oneof"`
+}
+type Tx_MsgfeeSetMsgFeeMsg struct {
+	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,102,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
+}
+type Tx_MetroPurchasePassMsg struct {
+	MetroPurchasePassMsg *metro.PurchasePassMsg `protobuf:"bytes,103,opt,name=metro_purchase_pass_msg,json=metroPurchasePassMsg,proto3,oneof"`
+}
+type Tx_MetroCancelPassMsg struct {
+	MetroCancelPassMsg *metro.CancelPassMsg `protobuf:"bytes,104,opt,name=metro_cancel_pass_msg,json=metroCancelPassMsg,proto3,oneof"`
+}
+type Tx_MsgfeeUpdateConfigurationMsg struct {
+	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_MetroReleasePassMsg struct {
+	MetroReleasePassMsg *metro.ReleasePassMsg `protobuf:"bytes,106,opt,name=metro_release_pass_msg,json=metroReleasePassMsg,proto3,oneof"`
+}
 
 func (*Tx_CashSendMsg) isTx_Sum()                     {}
 func (*Tx_MultisigCreateMsg) isTx_Sum()               {}
 func (*Tx_MultisigUpdateMsg) isTx_Sum()               {}
 func (*Tx_ValidatorsApplyDiffMsg) isTx_Sum()          {}
+func (*Tx_CurrencyCreateMsg) isTx_Sum()               {}
 func (*Tx_ExecuteBatchMsg) isTx_Sum()                 {}
+func (*Tx_PaychanCreateMsg) isTx_Sum()                {}
+func (*Tx_PaychanTransferMsg) isTx_Sum()              {}
+func (*Tx_PaychanCloseMsg) isTx_Sum()                 {}
 func (*Tx_MigrationUpgradeSchemaMsg) isTx_Sum()       {}
 func (*Tx_MetroRegisterPassengerMsg) isTx_Sum()       {}
 func (*Tx_MetroTrainArriveStationEventMsg) isTx_Sum() {}
+func (*Tx_MetroCreateLineMsg) isTx_Sum()              {}
+func (*Tx_MetroUpdateLineMsg) isTx_Sum()              {}
+func (*Tx_MetroTapInMsg) isTx_Sum()                   {}
+func (*Tx_MetroTapOutMsg) isTx_Sum()                  {}
+func (*Tx_MetroUpdateFareTableMsg) isTx_Sum()         {}
+func (*Tx_MetroSetPassengerCategoryMsg) isTx_Sum()    {}
+func (*Tx_MetroPayFareMsg) isTx_Sum()                 {}
+func (*Tx_MetroUpdatePassengerMsg) isTx_Sum()         {}
+func (*Tx_MetroDeregisterPassengerMsg) isTx_Sum()     {}
+func (*Tx_MetroCreateStationMsg) isTx_Sum()           {}
+func (*Tx_MetroUpdateStationMsg) isTx_Sum()           {}
+func (*Tx_MetroRetireStationMsg) isTx_Sum()           {}
+func (*Tx_MetroRegisterTrainMsg) isTx_Sum()           {}
+func (*Tx_MetroReassignTrainMsg) isTx_Sum()           {}
+func (*Tx_MetroDecommissionTrainMsg) isTx_Sum()       {}
+func (*Tx_MetroReportEquipmentStatusMsg) isTx_Sum()   {}
+func (*Tx_MetroSetConnectionMsg) isTx_Sum()           {}
+func (*Tx_MetroPublishTimetableMsg) isTx_Sum()        {}
+func (*Tx_MetroSupersedeTimetableMsg) isTx_Sum()      {}
+func (*Tx_MetroScheduleStopMsg) isTx_Sum()            {}
+func (*Tx_MetroTrainDepartStationEventMsg) isTx_Sum() {}
+func (*Tx_MetroOpenIncidentMsg) isTx_Sum()            {}
+func (*Tx_MetroUpdateIncidentMsg) isTx_Sum()          {}
+func (*Tx_MetroResolveIncidentMsg) isTx_Sum()         {}
+func (*Tx_MetroScheduleFareChangeMsg) isTx_Sum()      {}
+func (*Tx_MetroStartJourneyMsg) isTx_Sum()            {}
+func (*Tx_MetroEndJourneyMsg) isTx_Sum()              {}
+func (*Tx_MsgfeeSetMsgFeeMsg) isTx_Sum()              {}
+func (*Tx_MetroPurchasePassMsg) isTx_Sum()            {}
+func (*Tx_MetroCancelPassMsg) isTx_Sum()              {}
+func (*Tx_MsgfeeUpdateConfigurationMsg) isTx_Sum()    {}
+func (*Tx_MetroReleasePassMsg) isTx_Sum()             {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -191,6 +374,13 @@ func (m *Tx) GetValidatorsApplyDiffMsg() *validators.ApplyDiffMsg {
 	return nil
 }
 
+func (m *Tx) GetCurrencyCreateMsg() *currency.CreateMsg {
+	if x, ok := m.GetSum().(*Tx_CurrencyCreateMsg); ok {
+		return x.CurrencyCreateMsg
+	}
+	return nil
+}
+
 func (m *Tx) GetExecuteBatchMsg() *ExecuteBatchMsg {
 	if x, ok := m.GetSum().(*Tx_ExecuteBatchMsg); ok {
 		return x.ExecuteBatchMsg
@@ -198,6 +388,27 @@ func (m *Tx) GetExecuteBatchMsg() *ExecuteBatchMsg {
 	return nil
 }
 
+func (m *Tx) GetPaychanCreateMsg() *paychan.CreateMsg {
+	if x, ok := m.GetSum().(*Tx_PaychanCreateMsg); ok {
+		return x.PaychanCreateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetPaychanTransferMsg() *paychan.TransferMsg {
+	if x, ok := m.GetSum().(*Tx_PaychanTransferMsg); ok {
+		return x.PaychanTransferMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetPaychanCloseMsg() *paychan.CloseMsg {
+	if x, ok := m.GetSum().(*Tx_PaychanCloseMsg); ok {
+		return x.PaychanCloseMsg
+	}
+	return nil
+}
+
 func (m *Tx) GetMigrationUpgradeSchemaMsg() *migration.UpgradeSchemaMsg {
 	if x, ok := m.GetSum().(*Tx_MigrationUpgradeSchemaMsg); ok {
 		return x.MigrationUpgradeSchemaMsg
@@ -219,6 +430,230 @@ func (m *Tx) GetMetroTrainArriveStationEventMsg() *metro.TrainArriveStationEvent
 	return nil
 }
 
+func (m *Tx) GetMetroCreateLineMsg() *metro.CreateLineMsg {
+	if x, ok := m.GetSum().(*Tx_MetroCreateLineMsg); ok {
+		return x.MetroCreateLineMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroUpdateLineMsg() *metro.UpdateLineMsg {
+	if x, ok := m.GetSum().(*Tx_MetroUpdateLineMsg); ok {
+		return x.MetroUpdateLineMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroTapInMsg() *metro.TapInMsg {
+	if x, ok := m.GetSum().(*Tx_MetroTapInMsg); ok {
+		return x.MetroTapInMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroTapOutMsg() *metro.TapOutMsg {
+	if x, ok := m.GetSum().(*Tx_MetroTapOutMsg); ok {
+		return x.MetroTapOutMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroUpdateFareTableMsg() *metro.UpdateFareTableMsg {
+	if x, ok := m.GetSum().(*Tx_MetroUpdateFareTableMsg); ok {
+		return x.MetroUpdateFareTableMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroSetPassengerCategoryMsg() *metro.SetPassengerCategoryMsg {
+	if x, ok := m.GetSum().(*Tx_MetroSetPassengerCategoryMsg); ok {
+		return x.MetroSetPassengerCategoryMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroPayFareMsg() *metro.PayFareMsg {
+	if x, ok := m.GetSum().(*Tx_MetroPayFareMsg); ok {
+		return x.MetroPayFareMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroUpdatePassengerMsg() *metro.UpdatePassengerMsg {
+	if x, ok := m.GetSum().(*Tx_MetroUpdatePassengerMsg); ok {
+		return x.MetroUpdatePassengerMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroDeregisterPassengerMsg() *metro.DeregisterPassengerMsg {
+	if x, ok := m.GetSum().(*Tx_MetroDeregisterPassengerMsg); ok {
+		return x.MetroDeregisterPassengerMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroCreateStationMsg() *metro.CreateStationMsg {
+	if x, ok := m.GetSum().(*Tx_MetroCreateStationMsg); ok {
+		return x.MetroCreateStationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroUpdateStationMsg() *metro.UpdateStationMsg {
+	if x, ok := m.GetSum().(*Tx_MetroUpdateStationMsg); ok {
+		return x.MetroUpdateStationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroRetireStationMsg() *metro.RetireStationMsg {
+	if x, ok := m.GetSum().(*Tx_MetroRetireStationMsg); ok {
+		return x.MetroRetireStationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroRegisterTrainMsg() *metro.RegisterTrainMsg {
+	if x, ok := m.GetSum().(*Tx_MetroRegisterTrainMsg); ok {
+		return x.MetroRegisterTrainMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroReassignTrainMsg() *metro.ReassignTrainMsg {
+	if x, ok := m.GetSum().(*Tx_MetroReassignTrainMsg); ok {
+		return x.MetroReassignTrainMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroDecommissionTrainMsg() *metro.DecommissionTrainMsg {
+	if x, ok := m.GetSum().(*Tx_MetroDecommissionTrainMsg); ok {
+		return x.MetroDecommissionTrainMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroReportEquipmentStatusMsg() *metro.ReportEquipmentStatusMsg {
+	if x, ok := m.GetSum().(*Tx_MetroReportEquipmentStatusMsg); ok {
+		return x.MetroReportEquipmentStatusMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroSetConnectionMsg() *metro.SetConnectionMsg {
+	if x, ok := m.GetSum().(*Tx_MetroSetConnectionMsg); ok {
+		return x.MetroSetConnectionMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroPublishTimetableMsg() *metro.PublishTimetableMsg {
+	if x, ok := m.GetSum().(*Tx_MetroPublishTimetableMsg); ok {
+		return x.MetroPublishTimetableMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroSupersedeTimetableMsg() *metro.SupersedeTimetableMsg {
+	if x, ok := m.GetSum().(*Tx_MetroSupersedeTimetableMsg); ok {
+		return x.MetroSupersedeTimetableMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroScheduleStopMsg() *metro.ScheduleStopMsg {
+	if x, ok := m.GetSum().(*Tx_MetroScheduleStopMsg); ok {
+		return x.MetroScheduleStopMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroTrainDepartStationEventMsg() *metro.TrainDepartStationEventMsg {
+	if x, ok := m.GetSum().(*Tx_MetroTrainDepartStationEventMsg); ok {
+		return x.MetroTrainDepartStationEventMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroOpenIncidentMsg() *metro.OpenIncidentMsg {
+	if x, ok := m.GetSum().(*Tx_MetroOpenIncidentMsg); ok {
+		return x.MetroOpenIncidentMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroUpdateIncidentMsg() *metro.UpdateIncidentMsg {
+	if x, ok := m.GetSum().(*Tx_MetroUpdateIncidentMsg); ok {
+		return x.MetroUpdateIncidentMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroResolveIncidentMsg() *metro.ResolveIncidentMsg {
+	if x, ok := m.GetSum().(*Tx_MetroResolveIncidentMsg); ok {
+		return x.MetroResolveIncidentMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroScheduleFareChangeMsg() *metro.ScheduleFareChangeMsg {
+	if x, ok := m.GetSum().(*Tx_MetroScheduleFareChangeMsg); ok {
+		return x.MetroScheduleFareChangeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroStartJourneyMsg() *metro.StartJourneyMsg {
+	if x, ok := m.GetSum().(*Tx_MetroStartJourneyMsg); ok {
+		return x.MetroStartJourneyMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroEndJourneyMsg() *metro.EndJourneyMsg {
+	if x, ok := m.GetSum().(*Tx_MetroEndJourneyMsg); ok {
+		return x.MetroEndJourneyMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFeeMsg {
+	if x, ok := m.GetSum().(*Tx_MsgfeeSetMsgFeeMsg); ok {
+		return x.MsgfeeSetMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroPurchasePassMsg() *metro.PurchasePassMsg {
+	if x, ok := m.GetSum().(*Tx_MetroPurchasePassMsg); ok {
+		return x.MetroPurchasePassMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroCancelPassMsg() *metro.CancelPassMsg {
+	if x, ok := m.GetSum().(*Tx_MetroCancelPassMsg); ok {
+		return x.MetroCancelPassMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_MsgfeeUpdateConfigurationMsg); ok {
+		return x.MsgfeeUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMetroReleasePassMsg() *metro.ReleasePassMsg {
+	if x, ok := m.GetSum().(*Tx_MetroReleasePassMsg); ok {
+		return x.MetroReleasePassMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -226,10 +661,46 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_MultisigCreateMsg)(nil),
 		(*Tx_MultisigUpdateMsg)(nil),
 		(*Tx_ValidatorsApplyDiffMsg)(nil),
+		(*Tx_CurrencyCreateMsg)(nil),
 		(*Tx_ExecuteBatchMsg)(nil),
+		(*Tx_PaychanCreateMsg)(nil),
+		(*Tx_PaychanTransferMsg)(nil),
+		(*Tx_PaychanCloseMsg)(nil),
 		(*Tx_MigrationUpgradeSchemaMsg)(nil),
 		(*Tx_MetroRegisterPassengerMsg)(nil),
 		(*Tx_MetroTrainArriveStationEventMsg)(nil),
+		(*Tx_MetroCreateLineMsg)(nil),
+		(*Tx_MetroUpdateLineMsg)(nil),
+		(*Tx_MetroTapInMsg)(nil),
+		(*Tx_MetroTapOutMsg)(nil),
+		(*Tx_MetroUpdateFareTableMsg)(nil),
+		(*Tx_MetroSetPassengerCategoryMsg)(nil),
+		(*Tx_MetroPayFareMsg)(nil),
+		(*Tx_MetroUpdatePassengerMsg)(nil),
+		(*Tx_MetroDeregisterPassengerMsg)(nil),
+		(*Tx_MetroCreateStationMsg)(nil),
+		(*Tx_MetroUpdateStationMsg)(nil),
+		(*Tx_MetroRetireStationMsg)(nil),
+		(*Tx_MetroRegisterTrainMsg)(nil),
+		(*Tx_MetroReassignTrainMsg)(nil),
+		(*Tx_MetroDecommissionTrainMsg)(nil),
+		(*Tx_MetroReportEquipmentStatusMsg)(nil),
+		(*Tx_MetroSetConnectionMsg)(nil),
+		(*Tx_MetroPublishTimetableMsg)(nil),
+		(*Tx_MetroSupersedeTimetableMsg)(nil),
+		(*Tx_MetroScheduleStopMsg)(nil),
+		(*Tx_MetroTrainDepartStationEventMsg)(nil),
+		(*Tx_MetroOpenIncidentMsg)(nil),
+		(*Tx_MetroUpdateIncidentMsg)(nil),
+		(*Tx_MetroResolveIncidentMsg)(nil),
+		(*Tx_MetroScheduleFareChangeMsg)(nil),
+		(*Tx_MetroStartJourneyMsg)(nil),
+		(*Tx_MetroEndJourneyMsg)(nil),
+		(*Tx_MsgfeeSetMsgFeeMsg)(nil),
+		(*Tx_MetroPurchasePassMsg)(nil),
+		(*Tx_MetroCancelPassMsg)(nil),
+		(*Tx_MsgfeeUpdateConfigurationMsg)(nil),
+		(*Tx_MetroReleasePassMsg)(nil),
 	}
 }
 
@@ -257,11 +728,31 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.ValidatorsApplyDiffMsg); err != nil {
 			return err
 		}
+	case *Tx_CurrencyCreateMsg:
+		_ = b.EncodeVarint(59<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyCreateMsg); err != nil {
+			return err
+		}
 	case *Tx_ExecuteBatchMsg:
 		_ = b.EncodeVarint(60<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.ExecuteBatchMsg); err != nil {
 			return err
 		}
+	case *Tx_PaychanCreateMsg:
+		_ = b.EncodeVarint(62<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanCreateMsg); err != nil {
+			return err
+		}
+	case *Tx_PaychanTransferMsg:
+		_ = b.EncodeVarint(63<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanTransferMsg); err != nil {
+			return err
+		}
+	case *Tx_PaychanCloseMsg:
+		_ = b.EncodeVarint(64<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanCloseMsg); err != nil {
+			return err
+		}
 	case *Tx_MigrationUpgradeSchemaMsg:
 		_ = b.EncodeVarint(69<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.MigrationUpgradeSchemaMsg); err != nil {
@@ -277,6 +768,166 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MetroTrainArriveStationEventMsg); err != nil {
 			return err
 		}
+	case *Tx_MetroCreateLineMsg:
+		_ = b.EncodeVarint(72<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroCreateLineMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroUpdateLineMsg:
+		_ = b.EncodeVarint(73<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroUpdateLineMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroTapInMsg:
+		_ = b.EncodeVarint(74<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroTapInMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroTapOutMsg:
+		_ = b.EncodeVarint(75<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroTapOutMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroUpdateFareTableMsg:
+		_ = b.EncodeVarint(76<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroUpdateFareTableMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroSetPassengerCategoryMsg:
+		_ = b.EncodeVarint(77<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroSetPassengerCategoryMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroPayFareMsg:
+		_ = b.EncodeVarint(78<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroPayFareMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroUpdatePassengerMsg:
+		_ = b.EncodeVarint(79<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroUpdatePassengerMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroDeregisterPassengerMsg:
+		_ = b.EncodeVarint(80<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroDeregisterPassengerMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroCreateStationMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroCreateStationMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroUpdateStationMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroUpdateStationMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroRetireStationMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroRetireStationMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroRegisterTrainMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroRegisterTrainMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroReassignTrainMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroReassignTrainMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroDecommissionTrainMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroDecommissionTrainMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroReportEquipmentStatusMsg:
+		_ = b.EncodeVarint(87<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroReportEquipmentStatusMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroSetConnectionMsg:
+		_ = b.EncodeVarint(88<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroSetConnectionMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroPublishTimetableMsg:
+		_ = b.EncodeVarint(89<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroPublishTimetableMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroSupersedeTimetableMsg:
+		_ = b.EncodeVarint(90<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroSupersedeTimetableMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroScheduleStopMsg:
+		_ = b.EncodeVarint(91<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroScheduleStopMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroTrainDepartStationEventMsg:
+		_ = b.EncodeVarint(92<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroTrainDepartStationEventMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroOpenIncidentMsg:
+		_ = b.EncodeVarint(93<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroOpenIncidentMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroUpdateIncidentMsg:
+		_ = b.EncodeVarint(94<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroUpdateIncidentMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroResolveIncidentMsg:
+		_ = b.EncodeVarint(95<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroResolveIncidentMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroScheduleFareChangeMsg:
+		_ = b.EncodeVarint(97<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroScheduleFareChangeMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroStartJourneyMsg:
+		_ = b.EncodeVarint(99<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroStartJourneyMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroEndJourneyMsg:
+		_ = b.EncodeVarint(100<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroEndJourneyMsg); err != nil {
+			return err
+		}
+	case *Tx_MsgfeeSetMsgFeeMsg:
+		_ = b.EncodeVarint(102<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroPurchasePassMsg:
+		_ = b.EncodeVarint(103<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroPurchasePassMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroCancelPassMsg:
+		_ = b.EncodeVarint(104<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroCancelPassMsg); err != nil {
+			return err
+		}
+	case *Tx_MsgfeeUpdateConfigurationMsg:
+		_ = b.EncodeVarint(105<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_MetroReleasePassMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroReleasePassMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -319,15 +970,47 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_ValidatorsApplyDiffMsg{msg}
 		return true, err
-	case 60: // sum.execute_batch_msg
+	case 59: // sum.currency_create_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(ExecuteBatchMsg)
+		msg := new(currency.CreateMsg)
 		err := b.DecodeMessage(msg)
-		m.Sum = &Tx_ExecuteBatchMsg{msg}
+		m.Sum = &Tx_CurrencyCreateMsg{msg}
 		return true, err
-	case 69: // sum.migration_upgrade_schema_msg
+	case 60: // sum.execute_batch_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ExecuteBatchMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_ExecuteBatchMsg{msg}
+		return true, err
+	case 62: // sum.paychan_create_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.CreateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_PaychanCreateMsg{msg}
+		return true, err
+	case 63: // sum.paychan_transfer_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.TransferMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_PaychanTransferMsg{msg}
+		return true, err
+	case 64: // sum.paychan_close_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.CloseMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_PaychanCloseMsg{msg}
+		return true, err
+	case 69: // sum.migration_upgrade_schema_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
@@ -351,6 +1034,262 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_MetroTrainArriveStationEventMsg{msg}
 		return true, err
+	case 72: // sum.metro_create_line_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.CreateLineMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroCreateLineMsg{msg}
+		return true, err
+	case 73: // sum.metro_update_line_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.UpdateLineMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroUpdateLineMsg{msg}
+		return true, err
+	case 74: // sum.metro_tap_in_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.TapInMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroTapInMsg{msg}
+		return true, err
+	case 75: // sum.metro_tap_out_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.TapOutMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroTapOutMsg{msg}
+		return true, err
+	case 76: // sum.metro_update_fare_table_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.UpdateFareTableMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroUpdateFareTableMsg{msg}
+		return true, err
+	case 77: // sum.metro_set_passenger_category_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.SetPassengerCategoryMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroSetPassengerCategoryMsg{msg}
+		return true, err
+	case 78: // sum.metro_pay_fare_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.PayFareMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroPayFareMsg{msg}
+		return true, err
+	case 79: // sum.metro_update_passenger_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.UpdatePassengerMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroUpdatePassengerMsg{msg}
+		return true, err
+	case 80: // sum.metro_deregister_passenger_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.DeregisterPassengerMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroDeregisterPassengerMsg{msg}
+		return true, err
+	case 81: // sum.metro_create_station_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.CreateStationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroCreateStationMsg{msg}
+		return true, err
+	case 82: // sum.metro_update_station_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.UpdateStationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroUpdateStationMsg{msg}
+		return true, err
+	case 83: // sum.metro_retire_station_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.RetireStationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroRetireStationMsg{msg}
+		return true, err
+	case 84: // sum.metro_register_train_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.RegisterTrainMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroRegisterTrainMsg{msg}
+		return true, err
+	case 85: // sum.metro_reassign_train_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.ReassignTrainMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroReassignTrainMsg{msg}
+		return true, err
+	case 86: // sum.metro_decommission_train_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.DecommissionTrainMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroDecommissionTrainMsg{msg}
+		return true, err
+	case 87: // sum.metro_report_equipment_status_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.ReportEquipmentStatusMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroReportEquipmentStatusMsg{msg}
+		return true, err
+	case 88: // sum.metro_set_connection_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.SetConnectionMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroSetConnectionMsg{msg}
+		return true, err
+	case 89: // sum.metro_publish_timetable_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.PublishTimetableMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroPublishTimetableMsg{msg}
+		return true, err
+	case 90: // sum.metro_supersede_timetable_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.SupersedeTimetableMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroSupersedeTimetableMsg{msg}
+		return true, err
+	case 91: // sum.metro_schedule_stop_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.ScheduleStopMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroScheduleStopMsg{msg}
+		return true, err
+	case 92: // sum.metro_train_depart_station_event_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.TrainDepartStationEventMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroTrainDepartStationEventMsg{msg}
+		return true, err
+	case 93: // sum.metro_open_incident_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.OpenIncidentMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroOpenIncidentMsg{msg}
+		return true, err
+	case 94: // sum.metro_update_incident_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.UpdateIncidentMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroUpdateIncidentMsg{msg}
+		return true, err
+	case 95: // sum.metro_resolve_incident_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.ResolveIncidentMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroResolveIncidentMsg{msg}
+		return true, err
+	case 97: // sum.metro_schedule_fare_change_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.ScheduleFareChangeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroScheduleFareChangeMsg{msg}
+		return true, err
+	case 99: // sum.metro_start_journey_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.StartJourneyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroStartJourneyMsg{msg}
+		return true, err
+	case 100: // sum.metro_end_journey_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.EndJourneyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroEndJourneyMsg{msg}
+		return true, err
+	case 102: // sum.msgfee_set_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.SetMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MsgfeeSetMsgFeeMsg{msg}
+		return true, err
+	case 103: // sum.metro_purchase_pass_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.PurchasePassMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroPurchasePassMsg{msg}
+		return true, err
+	case 104: // sum.metro_cancel_pass_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.CancelPassMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroCancelPassMsg{msg}
+		return true, err
+	case 105: // sum.msgfee_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{msg}
+		return true, err
+	case 106: // sum.metro_release_pass_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.ReleasePassMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MetroReleasePassMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -380,11 +1319,31 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_CurrencyCreateMsg:
+		s := proto.Size(x.CurrencyCreateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *Tx_ExecuteBatchMsg:
 		s := proto.Size(x.ExecuteBatchMsg)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_PaychanCreateMsg:
+		s := proto.Size(x.PaychanCreateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_PaychanTransferMsg:
+		s := proto.Size(x.PaychanTransferMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_PaychanCloseMsg:
+		s := proto.Size(x.PaychanCloseMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *Tx_MigrationUpgradeSchemaMsg:
 		s := proto.Size(x.MigrationUpgradeSchemaMsg)
 		n += 2 // tag and wire
@@ -400,66 +1359,226 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-// ExecuteBatchMsg encapsulates multiple messages to support batch transaction
-type ExecuteBatchMsg struct {
-	Messages []ExecuteBatchMsg_Union `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
-}
-
-func (m *ExecuteBatchMsg) Reset()         { *m = ExecuteBatchMsg{} }
-func (m *ExecuteBatchMsg) String() string { return proto.CompactTextString(m) }
-func (*ExecuteBatchMsg) ProtoMessage()    {}
-func (*ExecuteBatchMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24fd8e45973e7fa9, []int{1}
-}
-func (m *ExecuteBatchMsg) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ExecuteBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ExecuteBatchMsg.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ExecuteBatchMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecuteBatchMsg.Merge(m, src)
-}
-func (m *ExecuteBatchMsg) XXX_Size() int {
-	return m.Size()
-}
-func (m *ExecuteBatchMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecuteBatchMsg.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ExecuteBatchMsg proto.InternalMessageInfo
-
-func (m *ExecuteBatchMsg) GetMessages() []ExecuteBatchMsg_Union {
-	if m != nil {
-		return m.Messages
-	}
-	return nil
-}
-
-type ExecuteBatchMsg_Union struct {
-	// No recursive batches!
-	//
-	// Types that are valid to be assigned to Sum:
-	//	*ExecuteBatchMsg_Union_CashSendMsg
-	//	*ExecuteBatchMsg_Union_MultisigCreateMsg
-	//	*ExecuteBatchMsg_Union_MultisigUpdateMsg
-	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
+	case *Tx_MetroCreateLineMsg:
+		s := proto.Size(x.MetroCreateLineMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroUpdateLineMsg:
+		s := proto.Size(x.MetroUpdateLineMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroTapInMsg:
+		s := proto.Size(x.MetroTapInMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroTapOutMsg:
+		s := proto.Size(x.MetroTapOutMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroUpdateFareTableMsg:
+		s := proto.Size(x.MetroUpdateFareTableMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroSetPassengerCategoryMsg:
+		s := proto.Size(x.MetroSetPassengerCategoryMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroPayFareMsg:
+		s := proto.Size(x.MetroPayFareMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroUpdatePassengerMsg:
+		s := proto.Size(x.MetroUpdatePassengerMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroDeregisterPassengerMsg:
+		s := proto.Size(x.MetroDeregisterPassengerMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroCreateStationMsg:
+		s := proto.Size(x.MetroCreateStationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroUpdateStationMsg:
+		s := proto.Size(x.MetroUpdateStationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroRetireStationMsg:
+		s := proto.Size(x.MetroRetireStationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroRegisterTrainMsg:
+		s := proto.Size(x.MetroRegisterTrainMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroReassignTrainMsg:
+		s := proto.Size(x.MetroReassignTrainMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroDecommissionTrainMsg:
+		s := proto.Size(x.MetroDecommissionTrainMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroReportEquipmentStatusMsg:
+		s := proto.Size(x.MetroReportEquipmentStatusMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroSetConnectionMsg:
+		s := proto.Size(x.MetroSetConnectionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroPublishTimetableMsg:
+		s := proto.Size(x.MetroPublishTimetableMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroSupersedeTimetableMsg:
+		s := proto.Size(x.MetroSupersedeTimetableMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroScheduleStopMsg:
+		s := proto.Size(x.MetroScheduleStopMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroTrainDepartStationEventMsg:
+		s := proto.Size(x.MetroTrainDepartStationEventMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroOpenIncidentMsg:
+		s := proto.Size(x.MetroOpenIncidentMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroUpdateIncidentMsg:
+		s := proto.Size(x.MetroUpdateIncidentMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroResolveIncidentMsg:
+		s := proto.Size(x.MetroResolveIncidentMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroScheduleFareChangeMsg:
+		s := proto.Size(x.MetroScheduleFareChangeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroStartJourneyMsg:
+		s := proto.Size(x.MetroStartJourneyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroEndJourneyMsg:
+		s := proto.Size(x.MetroEndJourneyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MsgfeeSetMsgFeeMsg:
+		s := proto.Size(x.MsgfeeSetMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroPurchasePassMsg:
+		s := proto.Size(x.MetroPurchasePassMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroCancelPassMsg:
+		s := proto.Size(x.MetroCancelPassMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MsgfeeUpdateConfigurationMsg:
+		s := proto.Size(x.MsgfeeUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MetroReleasePassMsg:
+		s := proto.Size(x.MetroReleasePassMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// ExecuteBatchMsg encapsulates multiple messages to support batch transaction
+type ExecuteBatchMsg struct {
+	Messages []ExecuteBatchMsg_Union `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+}
+
+func (m *ExecuteBatchMsg) Reset()         { *m = ExecuteBatchMsg{} }
+func (m *ExecuteBatchMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecuteBatchMsg) ProtoMessage()    {}
+func (*ExecuteBatchMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24fd8e45973e7fa9, []int{1}
+}
+func (m *ExecuteBatchMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteBatchMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteBatchMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteBatchMsg.Merge(m, src)
+}
+func (m *ExecuteBatchMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteBatchMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteBatchMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteBatchMsg proto.InternalMessageInfo
+
+func (m *ExecuteBatchMsg) GetMessages() []ExecuteBatchMsg_Union {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type ExecuteBatchMsg_Union struct {
+	// No recursive batches!
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*ExecuteBatchMsg_Union_CashSendMsg
+	//	*ExecuteBatchMsg_Union_MultisigCreateMsg
+	//	*ExecuteBatchMsg_Union_MultisigUpdateMsg
+	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
 func (m *ExecuteBatchMsg_Union) Reset()         { *m = ExecuteBatchMsg_Union{} }
@@ -654,6 +1773,9 @@ type CronTask struct {
 	//
 	// Types that are valid to be assigned to Sum:
 	//	*CronTask_EscrowReleaseMsg
+	//	*CronTask_MetroMarkTrainSilentMsg
+	//	*CronTask_MetroActivateFareChangeMsg
+	//	*CronTask_MetroExpireJourneyMsg
 	Sum isCronTask_Sum `protobuf_oneof:"sum"`
 }
 
@@ -699,8 +1821,20 @@ type isCronTask_Sum interface {
 type CronTask_EscrowReleaseMsg struct {
 	EscrowReleaseMsg *escrow.ReleaseMsg `protobuf:"bytes,53,opt,name=escrow_release_msg,json=escrowReleaseMsg,proto3,oneof"`
 }
+type CronTask_MetroMarkTrainSilentMsg struct {
+	MetroMarkTrainSilentMsg *metro.MarkTrainSilentMsg `protobuf:"bytes,96,opt,name=metro_mark_train_silent_msg,json=metroMarkTrainSilentMsg,proto3,oneof"`
+}
+type CronTask_MetroActivateFareChangeMsg struct {
+	MetroActivateFareChangeMsg *metro.ActivateFareChangeMsg `protobuf:"bytes,98,opt,name=metro_activate_fare_change_msg,json=metroActivateFareChangeMsg,proto3,oneof"`
+}
+type CronTask_MetroExpireJourneyMsg struct {
+	MetroExpireJourneyMsg *metro.ExpireJourneyMsg `protobuf:"bytes,101,opt,name=metro_expire_journey_msg,json=metroExpireJourneyMsg,proto3,oneof"`
+}
 
-func (*CronTask_EscrowReleaseMsg) isCronTask_Sum() {}
+func (*CronTask_EscrowReleaseMsg) isCronTask_Sum()           {}
+func (*CronTask_MetroMarkTrainSilentMsg) isCronTask_Sum()    {}
+func (*CronTask_MetroActivateFareChangeMsg) isCronTask_Sum() {}
+func (*CronTask_MetroExpireJourneyMsg) isCronTask_Sum()      {}
 
 func (m *CronTask) GetSum() isCronTask_Sum {
 	if m != nil {
@@ -723,10 +1857,34 @@ func (m *CronTask) GetEscrowReleaseMsg() *escrow.ReleaseMsg {
 	return nil
 }
 
+func (m *CronTask) GetMetroMarkTrainSilentMsg() *metro.MarkTrainSilentMsg {
+	if x, ok := m.GetSum().(*CronTask_MetroMarkTrainSilentMsg); ok {
+		return x.MetroMarkTrainSilentMsg
+	}
+	return nil
+}
+
+func (m *CronTask) GetMetroActivateFareChangeMsg() *metro.ActivateFareChangeMsg {
+	if x, ok := m.GetSum().(*CronTask_MetroActivateFareChangeMsg); ok {
+		return x.MetroActivateFareChangeMsg
+	}
+	return nil
+}
+
+func (m *CronTask) GetMetroExpireJourneyMsg() *metro.ExpireJourneyMsg {
+	if x, ok := m.GetSum().(*CronTask_MetroExpireJourneyMsg); ok {
+		return x.MetroExpireJourneyMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
 		(*CronTask_EscrowReleaseMsg)(nil),
+		(*CronTask_MetroMarkTrainSilentMsg)(nil),
+		(*CronTask_MetroActivateFareChangeMsg)(nil),
+		(*CronTask_MetroExpireJourneyMsg)(nil),
 	}
 }
 
@@ -739,6 +1897,21 @@ func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.EscrowReleaseMsg); err != nil {
 			return err
 		}
+	case *CronTask_MetroMarkTrainSilentMsg:
+		_ = b.EncodeVarint(96<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroMarkTrainSilentMsg); err != nil {
+			return err
+		}
+	case *CronTask_MetroActivateFareChangeMsg:
+		_ = b.EncodeVarint(98<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroActivateFareChangeMsg); err != nil {
+			return err
+		}
+	case *CronTask_MetroExpireJourneyMsg:
+		_ = b.EncodeVarint(101<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MetroExpireJourneyMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
@@ -757,6 +1930,30 @@ func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Sum = &CronTask_EscrowReleaseMsg{msg}
 		return true, err
+	case 96: // sum.metro_mark_train_silent_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.MarkTrainSilentMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_MetroMarkTrainSilentMsg{msg}
+		return true, err
+	case 98: // sum.metro_activate_fare_change_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.ActivateFareChangeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_MetroActivateFareChangeMsg{msg}
+		return true, err
+	case 101: // sum.metro_expire_journey_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(metro.ExpireJourneyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_MetroExpireJourneyMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -771,6 +1968,21 @@ func _CronTask_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CronTask_MetroMarkTrainSilentMsg:
+		s := proto.Size(x.MetroMarkTrainSilentMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_MetroActivateFareChangeMsg:
+		s := proto.Size(x.MetroActivateFareChangeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_MetroExpireJourneyMsg:
+		s := proto.Size(x.MetroExpireJourneyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -788,50 +2000,110 @@ func init() {
 func init() { proto.RegisterFile("cmd/metro/app/codec.proto", fileDescriptor_24fd8e45973e7fa9) }
 
 var fileDescriptor_24fd8e45973e7fa9 = []byte{
-	// 681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x5d, 0x4f, 0x13, 0x4d,
-	0x14, 0xc7, 0x5b, 0xde, 0x42, 0x06, 0x78, 0x78, 0x18, 0x0c, 0x29, 0x95, 0x94, 0x97, 0x18, 0x43,
-	0x62, 0x98, 0x8d, 0x10, 0x13, 0x35, 0xc6, 0x84, 0x42, 0x51, 0x2f, 0x34, 0x66, 0x4b, 0x6f, 0xdd,
-	0x0c, 0xbb, 0xa7, 0xdb, 0x89, 0xdd, 0x9d, 0xcd, 0xcc, 0x6c, 0xa9, 0xdf, 0xc2, 0xf8, 0x1d, 0xfc,
-	0x2e, 0x5c, 0xe2, 0x9d, 0x57, 0xc4, 0xc0, 0xb7, 0xf0, 0xca, 0xec, 0x99, 0xee, 0xf6, 0x25, 0x40,
-	0xbc, 0xf6, 0x6e, 0xe7, 0xfc, 0x7f, 0xe7, 0x7f, 0xce, 0x9e, 0x79, 0x21, 0xeb, 0x7e, 0x14, 0x38,
-	0x11, 0x18, 0x25, 0x1d, 0x9e, 0x24, 0x8e, 0x2f, 0x03, 0xf0, 0x59, 0xa2, 0xa4, 0x91, 0x74, 0x16,
-	0xc3, 0x55, 0x16, 0x0a, 0xd3, 0x49, 0xcf, 0x98, 0x2f, 0x23, 0x47, 0xc8, 0xde, 0x9e, 0x8c, 0xc1,
-	0x39, 0x07, 0xde, 0x03, 0x27, 0x12, 0xa1, 0xe2, 0x46, 0xc8, 0x78, 0x34, 0xad, 0xfa, 0xe4, 0x4e,
-	0xbe, 0xef, 0xf8, 0x5c, 0x77, 0xc6, 0xe0, 0xbd, 0x7b, 0x60, 0xd0, 0xbe, 0x92, 0xe7, 0x63, 0xb8,
-	0x73, 0x0f, 0x1e, 0xa5, 0x5d, 0x23, 0xb4, 0x08, 0xff, 0xba, 0x19, 0x2d, 0x42, 0x3d, 0x06, 0x3f,
-	0xbd, 0x07, 0xee, 0xf1, 0xae, 0x08, 0xb8, 0x91, 0x6a, 0x3c, 0xe5, 0x41, 0x28, 0x43, 0x89, 0x9f,
-	0x4e, 0xf6, 0x35, 0x88, 0xae, 0xf6, 0x07, 0x23, 0x1d, 0x41, 0x77, 0xbe, 0xcd, 0x91, 0xa9, 0xd3,
-	0x3e, 0xdd, 0x26, 0x33, 0x6d, 0x00, 0x5d, 0x29, 0x6f, 0x95, 0x77, 0x17, 0xf6, 0x97, 0x58, 0x36,
-	0x12, 0x76, 0x02, 0xf0, 0x2e, 0x6e, 0x4b, 0x17, 0x25, 0xba, 0x4f, 0x88, 0x16, 0x61, 0xcc, 0x4d,
-	0xaa, 0x40, 0x57, 0xa6, 0xb6, 0xa6, 0x77, 0x17, 0xf6, 0x29, 0xcb, 0xda, 0x65, 0x4d, 0x13, 0x34,
-	0x73, 0xc9, 0x1d, 0xa1, 0x68, 0x95, 0xcc, 0xe7, 0x03, 0xa8, 0xcc, 0x6c, 0x4d, 0xef, 0x2e, 0xba,
-	0xc5, 0x9a, 0x1e, 0x90, 0xa5, 0xac, 0x8a, 0xa7, 0x21, 0x0e, 0xbc, 0x48, 0x87, 0x95, 0x83, 0xd1,
-	0xda, 0x4d, 0x88, 0x83, 0xf7, 0x3a, 0x7c, 0x5b, 0x72, 0x17, 0xb2, 0xf5, 0x60, 0x49, 0x1b, 0x64,
-	0x35, 0x37, 0xf0, 0x7c, 0x05, 0xdc, 0x00, 0xa6, 0x3e, 0xc7, 0xd4, 0x55, 0x96, 0x6b, 0xec, 0x08,
-	0x35, 0x6b, 0xb0, 0x92, 0x47, 0x8b, 0xe0, 0x98, 0x4d, 0x9a, 0x04, 0xb9, 0xcd, 0x8b, 0x49, 0x9b,
-	0x16, 0x6a, 0x13, 0x36, 0x45, 0x90, 0xb6, 0xc8, 0xfa, 0x70, 0x07, 0x3c, 0x9e, 0x24, 0xdd, 0x2f,
-	0x5e, 0x20, 0xda, 0x6d, 0x34, 0x7b, 0x89, 0x66, 0x15, 0x36, 0x24, 0xd8, 0x61, 0x46, 0x1c, 0x8b,
-	0x76, 0xdb, 0x3a, 0xae, 0x0d, 0xa5, 0x51, 0x85, 0x1e, 0x93, 0x15, 0xe8, 0x83, 0x9f, 0x1a, 0xf0,
-	0xce, 0xb8, 0xf1, 0x3b, 0x68, 0xf7, 0x0a, 0xed, 0xd6, 0x18, 0x6e, 0x21, 0x6b, 0x58, 0xbd, 0x9e,
-	0xc9, 0xd6, 0x6c, 0x19, 0xc6, 0x43, 0xf4, 0x13, 0xd9, 0x28, 0xae, 0x82, 0x97, 0x26, 0xa1, 0xe2,
-	0x01, 0x78, 0xda, 0xef, 0x40, 0xc4, 0xd1, 0xb0, 0x81, 0x86, 0x0f, 0x59, 0x01, 0xb1, 0x96, 0x85,
-	0x9a, 0xc8, 0x58, 0xd7, 0xf5, 0x42, 0x9d, 0x14, 0xd1, 0x3f, 0xeb, 0xc5, 0x53, 0x10, 0x0a, 0x6d,
-	0x40, 0x79, 0x09, 0xd7, 0x1a, 0xe2, 0x10, 0x14, 0xfa, 0x9f, 0xe4, 0xfe, 0xd8, 0xb0, 0x3b, 0x80,
-	0x3e, 0xe6, 0x4c, 0xee, 0x9f, 0xa9, 0xb7, 0x89, 0x54, 0x91, 0x47, 0xd6, 0xdf, 0x28, 0x2e, 0x62,
-	0x8f, 0x2b, 0x25, 0x7a, 0xe0, 0x69, 0x63, 0x7f, 0x08, 0x7a, 0x10, 0x1b, 0xac, 0xf3, 0x06, 0xeb,
-	0x6c, 0x0f, 0xea, 0x9c, 0x66, 0xf0, 0x21, 0xb2, 0x4d, 0x8b, 0x36, 0x32, 0xd2, 0x56, 0xdb, 0x44,
-	0xe6, 0x6e, 0xa4, 0x3e, 0x4b, 0xa6, 0x75, 0x1a, 0xed, 0x7c, 0x9f, 0x22, 0xcb, 0x13, 0x13, 0xa6,
-	0xaf, 0xc9, 0x7c, 0x04, 0x5a, 0xf3, 0x10, 0x6f, 0x49, 0x76, 0xf8, 0x37, 0x6e, 0xdf, 0x0b, 0xd6,
-	0x8a, 0x85, 0x8c, 0xeb, 0x33, 0x17, 0x57, 0x9b, 0x25, 0xb7, 0xc8, 0xa9, 0xfe, 0x28, 0x93, 0x59,
-	0x54, 0xfe, 0x81, 0x83, 0x5f, 0xcc, 0xa9, 0x4c, 0xe6, 0x8f, 0x94, 0x8c, 0x4f, 0xb9, 0xfe, 0x4c,
-	0x3f, 0x90, 0xff, 0x78, 0x6a, 0x3a, 0x10, 0x1b, 0xe1, 0xe3, 0x99, 0xc6, 0x31, 0x2d, 0xd6, 0x1f,
-	0xff, 0xbe, 0xda, 0xdc, 0xb9, 0xeb, 0x0d, 0x63, 0x47, 0x32, 0x0e, 0x44, 0xb6, 0x07, 0xee, 0x44,
-	0x36, 0xad, 0x13, 0x6a, 0xdf, 0x5a, 0x4f, 0x41, 0x17, 0xb8, 0xb6, 0x9d, 0x3e, 0xc3, 0x4e, 0x29,
-	0xb3, 0x12, 0x73, 0xad, 0x64, 0x1b, 0xfd, 0xdf, 0x06, 0x87, 0xb1, 0x41, 0x9f, 0xf5, 0xca, 0xc5,
-	0x75, 0xad, 0x7c, 0x79, 0x5d, 0x2b, 0xff, 0xba, 0xae, 0x95, 0xbf, 0xde, 0xd4, 0x4a, 0x97, 0x37,
-	0xb5, 0xd2, 0xcf, 0x9b, 0x5a, 0xe9, 0x6c, 0x0e, 0x5f, 0xc1, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xc7, 0xe5, 0x7e, 0xae, 0x71, 0x06, 0x00, 0x00,
+	// 1636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x98, 0xdb, 0x72, 0x14, 0xb7,
+	0x16, 0x86, 0x6d, 0x0c, 0xbb, 0x28, 0x71, 0xb4, 0x6c, 0x7c, 0x02, 0x8f, 0x0d, 0xb5, 0x6b, 0x17,
+	0x55, 0xbb, 0xe8, 0xa9, 0x0d, 0xb5, 0xab, 0x12, 0x92, 0x70, 0xf0, 0x29, 0x98, 0x60, 0xec, 0xcc,
+	0xd8, 0x09, 0x84, 0x84, 0x8e, 0xdc, 0xad, 0xe9, 0x11, 0x9e, 0x3e, 0x44, 0x52, 0x1b, 0xfb, 0x2d,
+	0xf2, 0x12, 0x79, 0x87, 0x3c, 0x02, 0x97, 0xe4, 0x2e, 0x57, 0x54, 0x0a, 0x2e, 0xf3, 0x06, 0xb9,
+	0x4a, 0x69, 0x49, 0xea, 0x96, 0xda, 0x63, 0x17, 0xd7, 0xb9, 0xb3, 0xd7, 0xff, 0xeb, 0x93, 0x96,
+	0xb4, 0x7a, 0xb5, 0x7a, 0xd0, 0x6c, 0x94, 0xc6, 0xed, 0x94, 0x4a, 0x9e, 0xb7, 0x49, 0x51, 0xb4,
+	0xa3, 0x3c, 0xa6, 0x51, 0x50, 0xf0, 0x5c, 0xe6, 0xf8, 0x0c, 0x84, 0xe7, 0x82, 0x84, 0xc9, 0x7e,
+	0xb9, 0x1b, 0x44, 0x79, 0xda, 0x66, 0xf9, 0xfe, 0xad, 0x3c, 0xa3, 0xed, 0xd7, 0x94, 0xec, 0xd3,
+	0x76, 0xca, 0x12, 0x4e, 0x24, 0xcb, 0x33, 0x77, 0xd8, 0xdc, 0x7f, 0x8f, 0xf5, 0x1f, 0xb4, 0x23,
+	0x22, 0xfa, 0x9e, 0xb9, 0x7d, 0x92, 0xb9, 0xe4, 0x9c, 0x66, 0xd1, 0xa1, 0x37, 0xe0, 0xd6, 0x09,
+	0x03, 0xa8, 0x88, 0x78, 0xfe, 0xfa, 0xa3, 0xed, 0xa9, 0x48, 0x7a, 0x94, 0x7e, 0xf4, 0x72, 0xd2,
+	0x72, 0x20, 0x99, 0x60, 0x89, 0x37, 0x20, 0x38, 0x61, 0x40, 0x41, 0x0e, 0xa3, 0x3e, 0xf9, 0xf8,
+	0xcd, 0x11, 0x2c, 0x11, 0x9e, 0xf9, 0x7f, 0x27, 0x98, 0xf7, 0xc9, 0x80, 0xc5, 0x44, 0xe6, 0xdc,
+	0x1f, 0x32, 0x99, 0xe4, 0x49, 0x0e, 0x7f, 0xb6, 0xd5, 0x5f, 0x26, 0x3a, 0x71, 0x60, 0x8e, 0xd8,
+	0xb1, 0xde, 0xf8, 0x73, 0x1e, 0x9d, 0xda, 0x3e, 0xc0, 0xd7, 0xd1, 0xe9, 0x1e, 0xa5, 0x62, 0x66,
+	0x74, 0x71, 0xf4, 0xe6, 0xb9, 0xdb, 0x17, 0x02, 0x75, 0x44, 0xc1, 0x1a, 0xa5, 0xeb, 0x59, 0x2f,
+	0xef, 0x80, 0x84, 0x6f, 0x23, 0x24, 0x58, 0x92, 0x11, 0x59, 0x72, 0x2a, 0x66, 0x4e, 0x2d, 0x8e,
+	0xdd, 0x3c, 0x77, 0x1b, 0x07, 0x6a, 0xb9, 0x41, 0x57, 0xc6, 0x5d, 0x2b, 0x75, 0x1c, 0x17, 0x9e,
+	0x43, 0x67, 0xed, 0x86, 0xcd, 0x9c, 0x5e, 0x1c, 0xbb, 0x79, 0xbe, 0x53, 0xfd, 0x8f, 0xef, 0xa0,
+	0x0b, 0x6a, 0x96, 0x50, 0xd0, 0x2c, 0x0e, 0x53, 0x91, 0xcc, 0xdc, 0x71, 0xe7, 0xee, 0xd2, 0x2c,
+	0xde, 0x10, 0xc9, 0xa3, 0x91, 0xce, 0x39, 0xf5, 0xbf, 0xf9, 0x17, 0xaf, 0xa2, 0x09, 0x0b, 0x08,
+	0x23, 0x4e, 0x89, 0xa4, 0x30, 0xf4, 0x13, 0x18, 0x3a, 0x11, 0x58, 0x2d, 0x58, 0x06, 0x4d, 0x03,
+	0xc6, 0x6d, 0xb4, 0x0a, 0x7a, 0x98, 0xb2, 0x88, 0x2d, 0xe6, 0xd3, 0x26, 0x66, 0xa7, 0x88, 0x8f,
+	0x62, 0xaa, 0x20, 0xde, 0x41, 0xb3, 0xf5, 0x09, 0x84, 0xa4, 0x28, 0x06, 0x87, 0x61, 0xcc, 0x7a,
+	0x3d, 0x80, 0xdd, 0x05, 0xd8, 0x4c, 0x50, 0x3b, 0x82, 0x87, 0xca, 0xb1, 0xc2, 0x7a, 0x3d, 0x4d,
+	0x9c, 0xaa, 0x25, 0x57, 0x51, 0xab, 0xb3, 0x55, 0xef, 0x26, 0xf9, 0x99, 0x59, 0x9d, 0xd5, 0xfc,
+	0x24, 0x6d, 0xb4, 0x4e, 0x72, 0x05, 0x8d, 0xd3, 0x03, 0x1a, 0x95, 0x92, 0x86, 0xbb, 0x44, 0x46,
+	0x7d, 0x80, 0x7c, 0x0e, 0x90, 0xa9, 0x00, 0x2a, 0x21, 0x58, 0xd5, 0xfa, 0x92, 0x92, 0x35, 0xe7,
+	0x12, 0xf5, 0x43, 0x78, 0x09, 0x61, 0x53, 0xc2, 0xee, 0x5a, 0xee, 0x01, 0x06, 0x07, 0x46, 0xf2,
+	0x96, 0x72, 0xd9, 0x04, 0xeb, 0x95, 0x3c, 0x42, 0x93, 0x96, 0x21, 0x39, 0xc9, 0x44, 0x8f, 0x72,
+	0xa0, 0xdc, 0x07, 0xca, 0x64, 0x45, 0xd9, 0x36, 0xa2, 0xe6, 0xd8, 0x79, 0x9d, 0x28, 0xbe, 0x8f,
+	0xc6, 0xab, 0xd5, 0x0c, 0x72, 0xa1, 0x17, 0xf3, 0x00, 0x30, 0xe3, 0xf5, 0x62, 0x94, 0x62, 0xd2,
+	0xb1, 0x6b, 0x31, 0x21, 0xfc, 0x12, 0x5d, 0xab, 0x1a, 0x56, 0x58, 0x16, 0x09, 0x27, 0x31, 0x0d,
+	0x45, 0xd4, 0xa7, 0x29, 0x01, 0xd6, 0x2a, 0xb0, 0xae, 0x06, 0x95, 0x29, 0xd8, 0xd1, 0xa6, 0x2e,
+	0x78, 0x34, 0x75, 0xb6, 0x52, 0x9b, 0x22, 0xf0, 0xd5, 0xd6, 0x86, 0x9c, 0x26, 0x4c, 0x48, 0xca,
+	0xc3, 0x82, 0x08, 0x41, 0xb3, 0xc4, 0xa4, 0xbc, 0x66, 0xf9, 0xb0, 0xff, 0x1d, 0x63, 0xda, 0xb2,
+	0x1e, 0xcb, 0x57, 0xea, 0x30, 0x11, 0x73, 0xf4, 0x6f, 0xcd, 0x97, 0x9c, 0xb0, 0x2c, 0x24, 0x9c,
+	0xb3, 0x7d, 0x1a, 0x0a, 0xa9, 0x13, 0xa2, 0xfb, 0x34, 0x93, 0x30, 0xcf, 0x97, 0x30, 0xcf, 0x75,
+	0x33, 0xcf, 0xb6, 0x32, 0x3f, 0x04, 0x6f, 0x57, 0x5b, 0x57, 0x95, 0x53, 0xcf, 0xb6, 0x00, 0x9e,
+	0xe3, 0x2d, 0x78, 0x1d, 0x5d, 0xd1, 0x73, 0x9a, 0x02, 0x18, 0xb0, 0x4c, 0x6f, 0xfc, 0x23, 0x73,
+	0x7e, 0x7a, 0x12, 0x7d, 0xde, 0x4f, 0x58, 0x66, 0xf6, 0x1e, 0x43, 0xd8, 0x8b, 0xd6, 0x28, 0xf3,
+	0xd4, 0x55, 0xa8, 0x75, 0x0f, 0xa5, 0x1f, 0xb1, 0x26, 0xca, 0x8b, 0xe2, 0xbb, 0xe8, 0xb2, 0xd9,
+	0x09, 0x52, 0x84, 0x2c, 0x03, 0xca, 0x63, 0xa0, 0x5c, 0xb2, 0x59, 0x93, 0x62, 0x3d, 0xd3, 0x80,
+	0x0b, 0x3a, 0x47, 0x13, 0xc0, 0x5f, 0xa0, 0xf1, 0x7a, 0x6c, 0x5e, 0xea, 0x2d, 0xfb, 0x0a, 0x06,
+	0x5f, 0xae, 0x07, 0x6f, 0x96, 0x66, 0x87, 0x2e, 0xda, 0xd1, 0x3a, 0x82, 0x9f, 0xa3, 0xab, 0x5e,
+	0x16, 0x3d, 0xc2, 0x69, 0x28, 0xc9, 0xee, 0x40, 0xe7, 0xf2, 0x04, 0x40, 0xb3, 0x5e, 0x2e, 0x6b,
+	0x84, 0xd3, 0x6d, 0xe5, 0xd0, 0xc4, 0x69, 0x27, 0x21, 0x57, 0xc2, 0x7d, 0xb4, 0xa8, 0xd1, 0x82,
+	0x4a, 0xa7, 0x74, 0x22, 0x22, 0x69, 0x92, 0xf3, 0x43, 0xe0, 0x6f, 0x00, 0xbf, 0x65, 0xf8, 0x5d,
+	0x2a, 0xab, 0x0a, 0x59, 0x36, 0x36, 0x3d, 0x89, 0xae, 0xc4, 0x63, 0x74, 0xfc, 0x00, 0xe9, 0x5d,
+	0x0d, 0x0b, 0x72, 0xa8, 0x33, 0x50, 0xec, 0xa7, 0xe6, 0x59, 0xd2, 0xec, 0x2d, 0x72, 0xa8, 0x56,
+	0x67, 0x9e, 0x25, 0x88, 0xd5, 0x21, 0xfc, 0x0c, 0xcd, 0x79, 0xdb, 0xe0, 0x57, 0xfa, 0xe6, 0x90,
+	0x5d, 0x68, 0xd4, 0xb9, 0xbb, 0x0b, 0x5e, 0x95, 0xc7, 0xa8, 0xa5, 0xc9, 0x31, 0x3d, 0xe6, 0x39,
+	0xda, 0x02, 0xfa, 0xbc, 0xa1, 0xaf, 0x54, 0xb6, 0xc6, 0x0c, 0xfa, 0x9c, 0x86, 0xcb, 0xb8, 0x83,
+	0x66, 0xbc, 0xba, 0xb6, 0x4f, 0x91, 0xe2, 0x7f, 0x0d, 0xfc, 0x69, 0xaf, 0xb4, 0xcd, 0x73, 0xa1,
+	0xc9, 0x57, 0x9c, 0xea, 0xae, 0x85, 0x9a, 0x69, 0xf6, 0xc4, 0x65, 0x76, 0x3c, 0xa6, 0x4e, 0x7b,
+	0x08, 0xb3, 0x29, 0xd4, 0x4c, 0x4e, 0x25, 0xe3, 0x3e, 0xb3, 0xeb, 0x31, 0x3b, 0x60, 0x18, 0xc2,
+	0x6c, 0x0a, 0x2e, 0xd3, 0xec, 0xaf, 0x6e, 0x28, 0x8a, 0xb9, 0xdd, 0x60, 0x6a, 0x03, 0x34, 0x08,
+	0x9f, 0xe9, 0x0b, 0x2e, 0x93, 0x08, 0x75, 0x0b, 0x70, 0x98, 0x3b, 0x0d, 0xa6, 0x36, 0x0c, 0x61,
+	0xfa, 0x42, 0xdd, 0x4f, 0x63, 0x1a, 0xe5, 0x69, 0xca, 0x84, 0x60, 0xb9, 0xcb, 0xfd, 0xc6, 0xeb,
+	0xa7, 0x2b, 0x8e, 0xc9, 0x61, 0xcf, 0x9a, 0x2a, 0x38, 0x2a, 0xe2, 0x3d, 0x74, 0xdd, 0xae, 0xb9,
+	0xc8, 0xb9, 0x0c, 0xe9, 0x4f, 0x25, 0x2b, 0x52, 0xd5, 0x45, 0xd5, 0x2e, 0x97, 0x02, 0x26, 0xf9,
+	0x16, 0x26, 0x59, 0xa8, 0x16, 0xaf, 0x9c, 0xab, 0xd6, 0xd8, 0x05, 0x9f, 0x9e, 0x68, 0xde, 0x24,
+	0x31, 0xdc, 0x50, 0x6f, 0x90, 0x7a, 0xb8, 0xa3, 0x3c, 0xcb, 0x68, 0x54, 0x1d, 0xe4, 0x33, 0x6f,
+	0x83, 0xba, 0x54, 0x2e, 0x57, 0xba, 0xbb, 0x41, 0x4d, 0x01, 0xbf, 0xb0, 0xbd, 0xa8, 0x28, 0x77,
+	0x07, 0x4c, 0xf4, 0x43, 0xc9, 0x52, 0x5a, 0xf7, 0xa2, 0xe7, 0x80, 0x9d, 0xb3, 0xcf, 0xb3, 0xf6,
+	0x6c, 0x5b, 0x8b, 0x26, 0xeb, 0x45, 0x0d, 0xd1, 0x30, 0x41, 0xf3, 0x66, 0xc1, 0x65, 0x41, 0xb9,
+	0xa0, 0x31, 0x6d, 0xe0, 0xbf, 0x03, 0xfc, 0x35, 0xbb, 0x6a, 0xeb, 0x6a, 0x4c, 0xa0, 0xdb, 0xc4,
+	0x50, 0x15, 0x6f, 0xa2, 0x69, 0x33, 0x45, 0xd4, 0xa7, 0x71, 0x39, 0x50, 0xe5, 0x9d, 0x17, 0x00,
+	0x7f, 0xe1, 0xdd, 0x55, 0xba, 0x46, 0xef, 0xca, 0xbc, 0xd0, 0xd8, 0x49, 0x8d, 0xf5, 0xe3, 0xcd,
+	0x37, 0x64, 0x4c, 0x0b, 0xc2, 0xe5, 0x90, 0x37, 0xe4, 0xf7, 0x47, 0xdf, 0x90, 0x2b, 0xe0, 0x3d,
+	0xf1, 0x0d, 0x39, 0xd4, 0x52, 0x27, 0x91, 0x17, 0x34, 0x0b, 0x59, 0x16, 0xb1, 0xd8, 0x4e, 0xf3,
+	0x83, 0x97, 0xc4, 0x66, 0x41, 0xb3, 0x75, 0x23, 0xbb, 0x49, 0x34, 0xe2, 0xea, 0x66, 0xe9, 0xb5,
+	0x11, 0x0f, 0xf9, 0xd2, 0xdc, 0x2c, 0xdd, 0x3e, 0xe2, 0x43, 0xa7, 0x9c, 0x46, 0xe2, 0x62, 0xab,
+	0x8e, 0xcd, 0xa9, 0xc8, 0x07, 0xfb, 0x0d, 0x6e, 0xe8, 0x75, 0xec, 0x8e, 0xb6, 0xf8, 0xe0, 0x69,
+	0x53, 0xe0, 0x4d, 0x09, 0xef, 0xa2, 0x56, 0xe3, 0x18, 0xe1, 0x95, 0xa2, 0xee, 0x5e, 0x89, 0x2e,
+	0x15, 0xe2, 0x97, 0x8a, 0xb1, 0xa9, 0x77, 0xc9, 0x32, 0x98, 0xbc, 0x52, 0x19, 0xa6, 0x3a, 0xa5,
+	0x22, 0xd5, 0x91, 0xbe, 0xca, 0x4b, 0x9e, 0x51, 0xfd, 0x4a, 0x8c, 0xfc, 0x52, 0x51, 0xfa, 0x63,
+	0x2d, 0x7b, 0xa5, 0xe2, 0xc7, 0xeb, 0xdb, 0x88, 0xfa, 0x04, 0x71, 0x71, 0xb1, 0x77, 0x1b, 0x59,
+	0xcd, 0x62, 0x0f, 0xa6, 0xdf, 0x9b, 0x5e, 0x14, 0x3f, 0x46, 0x53, 0xfa, 0x4b, 0x12, 0x9e, 0xed,
+	0x54, 0x24, 0xa1, 0xfa, 0x5b, 0xb1, 0x7a, 0x96, 0x05, 0xb2, 0x7a, 0xb2, 0x37, 0x44, 0xb2, 0x46,
+	0xab, 0x9b, 0x0d, 0x84, 0xdd, 0x68, 0x9d, 0x67, 0x51, 0xf2, 0xa8, 0x4f, 0x84, 0x7e, 0xb3, 0x02,
+	0x2c, 0xf1, 0xf2, 0xdc, 0x32, 0xba, 0x7a, 0xab, 0xb9, 0x79, 0x36, 0xe2, 0xce, 0x05, 0x8e, 0x64,
+	0x11, 0x1d, 0xd4, 0xb8, 0xbe, 0x7f, 0x81, 0x03, 0xb5, 0x86, 0x99, 0x0b, 0x9c, 0x1b, 0xc5, 0x09,
+	0x5a, 0x30, 0x79, 0x9a, 0xca, 0x8c, 0xf2, 0xac, 0xc7, 0x92, 0x92, 0xd7, 0xaf, 0x24, 0x66, 0xaf,
+	0x27, 0x3a, 0x61, 0x5d, 0x85, 0xcb, 0xae, 0xcd, 0x5e, 0x4f, 0xc0, 0x30, 0x5c, 0xc7, 0x4f, 0xd0,
+	0x94, 0x2d, 0xd5, 0x01, 0xf5, 0xf6, 0xe0, 0x15, 0xf0, 0xaf, 0x54, 0x65, 0x0a, 0x72, 0xbd, 0xea,
+	0x09, 0x53, 0xa2, 0x6e, 0x78, 0xe9, 0x0c, 0x1a, 0x13, 0x65, 0x7a, 0xe3, 0x97, 0x53, 0xe8, 0x52,
+	0xe3, 0x9b, 0x07, 0xdf, 0x43, 0x67, 0x53, 0x2a, 0x04, 0x49, 0xe0, 0xf3, 0x77, 0xcc, 0xa9, 0xd1,
+	0x86, 0x33, 0xd8, 0xc9, 0x58, 0x9e, 0x2d, 0x9d, 0x7e, 0xf3, 0x6e, 0x61, 0xa4, 0x53, 0x8d, 0x99,
+	0xfb, 0x6d, 0x14, 0x9d, 0x01, 0xe5, 0x1f, 0xf0, 0x45, 0x6b, 0xf7, 0xe9, 0xd7, 0x31, 0x74, 0x76,
+	0x99, 0xe7, 0xd9, 0x36, 0x11, 0x7b, 0xf8, 0x29, 0xba, 0x48, 0x4a, 0xd9, 0xa7, 0x99, 0x64, 0x11,
+	0x7c, 0xac, 0xc2, 0x36, 0x9d, 0x5f, 0xfa, 0xcf, 0x5f, 0xef, 0x16, 0x6e, 0x1c, 0xf7, 0xe3, 0x44,
+	0xb0, 0x9c, 0x67, 0x31, 0x53, 0x87, 0xd9, 0x69, 0x8c, 0x56, 0x5f, 0x94, 0xfa, 0x37, 0x9a, 0xea,
+	0x68, 0xd5, 0x4a, 0xff, 0x6f, 0xbe, 0x28, 0xb5, 0x64, 0x8f, 0xd5, 0x7c, 0x51, 0xea, 0x60, 0x1d,
+	0xab, 0x6f, 0xe0, 0x29, 0xe1, 0x7b, 0xa6, 0xd3, 0x0b, 0x36, 0xb0, 0x9d, 0xec, 0x47, 0xaf, 0x93,
+	0x6d, 0x10, 0xbe, 0x07, 0xcd, 0xbb, 0x0b, 0x0e, 0xb7, 0x93, 0x1d, 0x95, 0xea, 0x4e, 0x46, 0x22,
+	0xc9, 0xf6, 0x89, 0x3c, 0xda, 0xc9, 0x76, 0xbd, 0x4e, 0xf6, 0xd0, 0xd8, 0x86, 0x77, 0xb2, 0xa1,
+	0x6a, 0x7d, 0x11, 0xa0, 0x07, 0x05, 0xe3, 0xd4, 0xeb, 0x3d, 0xd4, 0xbb, 0x08, 0xac, 0x82, 0xc1,
+	0x6b, 0x3f, 0xfa, 0x59, 0x6e, 0x0a, 0xe6, 0xe8, 0x96, 0x66, 0xde, 0xbc, 0x6f, 0x8d, 0xbe, 0x7d,
+	0xdf, 0x1a, 0xfd, 0xe3, 0x7d, 0x6b, 0xf4, 0xe7, 0x0f, 0xad, 0x91, 0xb7, 0x1f, 0x5a, 0x23, 0xbf,
+	0x7f, 0x68, 0x8d, 0xec, 0xfe, 0x0b, 0x7e, 0xf1, 0xb9, 0xf3, 0xf7, 0x00, 0x20, 0x05, 0xbd, 0x86,
+	0xed, 0x13, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -953,6 +2225,22 @@ func (m *Tx_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Tx_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CurrencyCreateMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
+		n7, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
 func (m *Tx_ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.ExecuteBatchMsg != nil {
@@ -961,11 +2249,59 @@ func (m *Tx_ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteBatchMsg.Size()))
-		n7, err := m.ExecuteBatchMsg.MarshalTo(dAtA[i:])
+		n8, err := m.ExecuteBatchMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
+	}
+	return i, nil
+}
+func (m *Tx_PaychanCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanCreateMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanCreateMsg.Size()))
+		n9, err := m.PaychanCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+func (m *Tx_PaychanTransferMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanTransferMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanTransferMsg.Size()))
+		n10, err := m.PaychanTransferMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *Tx_PaychanCloseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanCloseMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanCloseMsg.Size()))
+		n11, err := m.PaychanCloseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
 	}
 	return i, nil
 }
@@ -977,11 +2313,11 @@ func (m *Tx_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n8, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		n12, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n12
 	}
 	return i, nil
 }
@@ -993,11 +2329,11 @@ func (m *Tx_MetroRegisterPassengerMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MetroRegisterPassengerMsg.Size()))
-		n9, err := m.MetroRegisterPassengerMsg.MarshalTo(dAtA[i:])
+		n13, err := m.MetroRegisterPassengerMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n13
 	}
 	return i, nil
 }
@@ -1009,438 +2345,2653 @@ func (m *Tx_MetroTrainArriveStationEventMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MetroTrainArriveStationEventMsg.Size()))
-		n10, err := m.MetroTrainArriveStationEventMsg.MarshalTo(dAtA[i:])
+		n14, err := m.MetroTrainArriveStationEventMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n14
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Tx_MetroCreateLineMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroCreateLineMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroCreateLineMsg.Size()))
+		n15, err := m.MetroCreateLineMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *Tx_MetroUpdateLineMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroUpdateLineMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroUpdateLineMsg.Size()))
+		n16, err := m.MetroUpdateLineMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n16
 	}
 	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Tx_MetroTapInMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroTapInMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroTapInMsg.Size()))
+		n17, err := m.MetroTapInMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn11, err := m.Sum.MarshalTo(dAtA[i:])
+func (m *Tx_MetroTapOutMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroTapOutMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroTapOutMsg.Size()))
+		n18, err := m.MetroTapOutMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn11
+		i += n18
 	}
 	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MetroUpdateFareTableMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CashSendMsg != nil {
-		dAtA[i] = 0x9a
+	if m.MetroUpdateFareTableMsg != nil {
+		dAtA[i] = 0xe2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x4
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n12, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroUpdateFareTableMsg.Size()))
+		n19, err := m.MetroUpdateFareTableMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n19
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MetroSetPassengerCategoryMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigCreateMsg != nil {
-		dAtA[i] = 0xc2
+	if m.MetroSetPassengerCategoryMsg != nil {
+		dAtA[i] = 0xea
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x4
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n13, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroSetPassengerCategoryMsg.Size()))
+		n20, err := m.MetroSetPassengerCategoryMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n20
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MetroPayFareMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
+	if m.MetroPayFareMsg != nil {
+		dAtA[i] = 0xf2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x4
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n14, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroPayFareMsg.Size()))
+		n21, err := m.MetroPayFareMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n21
 	}
 	return i, nil
 }
-func (m *CronTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Tx_MetroUpdatePassengerMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroUpdatePassengerMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroUpdatePassengerMsg.Size()))
+		n22, err := m.MetroUpdatePassengerMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+func (m *Tx_MetroDeregisterPassengerMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroDeregisterPassengerMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroDeregisterPassengerMsg.Size()))
+		n23, err := m.MetroDeregisterPassengerMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n23
 	}
-	if m.Sum != nil {
-		nn15, err := m.Sum.MarshalTo(dAtA[i:])
+	return i, nil
+}
+func (m *Tx_MetroCreateStationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroCreateStationMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroCreateStationMsg.Size()))
+		n24, err := m.MetroCreateStationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn15
+		i += n24
 	}
 	return i, nil
 }
-
-func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MetroUpdateStationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReleaseMsg != nil {
-		dAtA[i] = 0xaa
+	if m.MetroUpdateStationMsg != nil {
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n16, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroUpdateStationMsg.Size()))
+		n25, err := m.MetroUpdateStationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n25
 	}
 	return i, nil
 }
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Tx_MetroRetireStationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroRetireStationMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroRetireStationMsg.Size()))
+		n26, err := m.MetroRetireStationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return i, nil
 }
-func (m *Tx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Fees != nil {
-		l = m.Fees.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+func (m *Tx_MetroRegisterTrainMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroRegisterTrainMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroRegisterTrainMsg.Size()))
+		n27, err := m.MetroRegisterTrainMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n27
 	}
-	if len(m.Multisig) > 0 {
-		for _, b := range m.Multisig {
-			l = len(b)
-			n += 1 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *Tx_MetroReassignTrainMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroReassignTrainMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroReassignTrainMsg.Size()))
+		n28, err := m.MetroReassignTrainMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n28
 	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+	return i, nil
 }
-
-func (m *Tx_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *Tx_MetroDecommissionTrainMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroDecommissionTrainMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroDecommissionTrainMsg.Size()))
+		n29, err := m.MetroDecommissionTrainMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MultisigCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MultisigCreateMsg != nil {
-		l = m.MultisigCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *Tx_MetroReportEquipmentStatusMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroReportEquipmentStatusMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroReportEquipmentStatusMsg.Size()))
+		n30, err := m.MetroReportEquipmentStatusMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *Tx_MetroSetConnectionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroSetConnectionMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroSetConnectionMsg.Size()))
+		n31, err := m.MetroSetConnectionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_ValidatorsApplyDiffMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ValidatorsApplyDiffMsg != nil {
-		l = m.ValidatorsApplyDiffMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *Tx_MetroPublishTimetableMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroPublishTimetableMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroPublishTimetableMsg.Size()))
+		n32, err := m.MetroPublishTimetableMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_ExecuteBatchMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExecuteBatchMsg != nil {
-		l = m.ExecuteBatchMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *Tx_MetroSupersedeTimetableMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroSupersedeTimetableMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroSupersedeTimetableMsg.Size()))
+		n33, err := m.MetroSupersedeTimetableMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MigrationUpgradeSchemaMsg != nil {
-		l = m.MigrationUpgradeSchemaMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *Tx_MetroScheduleStopMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroScheduleStopMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroScheduleStopMsg.Size()))
+		n34, err := m.MetroScheduleStopMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MetroRegisterPassengerMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MetroRegisterPassengerMsg != nil {
-		l = m.MetroRegisterPassengerMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *Tx_MetroTrainDepartStationEventMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroTrainDepartStationEventMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroTrainDepartStationEventMsg.Size()))
+		n35, err := m.MetroTrainDepartStationEventMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MetroTrainArriveStationEventMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MetroTrainArriveStationEventMsg != nil {
-		l = m.MetroTrainArriveStationEventMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *Tx_MetroOpenIncidentMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroOpenIncidentMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroOpenIncidentMsg.Size()))
+		n36, err := m.MetroOpenIncidentMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tx_MetroUpdateIncidentMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroUpdateIncidentMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroUpdateIncidentMsg.Size()))
+		n37, err := m.MetroUpdateIncidentMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
 	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *Tx_MetroResolveIncidentMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroResolveIncidentMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroResolveIncidentMsg.Size()))
+		n38, err := m.MetroResolveIncidentMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n38
 	}
-	return n
+	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tx_MetroScheduleFareChangeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroScheduleFareChangeMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroScheduleFareChangeMsg.Size()))
+		n39, err := m.MetroScheduleFareChangeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
 	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	return i, nil
+}
+func (m *Tx_MetroStartJourneyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroStartJourneyMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroStartJourneyMsg.Size()))
+		n40, err := m.MetroStartJourneyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
 	}
-	return n
+	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tx_MetroEndJourneyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroEndJourneyMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroEndJourneyMsg.Size()))
+		n41, err := m.MetroEndJourneyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
 	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *Tx_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
+		n42, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tx_MetroPurchasePassMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroPurchasePassMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroPurchasePassMsg.Size()))
+		n43, err := m.MetroPurchasePassMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
 	}
-	var l int
-	_ = l
-	if m.MultisigCreateMsg != nil {
-		l = m.MultisigCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *Tx_MetroCancelPassMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroCancelPassMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroCancelPassMsg.Size()))
+		n44, err := m.MetroCancelPassMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tx_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
+		n45, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
 	}
-	var l int
-	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *Tx_MetroReleasePassMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroReleasePassMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroReleasePassMsg.Size()))
+		n46, err := m.MetroReleasePassMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
-	return n
+	return i, nil
 }
-func (m *CronTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			l = len(b)
-			n += 1 + l + sovCodec(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+	return i, nil
 }
 
-func (m *CronTask_EscrowReleaseMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.EscrowReleaseMsg != nil {
-		l = m.EscrowReleaseMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	if m.Sum != nil {
+		nn47, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn47
 	}
-	return n
+	return i, nil
 }
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n48, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigCreateMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
+		n49, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n50, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	return i, nil
+}
+func (m *CronTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Sum != nil {
+		nn51, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn51
+	}
+	return i, nil
+}
+
+func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
+		n52, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	return i, nil
+}
+func (m *CronTask_MetroMarkTrainSilentMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroMarkTrainSilentMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroMarkTrainSilentMsg.Size()))
+		n53, err := m.MetroMarkTrainSilentMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	return i, nil
+}
+func (m *CronTask_MetroActivateFareChangeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroActivateFareChangeMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroActivateFareChangeMsg.Size()))
+		n54, err := m.MetroActivateFareChangeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	return i, nil
+}
+func (m *CronTask_MetroExpireJourneyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MetroExpireJourneyMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MetroExpireJourneyMsg.Size()))
+		n55, err := m.MetroExpireJourneyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	return i, nil
+}
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Tx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Multisig) > 0 {
+		for _, b := range m.Multisig {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
 	return n
 }
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Tx_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
 }
-func (m *Tx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
+func (m *Tx_MultisigCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateMsg != nil {
+		l = m.MultisigCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CurrencyCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyCreateMsg != nil {
+		l = m.CurrencyCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteBatchMsg != nil {
+		l = m.ExecuteBatchMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_PaychanCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PaychanCreateMsg != nil {
+		l = m.PaychanCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_PaychanTransferMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PaychanTransferMsg != nil {
+		l = m.PaychanTransferMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_PaychanCloseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PaychanCloseMsg != nil {
+		l = m.PaychanCloseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationUpgradeSchemaMsg != nil {
+		l = m.MigrationUpgradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroRegisterPassengerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroRegisterPassengerMsg != nil {
+		l = m.MetroRegisterPassengerMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroTrainArriveStationEventMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroTrainArriveStationEventMsg != nil {
+		l = m.MetroTrainArriveStationEventMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroCreateLineMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroCreateLineMsg != nil {
+		l = m.MetroCreateLineMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroUpdateLineMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroUpdateLineMsg != nil {
+		l = m.MetroUpdateLineMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroTapInMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroTapInMsg != nil {
+		l = m.MetroTapInMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroTapOutMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroTapOutMsg != nil {
+		l = m.MetroTapOutMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroUpdateFareTableMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroUpdateFareTableMsg != nil {
+		l = m.MetroUpdateFareTableMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroSetPassengerCategoryMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroSetPassengerCategoryMsg != nil {
+		l = m.MetroSetPassengerCategoryMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroPayFareMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroPayFareMsg != nil {
+		l = m.MetroPayFareMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroUpdatePassengerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroUpdatePassengerMsg != nil {
+		l = m.MetroUpdatePassengerMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroDeregisterPassengerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroDeregisterPassengerMsg != nil {
+		l = m.MetroDeregisterPassengerMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroCreateStationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroCreateStationMsg != nil {
+		l = m.MetroCreateStationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroUpdateStationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroUpdateStationMsg != nil {
+		l = m.MetroUpdateStationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroRetireStationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroRetireStationMsg != nil {
+		l = m.MetroRetireStationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroRegisterTrainMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroRegisterTrainMsg != nil {
+		l = m.MetroRegisterTrainMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroReassignTrainMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroReassignTrainMsg != nil {
+		l = m.MetroReassignTrainMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroDecommissionTrainMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroDecommissionTrainMsg != nil {
+		l = m.MetroDecommissionTrainMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroReportEquipmentStatusMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroReportEquipmentStatusMsg != nil {
+		l = m.MetroReportEquipmentStatusMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroSetConnectionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroSetConnectionMsg != nil {
+		l = m.MetroSetConnectionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroPublishTimetableMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroPublishTimetableMsg != nil {
+		l = m.MetroPublishTimetableMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroSupersedeTimetableMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroSupersedeTimetableMsg != nil {
+		l = m.MetroSupersedeTimetableMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroScheduleStopMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroScheduleStopMsg != nil {
+		l = m.MetroScheduleStopMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroTrainDepartStationEventMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroTrainDepartStationEventMsg != nil {
+		l = m.MetroTrainDepartStationEventMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroOpenIncidentMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroOpenIncidentMsg != nil {
+		l = m.MetroOpenIncidentMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroUpdateIncidentMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroUpdateIncidentMsg != nil {
+		l = m.MetroUpdateIncidentMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroResolveIncidentMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroResolveIncidentMsg != nil {
+		l = m.MetroResolveIncidentMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroScheduleFareChangeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroScheduleFareChangeMsg != nil {
+		l = m.MetroScheduleFareChangeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroStartJourneyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroStartJourneyMsg != nil {
+		l = m.MetroStartJourneyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroEndJourneyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroEndJourneyMsg != nil {
+		l = m.MetroEndJourneyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MsgfeeSetMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroPurchasePassMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroPurchasePassMsg != nil {
+		l = m.MetroPurchasePassMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroCancelPassMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroCancelPassMsg != nil {
+		l = m.MetroCancelPassMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MsgfeeUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		l = m.MsgfeeUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MetroReleasePassMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroReleasePassMsg != nil {
+		l = m.MetroReleasePassMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteBatchMsg_Union) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ExecuteBatchMsg_Union_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateMsg != nil {
+		l = m.MultisigCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *CronTask_EscrowReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMsg != nil {
+		l = m.EscrowReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_MetroMarkTrainSilentMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroMarkTrainSilentMsg != nil {
+		l = m.MetroMarkTrainSilentMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_MetroActivateFareChangeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroActivateFareChangeMsg != nil {
+		l = m.MetroActivateFareChangeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_MetroExpireJourneyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetroExpireJourneyMsg != nil {
+		l = m.MetroExpireJourneyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Tx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &cash.FeeInfo{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
+			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashSendMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CurrencyCreateMsg{v}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecuteBatchMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ExecuteBatchMsg{v}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &paychan.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_PaychanCreateMsg{v}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanTransferMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &paychan.TransferMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_PaychanTransferMsg{v}
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanCloseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &paychan.CloseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_PaychanCloseMsg{v}
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &migration.UpgradeSchemaMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroRegisterPassengerMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.RegisterPassengerMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroRegisterPassengerMsg{v}
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroTrainArriveStationEventMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.TrainArriveStationEventMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroTrainArriveStationEventMsg{v}
+			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroCreateLineMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.CreateLineMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroCreateLineMsg{v}
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroUpdateLineMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.UpdateLineMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroUpdateLineMsg{v}
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroTapInMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.TapInMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroTapInMsg{v}
+			iNdEx = postIndex
+		case 75:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroTapOutMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.TapOutMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroTapOutMsg{v}
+			iNdEx = postIndex
+		case 76:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroUpdateFareTableMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.UpdateFareTableMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroUpdateFareTableMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroSetPassengerCategoryMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.SetPassengerCategoryMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroSetPassengerCategoryMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroPayFareMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.PayFareMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroPayFareMsg{v}
+			iNdEx = postIndex
+		case 79:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroUpdatePassengerMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.UpdatePassengerMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroUpdatePassengerMsg{v}
+			iNdEx = postIndex
+		case 80:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroDeregisterPassengerMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.DeregisterPassengerMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroDeregisterPassengerMsg{v}
+			iNdEx = postIndex
+		case 81:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroCreateStationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.CreateStationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroCreateStationMsg{v}
+			iNdEx = postIndex
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroUpdateStationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.UpdateStationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroUpdateStationMsg{v}
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroRetireStationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.RetireStationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroRetireStationMsg{v}
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroRegisterTrainMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.RegisterTrainMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroRegisterTrainMsg{v}
+			iNdEx = postIndex
+		case 85:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroReassignTrainMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.ReassignTrainMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroReassignTrainMsg{v}
+			iNdEx = postIndex
+		case 86:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroDecommissionTrainMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.DecommissionTrainMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroDecommissionTrainMsg{v}
+			iNdEx = postIndex
+		case 87:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroReportEquipmentStatusMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.ReportEquipmentStatusMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroReportEquipmentStatusMsg{v}
+			iNdEx = postIndex
+		case 88:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroSetConnectionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.SetConnectionMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroSetConnectionMsg{v}
+			iNdEx = postIndex
+		case 89:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroPublishTimetableMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.PublishTimetableMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroPublishTimetableMsg{v}
+			iNdEx = postIndex
+		case 90:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroSupersedeTimetableMsg", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &metro.SupersedeTimetableMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sum = &Tx_MetroSupersedeTimetableMsg{v}
+			iNdEx = postIndex
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroScheduleStopMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1467,16 +5018,50 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fees == nil {
-				m.Fees = &cash.FeeInfo{}
+			v := &metro.ScheduleStopMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sum = &Tx_MetroScheduleStopMsg{v}
+			iNdEx = postIndex
+		case 92:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroTrainDepartStationEventMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.TrainDepartStationEventMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Tx_MetroTrainDepartStationEventMsg{v}
 			iNdEx = postIndex
-		case 2:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroOpenIncidentMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1503,16 +5088,17 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &metro.OpenIncidentMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Tx_MetroOpenIncidentMsg{v}
 			iNdEx = postIndex
-		case 4:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroUpdateIncidentMsg", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1522,27 +5108,30 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
-			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			v := &metro.UpdateIncidentMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroUpdateIncidentMsg{v}
 			iNdEx = postIndex
-		case 51:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroResolveIncidentMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1569,15 +5158,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &metro.ResolveIncidentMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CashSendMsg{v}
+			m.Sum = &Tx_MetroResolveIncidentMsg{v}
 			iNdEx = postIndex
-		case 56:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroScheduleFareChangeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1604,15 +5193,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &metro.ScheduleFareChangeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigCreateMsg{v}
+			m.Sum = &Tx_MetroScheduleFareChangeMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroStartJourneyMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1639,15 +5228,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &metro.StartJourneyMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigUpdateMsg{v}
+			m.Sum = &Tx_MetroStartJourneyMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroEndJourneyMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1674,15 +5263,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &metro.EndJourneyMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			m.Sum = &Tx_MetroEndJourneyMsg{v}
 			iNdEx = postIndex
-		case 60:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1709,15 +5298,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ExecuteBatchMsg{}
+			v := &msgfee.SetMsgFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ExecuteBatchMsg{v}
+			m.Sum = &Tx_MsgfeeSetMsgFeeMsg{v}
 			iNdEx = postIndex
-		case 69:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroPurchasePassMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1744,15 +5333,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &migration.UpgradeSchemaMsg{}
+			v := &metro.PurchasePassMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			m.Sum = &Tx_MetroPurchasePassMsg{v}
 			iNdEx = postIndex
-		case 70:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetroRegisterPassengerMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroCancelPassMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1779,15 +5368,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &metro.RegisterPassengerMsg{}
+			v := &metro.CancelPassMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MetroRegisterPassengerMsg{v}
+			m.Sum = &Tx_MetroCancelPassMsg{v}
 			iNdEx = postIndex
-		case 71:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetroTrainArriveStationEventMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1814,11 +5403,46 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &metro.TrainArriveStationEventMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MetroTrainArriveStationEventMsg{v}
+			m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroReleasePassMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.ReleasePassMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MetroReleasePassMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2185,6 +5809,111 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_EscrowReleaseMsg{v}
 			iNdEx = postIndex
+		case 96:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroMarkTrainSilentMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.MarkTrainSilentMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_MetroMarkTrainSilentMsg{v}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroActivateFareChangeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.ActivateFareChangeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_MetroActivateFareChangeMsg{v}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetroExpireJourneyMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &metro.ExpireJourneyMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_MetroExpireJourneyMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
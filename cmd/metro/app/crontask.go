@@ -3,6 +3,7 @@ package metro
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/orkunkl/metro-app/x/metro"
 )
 
 // CronTaskMarshaler is a task marshaler implementation to be used by the weave
@@ -20,6 +21,18 @@ func (taskMarshaler) MarshalTask(auth []weave.Condition, msg weave.Msg) ([]byte,
 	}
 
 	switch msg := msg.(type) {
+	case *metro.MarkTrainSilentMsg:
+		t.Sum = &CronTask_MetroMarkTrainSilentMsg{
+			MetroMarkTrainSilentMsg: msg,
+		}
+	case *metro.ActivateFareChangeMsg:
+		t.Sum = &CronTask_MetroActivateFareChangeMsg{
+			MetroActivateFareChangeMsg: msg,
+		}
+	case *metro.ExpireJourneyMsg:
+		t.Sum = &CronTask_MetroExpireJourneyMsg{
+			MetroExpireJourneyMsg: msg,
+		}
 	default:
 		return nil, errors.Wrapf(errors.ErrType, "unsupported message type: %T", msg)
 	}
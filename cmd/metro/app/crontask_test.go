@@ -0,0 +1,344 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
+	"github.com/orkunkl/metro-app/x/metro"
+)
+
+func TestTrainWatchdog(t *testing.T) {
+	trainSigner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	if err := gconf.Save(db, "metro", &metro.Configuration{
+		Metadata:            &weave.Metadata{Schema: 1},
+		Admin:               weavetest.NewCondition().Address(),
+		TrainSilenceMinutes: 5,
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if err := metro.NewStationBucket().Save(db, &metro.Station{
+		Metadata: &weave.Metadata{Schema: 1},
+		Station:  "fahrettin altay",
+	}); err != nil {
+		t.Fatalf("cannot save station: %s", err)
+	}
+	if err := metro.NewTrainBucket().Save(db, &metro.Train{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  trainSigner.Address(),
+	}); err != nil {
+		t.Fatalf("cannot save train: %s", err)
+	}
+
+	auth := &weavetest.Auth{Signer: trainSigner}
+	arrivals := metro.NewTrainArriveStationEventHandler(auth, cron.NewScheduler(CronTaskMarshaler))
+	ticker := cron.NewTicker(CronStack(), CronTaskMarshaler)
+
+	start := time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)
+	height := int64(0)
+	blockCtx := func(at time.Time) weave.Context {
+		height++
+		ctx := weave.WithHeight(context.Background(), height)
+		return weave.WithBlockTime(ctx, at)
+	}
+	arrive := func(at time.Time) {
+		t.Helper()
+		_, err := arrivals.Deliver(blockCtx(at), db, &weavetest.Tx{Msg: &metro.TrainArriveStationEventMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			StationKey: weavetest.SequenceID(1),
+			TrainKey:   weavetest.SequenceID(1),
+		}})
+		if err != nil {
+			t.Fatalf("cannot arrive: %+v", err)
+		}
+	}
+	tick := func(at time.Time) metro.Train {
+		t.Helper()
+		ticker.Tick(blockCtx(at), db)
+		var train metro.Train
+		if err := metro.NewTrainBucket().ByID(db, weavetest.SequenceID(1), &train); err != nil {
+			t.Fatalf("cannot load train: %s", err)
+		}
+		return train
+	}
+
+	arrive(start)
+	if train := tick(start.Add(4 * time.Minute)); train.IsSilent() {
+		t.Fatal("train must not be silent before the threshold")
+	}
+
+	// An arrival pushes the watchdog forward.
+	arrive(start.Add(4 * time.Minute))
+	if train := tick(start.Add(6 * time.Minute)); train.IsSilent() {
+		t.Fatal("rescheduled watchdog must not fire early")
+	}
+
+	silentAt := start.Add(10 * time.Minute)
+	train := tick(silentAt)
+	if !train.IsSilent() || !train.SilentSince.Time().Equal(silentAt) {
+		t.Fatalf("want train silent since %s, got %+v", silentAt, train)
+	}
+	if len(train.WatchdogTaskID) != 0 {
+		t.Fatalf("executed watchdog task must be cleared, got %x", train.WatchdogTaskID)
+	}
+
+	arrive(start.Add(12 * time.Minute))
+	if train := tick(start.Add(13 * time.Minute)); train.IsSilent() {
+		t.Fatal("arrival must clear the silent flag")
+	}
+
+	// The watchdog message cannot be submitted by the train itself.
+	_, err := metro.NewMarkTrainSilentHandler(auth).Deliver(blockCtx(start), db, &weavetest.Tx{Msg: &metro.MarkTrainSilentMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		TrainKey: weavetest.SequenceID(1),
+	}})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+}
+
+func TestScheduledFareChange(t *testing.T) {
+	fareAdmin := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	if err := gconf.Save(db, "metro", &metro.Configuration{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Admin:     weavetest.NewCondition().Address(),
+		FareAdmin: fareAdmin.Address(),
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	current := &metro.FareTable{
+		Metadata: &weave.Metadata{Schema: 1},
+		BaseFare: coin.NewCoin(3, 0, "TRY"),
+	}
+	if _, err := metro.NewFareTableBucket().Put(db, []byte("current"), current); err != nil {
+		t.Fatalf("cannot save fare table: %s", err)
+	}
+
+	start := time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)
+	activateAt := start.Add(14 * 24 * time.Hour)
+	ctx := weave.WithHeight(weave.WithBlockTime(context.Background(), start), 1)
+
+	h := metro.NewScheduleFareChangeHandler(&weavetest.Auth{Signer: fareAdmin}, cron.NewScheduler(CronTaskMarshaler))
+	res, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &metro.ScheduleFareChangeMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		FareTable: &metro.FareTable{
+			Metadata: &weave.Metadata{Schema: 1},
+			BaseFare: coin.NewCoin(4, 0, "TRY"),
+			Zones: []metro.StationZone{
+				{StationKey: weavetest.SequenceID(1), Zone: 1},
+				{StationKey: weavetest.SequenceID(2), Zone: 2},
+			},
+		},
+		ActivateAt: weave.AsUnixTime(activateAt),
+	}})
+	if err != nil {
+		t.Fatalf("cannot schedule fare change: %+v", err)
+	}
+
+	qr := QueryRouter()
+	pending := func() int {
+		t.Helper()
+		models, err := qr.Handler("/farechanges/pending").Query(db, weave.KeyQueryMod, metro.PendingFareChangeIndexKey)
+		if err != nil {
+			t.Fatalf("cannot query pending fare changes: %+v", err)
+		}
+		return len(models)
+	}
+	baseFare := func() coin.Coin {
+		t.Helper()
+		var table metro.FareTable
+		if err := metro.NewFareTableBucket().One(db, []byte("current"), &table); err != nil {
+			t.Fatalf("cannot load fare table: %s", err)
+		}
+		return table.BaseFare
+	}
+	ticker := cron.NewTicker(CronStack(), CronTaskMarshaler)
+	tick := func(at time.Time, height int64) {
+		ticker.Tick(weave.WithHeight(weave.WithBlockTime(context.Background(), at), height), db)
+	}
+
+	if n := pending(); n != 1 {
+		t.Fatalf("want one pending change, got %d", n)
+	}
+	tick(activateAt.Add(-time.Second), 2)
+	if fare := baseFare(); !fare.Equals(current.BaseFare) {
+		t.Fatalf("fare changed before the activation time: %v", fare)
+	}
+
+	// Tasks due at a block time run in the first block after it.
+	activatedAt := activateAt.Add(time.Second)
+	tick(activatedAt, 3)
+	if fare := baseFare(); !fare.Equals(coin.NewCoin(4, 0, "TRY")) {
+		t.Fatalf("want new base fare, got %v", fare)
+	}
+	if n := pending(); n != 0 {
+		t.Fatalf("want no pending changes, got %d", n)
+	}
+	var change metro.FareChange
+	if err := metro.NewFareChangeBucket().ByID(db, res.Data, &change); err != nil {
+		t.Fatalf("cannot load fare change: %s", err)
+	}
+	if !change.ActivatedAt.Time().Equal(activatedAt) || len(change.TaskID) != 0 {
+		t.Fatalf("unexpected activated change: %+v", change)
+	}
+}
+
+func TestJourneyExpiry(t *testing.T) {
+	gate := weavetest.NewCondition()
+	collector := weavetest.NewCondition().Address()
+	lost := weavetest.NewCondition()
+	regular := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron", "cash")
+	maxFare := coin.NewCoin(5, 0, "METR")
+	if err := gconf.Save(db, "metro", &metro.Configuration{
+		Metadata:          &weave.Metadata{Schema: 1},
+		Admin:             weavetest.NewCondition().Address(),
+		FareCollector:     collector,
+		MaxJourneyMinutes: 120,
+		MaxFare:           &maxFare,
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if err := metro.NewStationBucket().Save(db, &metro.Station{
+		Metadata: &weave.Metadata{Schema: 1},
+		Station:  "fahrettin altay",
+		Gates:    []weave.Address{gate.Address()},
+	}); err != nil {
+		t.Fatalf("cannot save station: %s", err)
+	}
+	ctrl := cash.NewController(cash.NewBucket())
+	for _, p := range []weave.Condition{lost, regular} {
+		if err := metro.NewPassengerBucket().Save(db, &metro.Passenger{
+			Metadata: &weave.Metadata{Schema: 1},
+			Address:  p.Address(),
+		}); err != nil {
+			t.Fatalf("cannot save passenger: %s", err)
+		}
+		if err := ctrl.CoinMint(db, p.Address(), coin.NewCoin(10, 0, "METR")); err != nil {
+			t.Fatalf("cannot fund passenger: %s", err)
+		}
+	}
+	if _, err := metro.NewFareTableBucket().Put(db, []byte("current"), &metro.FareTable{
+		Metadata: &weave.Metadata{Schema: 1},
+		BaseFare: coin.NewCoin(1, 0, "METR"),
+	}); err != nil {
+		t.Fatalf("cannot save fare table: %s", err)
+	}
+
+	start := time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)
+	auth := &weavetest.Auth{Signer: gate}
+	scheduler := cron.NewScheduler(CronTaskMarshaler)
+	ticker := cron.NewTicker(CronStack(), CronTaskMarshaler)
+	height := int64(0)
+	blockCtx := func(at time.Time) weave.Context {
+		height++
+		return weave.WithHeight(weave.WithBlockTime(context.Background(), at), height)
+	}
+	startJourney := func(passenger uint64) {
+		t.Helper()
+		_, err := metro.NewStartJourneyHandler(auth, scheduler).Deliver(blockCtx(start), db, &weavetest.Tx{Msg: &metro.StartJourneyMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PassengerKey: weavetest.SequenceID(passenger),
+			StationKey:   weavetest.SequenceID(1),
+		}})
+		if err != nil {
+			t.Fatalf("cannot start journey: %+v", err)
+		}
+	}
+	journey := func(key uint64) metro.Journey {
+		t.Helper()
+		var j metro.Journey
+		if err := metro.NewJourneyBucket().ByID(db, weavetest.SequenceID(key), &j); err != nil {
+			t.Fatalf("cannot load journey: %s", err)
+		}
+		return j
+	}
+	balance := func(addr weave.Address) coin.Coins {
+		t.Helper()
+		coins, err := ctrl.Balance(db, addr)
+		if err != nil {
+			t.Fatalf("cannot get balance: %s", err)
+		}
+		return coins
+	}
+
+	startJourney(1)
+	startJourney(2)
+	_, err := metro.NewEndJourneyHandler(auth, ctrl, scheduler).Deliver(blockCtx(start.Add(30*time.Minute)), db, &weavetest.Tx{Msg: &metro.EndJourneyMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: weavetest.SequenceID(2),
+		StationKey:   weavetest.SequenceID(1),
+	}})
+	if err != nil {
+		t.Fatalf("cannot end journey: %+v", err)
+	}
+
+	ticker.Tick(blockCtx(start.Add(119*time.Minute)), db)
+	if j := journey(1); !j.IsOpen() {
+		t.Fatalf("journey must not expire early: %+v", j)
+	}
+
+	expiredAt := start.Add(121 * time.Minute)
+	ticker.Tick(blockCtx(expiredAt), db)
+	j := journey(1)
+	if j.Status != metro.JourneyExpired || !j.EndedAt.Time().Equal(expiredAt) || !j.Fare.Equals(maxFare) {
+		t.Fatalf("want journey expired with the maximum fare, got %+v", j)
+	}
+	if got := balance(lost.Address()); !got.Equals(coin.Coins{coin.NewCoinp(5, 0, "METR")}) {
+		t.Fatalf("want maximum fare charged, got %v", got)
+	}
+
+	// The ended journey expiry was cancelled, only the regular fare is paid.
+	if j := journey(2); j.Status != metro.JourneyCompleted {
+		t.Fatalf("want completed journey, got %+v", j)
+	}
+	if got := balance(regular.Address()); !got.Equals(coin.Coins{coin.NewCoinp(9, 0, "METR")}) {
+		t.Fatalf("want regular fare charged, got %v", got)
+	}
+	if got := balance(collector); !got.Equals(coin.Coins{coin.NewCoinp(6, 0, "METR")}) {
+		t.Fatalf("unexpected collected fares: %v", got)
+	}
+}
+
+func TestCronTaskMarshaler(t *testing.T) {
+	auth := []weave.Condition{metro.WatchdogCondition(weavetest.SequenceID(1))}
+	msg := &metro.MarkTrainSilentMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		TrainKey: weavetest.SequenceID(1),
+	}
+	raw, err := CronTaskMarshaler.MarshalTask(auth, msg)
+	if err != nil {
+		t.Fatalf("cannot marshal task: %s", err)
+	}
+	gotAuth, gotMsg, err := CronTaskMarshaler.UnmarshalTask(raw)
+	if err != nil {
+		t.Fatalf("cannot unmarshal task: %s", err)
+	}
+	if len(gotAuth) != 1 || !gotAuth[0].Equals(auth[0]) {
+		t.Fatalf("unexpected conditions: %v", gotAuth)
+	}
+	if silent, ok := gotMsg.(*metro.MarkTrainSilentMsg); !ok || string(silent.TrainKey) != string(msg.TrainKey) {
+		t.Fatalf("unexpected message: %#v", gotMsg)
+	}
+
+	if _, err := CronTaskMarshaler.MarshalTask(auth, &metro.TapInMsg{}); !errors.ErrType.Is(err) {
+		t.Fatalf("want type error for a message that cannot be scheduled, got %+v", err)
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
+	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/validators"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -56,6 +58,8 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 	// collectorAddr is the address where all tx fee's will be
 	// stashed and then distributed to stakeholders
 	collectorAddr := cond1.Address()
+	// gateAddr is the toll gate of the genesis station
+	gateAddr := weave.NewCondition("sigs", "ed25519", []byte{4, 5, 6}).Address()
 
 	return json.Marshal(dict{
 		"cash": array{
@@ -79,6 +83,21 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 				},
 			},
 		},
+		"currencies": array{
+			dict{"ticker": ticker, "name": "metro token"},
+		},
+		// msgfee sets anti-spam fees for message paths that can be
+		// submitted in bulk
+		"msgfee": array{
+			dict{
+				"msg_path": "metro/train_arrive_station",
+				"fee":      coin.Coin{Fractional: 10000000, Ticker: ticker},
+			},
+			dict{
+				"msg_path": "metro/register_passenger",
+				"fee":      coin.Coin{Fractional: 100000000, Ticker: ticker},
+			},
+		},
 		"metro": dict{
 			"station": array{
 				dict{
@@ -90,13 +109,35 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 					"toll_gate_ent": 10,
 					"toll_gate_ex":  8,
 					"entrance_exit": 5,
+					"gates":         array{gateAddr.String()},
 				},
 			},
 			"train": array{
 				dict{
-					"address": cond1.Address().String(),
+					"address":    cond1.Address().String(),
+					"model":      "Alstom Metropolis",
+					"capacity":   1500,
+					"car_count":  6,
+					"home_depot": "hacosman",
 				},
 			},
+			"fare_table": dict{
+				"base_fare": coin.Coin{Whole: 1, Ticker: ticker},
+				"stop_fare": coin.Coin{Fractional: 250000000, Ticker: ticker},
+				"zone_fare": coin.Coin{Fractional: 500000000, Ticker: ticker},
+				"zones": array{
+					dict{"station": 1, "zone": 1},
+				},
+				"discounts": array{
+					dict{"category": "student", "percent": 50},
+					dict{"category": "senior", "percent": 50},
+					dict{"category": "disabled", "percent": 100},
+				},
+				// passes are paid up front and released to the
+				// fare_collector as their period elapses
+				"weekly_pass":  coin.Coin{Whole: 20, Ticker: ticker},
+				"monthly_pass": coin.Coin{Whole: 70, Ticker: ticker},
+			},
 		},
 		"conf": dict{
 			"cash": dict{
@@ -107,13 +148,46 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 				// admin is who can change this redistribution address to other address
 				"admin": addr,
 			},
+			"msgfee": dict{
+				"owner": addr,
+				// fee_admin is who can set message fees
+				"fee_admin": addr,
+			},
+			"metro": dict{
+				// admin is who can manage lines and stations
+				"admin":          addr,
+				"fare_collector": collectorAddr,
+				// fare_admin is who can update the fare table
+				"fare_admin": addr,
+				// category_issuer is who can grant passenger concessions
+				"category_issuer": addr,
+				// fleet_manager is who can register and decommission trains
+				"fleet_manager": addr,
+				// maintainers can report the status of station equipment
+				"maintainers": array{addr},
+				// operators can announce service incidents
+				"operators": array{addr},
+				// train_silence_minutes is how long a train can go without
+				// reporting an arrival before it is marked silent
+				"train_silence_minutes": 10,
+				// journeys not ended within max_journey_minutes are charged
+				// the max_fare
+				"max_journey_minutes": 180,
+				"max_fare":            dict{"whole": 10, "ticker": ticker},
+				// currency_issuer is who can register new currencies
+				"currency_issuer": addr,
+			},
 		},
 		"initialize_schema": []dict{
 			{"pkg": "migration", "ver": 1},
 			{"pkg": "metro", "ver": 1},
+			{"pkg": "msgfee", "ver": 1},
 			{"pkg": "cash", "ver": 1},
+			{"pkg": "cron", "ver": 1},
+			{"pkg": "currency", "ver": 1},
 			{"pkg": "sigs", "ver": 1},
 			{"pkg": "multisig", "ver": 1},
+			{"pkg": "paychan", "ver": 1},
 			{"pkg": "utils", "ver": 1},
 			{"pkg": "validators", "ver": 1},
 		},
@@ -128,7 +202,7 @@ func GenerateApp(options *server.Options) (abci.Application, error) {
 		dbPath = filepath.Join(options.Home, "metro.db")
 	}
 
-	stack := Stack(nil, options.MinFee)
+	stack := Stack(options.MinFee)
 	application, err := Application("metro", stack, TxDecoder, dbPath, options.Debug)
 	if err != nil {
 		return nil, err
@@ -142,8 +216,10 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 	application.WithInit(app.ChainInitializers(
 		&migration.Initializer{},
 		&cash.Initializer{},
+		&currency.Initializer{},
 		&multisig.Initializer{},
 		&validators.Initializer{},
+		&msgfee.Initializer{},
 		&metro.Initializer{},
 	))
 	application.WithLogger(logger)
@@ -153,7 +229,7 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 // InlineApp will take a previously prepared CommitStore and return a complete Application
 func InlineApp(kv weave.CommitKVStore, logger log.Logger, debug bool) abci.Application {
 	minFee := coin.Coin{}
-	stack := Stack(nil, minFee)
+	stack := Stack(minFee)
 	ctx := context.Background()
 	store := app.NewStoreApp("metro", kv, QueryRouter(), ctx)
 	base := app.NewBaseApp(store, TxDecoder, stack, nil, debug)
@@ -3,14 +3,17 @@ package client
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x/sigs"
+	"github.com/orkunkl/metro-app/x/metro"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	"github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -291,6 +294,13 @@ func (cc *BlogClient) SubscribeHeaders(out chan<- *tmtypes.Header) (func(), erro
 	return cancel, nil
 }
 
+// QueryLateArrivals returns a query matching train arrivals that are more
+// than given delay behind the schedule. Use it with Subscribe.
+func QueryLateArrivals(delay time.Duration) tmpubsub.Query {
+	return tmquery.MustParse(fmt.Sprintf("%s='%s' AND %s > %d",
+		tmtypes.EventTypeKey, tmtypes.EventTx, metro.DelayTag, int64(delay/time.Second)))
+}
+
 // Subscribe will take an arbitrary query and push all events to
 // the given channel. If there is no error,
 // returns a cancel function that can be called to cancel
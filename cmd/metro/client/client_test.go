@@ -168,6 +168,11 @@ func TestSubscribeHeaders(t *testing.T) {
 	}
 }
 
+func TestQueryLateArrivals(t *testing.T) {
+	q := QueryLateArrivals(2 * time.Minute)
+	assert.Equal(t, "tm.event='Tx' AND metro.delay > 120", q.String())
+}
+
 func TestSendMultipleTx(t *testing.T) {
 	conn := NewLocalConnection(node)
 	blog := NewClient(conn)
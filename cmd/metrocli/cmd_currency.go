@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/x/currency"
+	app "github.com/orkunkl/metro-app/cmd/metro/app"
+)
+
+func cmdIssueToken(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for registering a new token, for example a dedicated fare
+credit ticker. Transaction must be signed by the currency issuer set in the
+metro configuration of the network.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tickerFl = fl.String("ticker", "", "Ticker of the new token, 3 to 4 upper case characters.")
+		nameFl   = fl.String("name", "", "Human readable name of the token.")
+	)
+	fl.Parse(args)
+
+	msg := &currency.CreateMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Ticker:   *tickerFl,
+		Name:     *nameFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_CurrencyCreateMsg{
+			CurrencyCreateMsg: msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/currency"
+)
+
+func TestCmdIssueTokenHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-ticker", "FARE",
+		"-name", "fare credit",
+	}
+	if err := cmdIssueToken(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new token issue transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*currency.CreateMsg)
+
+	assert.Equal(t, "FARE", msg.Ticker)
+	assert.Equal(t, "fare credit", msg.Name)
+}
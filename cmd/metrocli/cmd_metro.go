@@ -4,8 +4,12 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	app "github.com/orkunkl/metro-app/cmd/metro/app"
 	"github.com/orkunkl/metro-app/x/metro"
 )
@@ -74,3 +78,1275 @@ Train arrives to station
 	_, err := writeTx(output, tx)
 	return err
 }
+
+func cmdTrainDepartStation(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Train departs from the station it arrived at last
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		stationFl = flSeq(fl, "station_key", "", "Primary key of a station")
+		trainFl   = flSeq(fl, "train_key", "", "Primary key of a train")
+	)
+	fl.Parse(args)
+
+	msg := metro.TrainDepartStationEventMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: *stationFl,
+		TrainKey:   *trainFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroTrainDepartStationEventMsg{
+			MetroTrainDepartStationEventMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdCreateLine(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a metro line that visits given stations in order.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		nameFl     = fl.String("name", "", "Name of the line")
+		colorFl    = fl.String("color", "", "Color of the line in #rrggbb format")
+		stationsFl = flSeqList(fl, "stations", "", "Comma separated, ordered primary keys of stations")
+	)
+	fl.Parse(args)
+
+	msg := metro.CreateLineMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Name:        *nameFl,
+		Color:       *colorFl,
+		StationKeys: *stationsFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroCreateLineMsg{
+			MetroCreateLineMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdUpdateLine(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Replace the name, color and stations of a metro line.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		lineFl     = flSeq(fl, "line_key", "", "Primary key of a line")
+		nameFl     = fl.String("name", "", "Name of the line")
+		colorFl    = fl.String("color", "", "Color of the line in #rrggbb format")
+		stationsFl = flSeqList(fl, "stations", "", "Comma separated, ordered primary keys of stations")
+	)
+	fl.Parse(args)
+
+	msg := metro.UpdateLineMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		LineKey:     *lineFl,
+		Name:        *nameFl,
+		Color:       *colorFl,
+		StationKeys: *stationsFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroUpdateLineMsg{
+			MetroUpdateLineMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdTapIn(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Passenger enters a station through a toll gate. Transaction must be signed by
+the toll gate.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+		stationFl   = flSeq(fl, "station_key", "", "Primary key of a station")
+	)
+	fl.Parse(args)
+
+	msg := metro.TapInMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: *passengerFl,
+		StationKey:   *stationFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroTapInMsg{
+			MetroTapInMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdTapOut(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Passenger leaves a station through a toll gate and is charged the fare.
+Transaction must be signed by the toll gate.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+		stationFl   = flSeq(fl, "station_key", "", "Primary key of a station")
+	)
+	fl.Parse(args)
+
+	msg := metro.TapOutMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: *passengerFl,
+		StationKey:   *stationFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroTapOutMsg{
+			MetroTapOutMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdStartJourney(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Passenger starts a journey through a toll gate. A journey that is not ended
+within the maximum duration is charged the maximum fare. Transaction must be
+signed by the toll gate.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+		stationFl   = flSeq(fl, "station_key", "", "Primary key of a station")
+	)
+	fl.Parse(args)
+
+	msg := metro.StartJourneyMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: *passengerFl,
+		StationKey:   *stationFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroStartJourneyMsg{
+			MetroStartJourneyMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdEndJourney(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Passenger ends a journey through a toll gate and is charged the fare.
+Transaction must be signed by the toll gate.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+		stationFl   = flSeq(fl, "station_key", "", "Primary key of a station")
+	)
+	fl.Parse(args)
+
+	msg := metro.EndJourneyMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: *passengerFl,
+		StationKey:   *stationFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroEndJourneyMsg{
+			MetroEndJourneyMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdPurchasePass(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Buy a weekly or monthly pass. The price is held in escrow and released to the
+operator as the period elapses. Transaction must be signed by the passenger.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+		kindFl      = fl.String("kind", "monthly", "One of weekly or monthly.")
+		fromFl      = flTime(fl, "from", nil, "Time the pass becomes valid in UTC. Defaults to the block time.")
+	)
+	fl.Parse(args)
+
+	kind, ok := metro.PassKind_value["PASS_KIND_"+strings.ToUpper(*kindFl)]
+	if !ok {
+		return fmt.Errorf("unknown pass kind %q", *kindFl)
+	}
+	msg := metro.PurchasePassMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: *passengerFl,
+		Kind:         metro.PassKind(kind),
+	}
+	if !fromFl.Time().IsZero() {
+		msg.ValidFrom = fromFl.UnixTime()
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroPurchasePassMsg{
+			MetroPurchasePassMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdCancelPass(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Cancel a pass. The elapsed part of the price is paid to the operator and the
+rest is refunded. Transaction must be signed by the pass holder.
+		`)
+		fl.PrintDefaults()
+	}
+	passFl := flSeq(fl, "pass_key", "", "Primary key of a pass")
+	fl.Parse(args)
+
+	msg := metro.CancelPassMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		PassKey:  *passFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroCancelPassMsg{
+			MetroCancelPassMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdReleasePass(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Pay out the elapsed part of a pass price from its escrow to the operator.
+Transaction can be signed by anyone.
+		`)
+		fl.PrintDefaults()
+	}
+	passFl := flSeq(fl, "pass_key", "", "Primary key of a pass")
+	fl.Parse(args)
+
+	msg := metro.ReleasePassMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		PassKey:  *passFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroReleasePassMsg{
+			MetroReleasePassMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdUpdateFareTable(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Replace the fare table. Transaction must be signed by the fare admin.
+		`)
+		fl.PrintDefaults()
+	}
+	tableFl := flFareTable(fl)
+	fl.Parse(args)
+
+	table, err := tableFl.FareTable()
+	if err != nil {
+		return err
+	}
+	msg := metro.UpdateFareTableMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		FareTable: table,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroUpdateFareTableMsg{
+			MetroUpdateFareTableMsg: &msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdScheduleFareChange(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Announce a fare table that replaces the one in use at given time. Transaction
+must be signed by the fare admin.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tableFl    = flFareTable(fl)
+		activateFl = flTime(fl, "activate", nil, "Time the fare table is put in use in UTC.")
+	)
+	fl.Parse(args)
+
+	table, err := tableFl.FareTable()
+	if err != nil {
+		return err
+	}
+	msg := metro.ScheduleFareChangeMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		FareTable:  table,
+		ActivateAt: activateFl.UnixTime(),
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroScheduleFareChangeMsg{
+			MetroScheduleFareChangeMsg: &msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+// fareTableFlags holds the flags describing a fare table.
+type fareTableFlags struct {
+	base        *coin.Coin
+	stop        *coin.Coin
+	zone        *coin.Coin
+	zones       *string
+	multipliers *string
+	discounts   *string
+	weeklyPass  *coin.Coin
+	monthlyPass *coin.Coin
+}
+
+// flFareTable declares the flags describing a fare table.
+func flFareTable(fl *flag.FlagSet) *fareTableFlags {
+	return &fareTableFlags{
+		base:        flCoin(fl, "base", "", "Fare charged for every trip."),
+		stop:        flCoin(fl, "stop", "", "Fare charged for every stop travelled."),
+		zone:        flCoin(fl, "zone", "", "Fare charged for every zone boundary crossed."),
+		zones:       fl.String("zones", "", "Comma separated station=zone pairs, for example '1=1,2=1,3=2'."),
+		multipliers: fl.String("multipliers", "", "Comma separated UTC time ranges and fare percent, for example '07:00-09:30=150'."),
+		discounts:   fl.String("discounts", "", "Comma separated category=percent pairs, for example 'student=50,senior=50'."),
+		weeklyPass:  flCoin(fl, "weekly_pass", "", "Price of a weekly pass. Weekly passes are not sold if not set."),
+		monthlyPass: flCoin(fl, "monthly_pass", "", "Price of a monthly pass. Monthly passes are not sold if not set."),
+	}
+}
+
+// FareTable returns the fare table described by the parsed flags.
+func (f *fareTableFlags) FareTable() (*metro.FareTable, error) {
+	table := metro.FareTable{
+		Metadata: &weave.Metadata{Schema: 1},
+		BaseFare: *f.base,
+		StopFare: *f.stop,
+		ZoneFare: *f.zone,
+	}
+	for _, pair := range splitPairs(*f.zones) {
+		key, err := numericID(pair[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid zone station %q: %s", pair[0], err)
+		}
+		zone, err := strconv.ParseUint(pair[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zone %q: %s", pair[1], err)
+		}
+		table.Zones = append(table.Zones, metro.StationZone{StationKey: key, Zone: uint32(zone)})
+	}
+	for _, pair := range splitPairs(*f.multipliers) {
+		bounds := strings.SplitN(pair[0], "-", 2)
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid time range %q", pair[0])
+		}
+		start, err := dayMinute(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := dayMinute(bounds[1])
+		if err != nil {
+			return nil, err
+		}
+		percent, err := strconv.ParseUint(pair[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid percent %q: %s", pair[1], err)
+		}
+		table.TimeMultipliers = append(table.TimeMultipliers, metro.TimeMultiplier{
+			StartMinute: start,
+			EndMinute:   end,
+			Percent:     uint32(percent),
+		})
+	}
+	for _, pair := range splitPairs(*f.discounts) {
+		category, err := metro.ParsePassengerCategory(pair[0])
+		if err != nil {
+			return nil, err
+		}
+		percent, err := strconv.ParseUint(pair[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid percent %q: %s", pair[1], err)
+		}
+		table.Discounts = append(table.Discounts, metro.Discount{Category: category, Percent: uint32(percent)})
+	}
+	if !f.weeklyPass.IsZero() {
+		table.WeeklyPass = f.weeklyPass
+	}
+	if !f.monthlyPass.IsZero() {
+		table.MonthlyPass = f.monthlyPass
+	}
+	return &table, nil
+}
+
+func cmdSetPassengerCategory(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Grant a passenger a concession category. Transaction must be signed by the
+category issuer.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+		categoryFl  = fl.String("category", "standard", "One of standard, student, teacher, senior, disabled or disabled_veteran.")
+		expiresFl   = flTime(fl, "expires", inOneYear, "Time when the category expires. Ignored for the standard category.")
+	)
+	fl.Parse(args)
+
+	category, err := metro.ParsePassengerCategory(*categoryFl)
+	if err != nil {
+		return err
+	}
+	msg := metro.SetPassengerCategoryMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: *passengerFl,
+		Category:     category,
+	}
+	if category != metro.CategoryStandard {
+		msg.ExpiresAt = expiresFl.UnixTime()
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroSetPassengerCategoryMsg{
+			MetroSetPassengerCategoryMsg: &msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdPayFare(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Pay the fare of a trip between two stations. Concession holders are charged a
+discounted fare. Transaction must be signed by the passenger.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+		entryFl     = flSeq(fl, "entry_station_key", "", "Primary key of the station the trip starts at")
+		exitFl      = flSeq(fl, "exit_station_key", "", "Primary key of the station the trip ends at")
+	)
+	fl.Parse(args)
+
+	msg := metro.PayFareMsg{
+		Metadata:        &weave.Metadata{Schema: 1},
+		PassengerKey:    *passengerFl,
+		EntryStationKey: *entryFl,
+		ExitStationKey:  *exitFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroPayFareMsg{
+			MetroPayFareMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdUpdatePassenger(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Change the name and optionally the address of a passenger. Transaction must be
+signed by the current passenger address.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+		nameFl      = fl.String("name", "", "New name of the passenger")
+		addressFl   = flAddress(fl, "address", "", "New address of the passenger. Keep empty to not change it.")
+	)
+	fl.Parse(args)
+
+	msg := metro.UpdatePassengerMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: *passengerFl,
+		Name:         *nameFl,
+		Address:      *addressFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroUpdatePassengerMsg{
+			MetroUpdatePassengerMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdDeregisterPassenger(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Close the account of a passenger. Transaction must be signed by the passenger.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		passengerFl = flSeq(fl, "passenger_key", "", "Primary key of a passenger")
+	)
+	fl.Parse(args)
+
+	msg := metro.DeregisterPassengerMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: *passengerFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroDeregisterPassengerMsg{
+			MetroDeregisterPassengerMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdCreateStation(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Open a new station. Transaction must be signed by the metro admin.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		nameFl         = fl.String("name", "", "Name of the station")
+		escalatorFl    = fl.Int64("escalator", 0, "Number of escalators")
+		elevatorFl     = fl.Int64("elevator", 0, "Number of elevators")
+		peronAdaFl     = fl.Bool("peron_ada", false, "Station has an island platform")
+		ticketOfficeFl = fl.Int64("ticket_office", 0, "Number of ticket offices")
+		gateEntFl      = fl.Int64("toll_gate_ent", 0, "Number of entrance toll gates")
+		gateExFl       = fl.Int64("toll_gate_ex", 0, "Number of exit toll gates")
+		entranceFl     = fl.Int64("entrance_exit", 0, "Number of entrances")
+		gatesFl        = fl.String("gates", "", "Comma separated addresses of the toll gates")
+	)
+	fl.Parse(args)
+
+	gates, err := parseAddresses(*gatesFl)
+	if err != nil {
+		return err
+	}
+	msg := metro.CreateStationMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Station:      *nameFl,
+		Escalator:    *escalatorFl,
+		Elevator:     *elevatorFl,
+		IsPeronAda:   *peronAdaFl,
+		TicketOffice: *ticketOfficeFl,
+		TollGateEnt:  *gateEntFl,
+		TollGateEx:   *gateExFl,
+		EntranceExit: *entranceFl,
+		Gates:        gates,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroCreateStationMsg{
+			MetroCreateStationMsg: &msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdUpdateStation(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Replace the details of a station. Transaction must be signed by the metro
+admin.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		stationFl      = flSeq(fl, "station_key", "", "Primary key of a station")
+		nameFl         = fl.String("name", "", "Name of the station")
+		escalatorFl    = fl.Int64("escalator", 0, "Number of escalators")
+		elevatorFl     = fl.Int64("elevator", 0, "Number of elevators")
+		peronAdaFl     = fl.Bool("peron_ada", false, "Station has an island platform")
+		ticketOfficeFl = fl.Int64("ticket_office", 0, "Number of ticket offices")
+		gateEntFl      = fl.Int64("toll_gate_ent", 0, "Number of entrance toll gates")
+		gateExFl       = fl.Int64("toll_gate_ex", 0, "Number of exit toll gates")
+		entranceFl     = fl.Int64("entrance_exit", 0, "Number of entrances")
+		gatesFl        = fl.String("gates", "", "Comma separated addresses of the toll gates")
+	)
+	fl.Parse(args)
+
+	gates, err := parseAddresses(*gatesFl)
+	if err != nil {
+		return err
+	}
+	msg := metro.UpdateStationMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		StationKey:   *stationFl,
+		Station:      *nameFl,
+		Escalator:    *escalatorFl,
+		Elevator:     *elevatorFl,
+		IsPeronAda:   *peronAdaFl,
+		TicketOffice: *ticketOfficeFl,
+		TollGateEnt:  *gateEntFl,
+		TollGateEx:   *gateExFl,
+		EntranceExit: *entranceFl,
+		Gates:        gates,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroUpdateStationMsg{
+			MetroUpdateStationMsg: &msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdRetireStation(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Close a station. Transaction must be signed by the metro admin.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		stationFl = flSeq(fl, "station_key", "", "Primary key of a station")
+	)
+	fl.Parse(args)
+
+	msg := metro.RetireStationMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: *stationFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroRetireStationMsg{
+			MetroRetireStationMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdRegisterTrain(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Add a new train to the fleet. Transaction must be signed by the fleet manager.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		addressFl  = flAddress(fl, "address", "", "Address of the on-board unit that signs train reports")
+		modelFl    = fl.String("model", "", "Rolling stock model")
+		capacityFl = fl.Uint("capacity", 0, "Number of passengers the train can carry")
+		carsFl     = fl.Uint("cars", 0, "Number of cars")
+		depotFl    = fl.String("depot", "", "Home depot of the train")
+	)
+	fl.Parse(args)
+
+	msg := metro.RegisterTrainMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Address:   *addressFl,
+		Model:     *modelFl,
+		Capacity:  uint32(*capacityFl),
+		CarCount:  uint32(*carsFl),
+		HomeDepot: *depotFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroRegisterTrainMsg{
+			MetroRegisterTrainMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdReassignTrain(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Change the signing address of a train. Transaction must be signed by the fleet
+manager.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		trainFl   = flSeq(fl, "train_key", "", "Primary key of a train")
+		addressFl = flAddress(fl, "address", "", "Address of the new on-board unit")
+	)
+	fl.Parse(args)
+
+	msg := metro.ReassignTrainMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		TrainKey: *trainFl,
+		Address:  *addressFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroReassignTrainMsg{
+			MetroReassignTrainMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdDecommissionTrain(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Take a train out of service. Transaction must be signed by the fleet manager.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		trainFl = flSeq(fl, "train_key", "", "Primary key of a train")
+	)
+	fl.Parse(args)
+
+	msg := metro.DecommissionTrainMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		TrainKey: *trainFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroDecommissionTrainMsg{
+			MetroDecommissionTrainMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdReportEquipment(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Report the status of a station equipment unit. Transaction must be signed by
+one of the maintainers.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		stationFl = flSeq(fl, "station_key", "", "Primary key of a station")
+		typeFl    = fl.String("type", "", "One of escalator, elevator, toll_gate_ent, toll_gate_ex or ticket_office.")
+		idFl      = fl.String("id", "", "Identifier of the unit within the station, for example E1.")
+		statusFl  = fl.String("status", "operational", "One of operational, maintenance or out_of_order.")
+	)
+	fl.Parse(args)
+
+	typ, ok := metro.EquipmentType_value["EQUIPMENT_TYPE_"+strings.ToUpper(*typeFl)]
+	if !ok {
+		return fmt.Errorf("unknown equipment type %q", *typeFl)
+	}
+	status, ok := metro.EquipmentStatus_value["EQUIPMENT_STATUS_"+strings.ToUpper(*statusFl)]
+	if !ok {
+		return fmt.Errorf("unknown equipment status %q", *statusFl)
+	}
+	msg := metro.ReportEquipmentStatusMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: *stationFl,
+		Type:       metro.EquipmentType(typ),
+		Identifier: *idFl,
+		Status:     metro.EquipmentStatus(status),
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroReportEquipmentStatusMsg{
+			MetroReportEquipmentStatusMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdSetConnection(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Connect two neighbouring stations, change the travel time between them or
+remove the connection by setting zero travel time. Transaction must be signed
+by the metro admin.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		fromFl    = flSeq(fl, "from", "", "Primary key of a station")
+		toFl      = flSeq(fl, "to", "", "Primary key of the neighbouring station")
+		minutesFl = fl.Uint("minutes", 0, "Travel time between the stations in minutes")
+	)
+	fl.Parse(args)
+
+	msg := metro.SetConnectionMsg{
+		Metadata:       &weave.Metadata{Schema: 1},
+		FromStationKey: *fromFl,
+		ToStationKey:   *toFl,
+		TravelMinutes:  uint32(*minutesFl),
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroSetConnectionMsg{
+			MetroSetConnectionMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdPublishTimetable(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Publish the timetable of a line for given service pattern and direction. Only
+one timetable can be active at a time, use supersede-timetable to replace it.
+Transaction must be signed by the metro admin.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		lineFl      = flSeq(fl, "line_key", "", "Primary key of a line")
+		serviceFl   = fl.String("service", "weekday", "One of weekday, saturday or holiday.")
+		directionFl = fl.String("direction", "outbound", "One of outbound or inbound.")
+		stopsFl     = fl.String("stops", "", "Comma separated station=departures pairs with UTC departures separated by '|', for example '1=06:00|06:10,2=06:03|06:13'.")
+	)
+	fl.Parse(args)
+
+	service, ok := metro.ServicePattern_value["SERVICE_PATTERN_"+strings.ToUpper(*serviceFl)]
+	if !ok {
+		return fmt.Errorf("unknown service pattern %q", *serviceFl)
+	}
+	direction, ok := metro.Direction_value["DIRECTION_"+strings.ToUpper(*directionFl)]
+	if !ok {
+		return fmt.Errorf("unknown direction %q", *directionFl)
+	}
+	stops, err := parseTimetableStops(*stopsFl)
+	if err != nil {
+		return err
+	}
+	msg := metro.PublishTimetableMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		LineKey:   *lineFl,
+		Service:   metro.ServicePattern(service),
+		Direction: metro.Direction(direction),
+		Stops:     stops,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroPublishTimetableMsg{
+			MetroPublishTimetableMsg: &msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdSupersedeTimetable(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Replace an active timetable with a new one for the same line, service pattern
+and direction. Transaction must be signed by the metro admin.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		timetableFl = flSeq(fl, "timetable_key", "", "Primary key of the active timetable")
+		stopsFl     = fl.String("stops", "", "Comma separated station=departures pairs with UTC departures separated by '|', for example '1=06:00|06:10,2=06:03|06:13'.")
+	)
+	fl.Parse(args)
+
+	stops, err := parseTimetableStops(*stopsFl)
+	if err != nil {
+		return err
+	}
+	msg := metro.SupersedeTimetableMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		TimetableKey: *timetableFl,
+		Stops:        stops,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroSupersedeTimetableMsg{
+			MetroSupersedeTimetableMsg: &msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdScheduleStop(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Plan a call of a train at a station. Arrivals are compared with the nearest
+planned call to compute the delay. Transaction must be signed by the metro
+admin.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		trainFl   = flSeq(fl, "train_key", "", "Primary key of a train")
+		stationFl = flSeq(fl, "station_key", "", "Primary key of a station")
+		lineFl    = flSeq(fl, "line_key", "", "Primary key of the line the train serves")
+		plannedFl = flTime(fl, "planned", nil, "Planned arrival time in UTC.")
+	)
+	fl.Parse(args)
+
+	msg := metro.ScheduleStopMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		TrainKey:   *trainFl,
+		StationKey: *stationFl,
+		LineKey:    *lineFl,
+		PlannedAt:  plannedFl.UnixTime(),
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroScheduleStopMsg{
+			MetroScheduleStopMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdOpenIncident(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Announce a service incident or planned works affecting given stations. Arrivals
+at affected stations are tagged with the incident while it is active.
+Transaction must be signed by a metro operator.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		severityFl = fl.String("severity", "minor", "One of info, minor, major or suspended.")
+		stationsFl = flSeqList(fl, "stations", "", "Comma separated primary keys of affected stations")
+		startFl    = flTime(fl, "start", time.Now, "Time the incident starts affecting the service in UTC.")
+		endFl      = flTime(fl, "end", nil, "Expected end of the incident in UTC.")
+		messagesFl = fl.String("messages", "", "Comma separated language=text pairs, for example 'en=Delays,tr=Gecikmeler'.")
+	)
+	fl.Parse(args)
+
+	severity, ok := metro.IncidentSeverity_value["INCIDENT_SEVERITY_"+strings.ToUpper(*severityFl)]
+	if !ok {
+		return fmt.Errorf("unknown incident severity %q", *severityFl)
+	}
+	msg := metro.OpenIncidentMsg{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Severity:      metro.IncidentSeverity(severity),
+		StationKeys:   *stationsFl,
+		StartedAt:     startFl.UnixTime(),
+		ExpectedEndAt: endFl.UnixTime(),
+		Messages:      parseLocalizedTexts(*messagesFl),
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroOpenIncidentMsg{
+			MetroOpenIncidentMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdUpdateIncident(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Change the severity, affected stations, expected end or messages of an open
+incident. Transaction must be signed by a metro operator.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		incidentFl = flSeq(fl, "incident_key", "", "Primary key of an open incident")
+		severityFl = fl.String("severity", "minor", "One of info, minor, major or suspended.")
+		stationsFl = flSeqList(fl, "stations", "", "Comma separated primary keys of affected stations")
+		endFl      = flTime(fl, "end", nil, "Expected end of the incident in UTC.")
+		messagesFl = fl.String("messages", "", "Comma separated language=text pairs, for example 'en=Delays,tr=Gecikmeler'.")
+	)
+	fl.Parse(args)
+
+	severity, ok := metro.IncidentSeverity_value["INCIDENT_SEVERITY_"+strings.ToUpper(*severityFl)]
+	if !ok {
+		return fmt.Errorf("unknown incident severity %q", *severityFl)
+	}
+	msg := metro.UpdateIncidentMsg{
+		Metadata:      &weave.Metadata{Schema: 1},
+		IncidentKey:   *incidentFl,
+		Severity:      metro.IncidentSeverity(severity),
+		StationKeys:   *stationsFl,
+		ExpectedEndAt: endFl.UnixTime(),
+		Messages:      parseLocalizedTexts(*messagesFl),
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroUpdateIncidentMsg{
+			MetroUpdateIncidentMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdResolveIncident(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Mark an incident as resolved. Transaction must be signed by a metro operator.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		incidentFl = flSeq(fl, "incident_key", "", "Primary key of an open incident")
+	)
+	fl.Parse(args)
+
+	msg := metro.ResolveIncidentMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		IncidentKey: *incidentFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MetroResolveIncidentMsg{
+			MetroResolveIncidentMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+// parseLocalizedTexts parses comma separated language=text pairs.
+func parseLocalizedTexts(s string) []metro.LocalizedText {
+	var texts []metro.LocalizedText
+	for _, pair := range splitPairs(s) {
+		texts = append(texts, metro.LocalizedText{Language: pair[0], Text: pair[1]})
+	}
+	return texts
+}
+
+// parseTimetableStops parses comma separated station=departures pairs, where
+// departures are HH:MM times separated by '|'.
+func parseTimetableStops(s string) ([]metro.TimetableStop, error) {
+	var stops []metro.TimetableStop
+	for _, pair := range splitPairs(s) {
+		key, err := numericID(pair[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid stop station %q: %s", pair[0], err)
+		}
+		stop := metro.TimetableStop{StationKey: key}
+		for _, raw := range strings.Split(pair[1], "|") {
+			minute, err := dayMinute(raw)
+			if err != nil {
+				return nil, err
+			}
+			stop.Departures = append(stop.Departures, minute)
+		}
+		stops = append(stops, stop)
+	}
+	return stops, nil
+}
+
+// parseAddresses parses comma separated list of addresses.
+func parseAddresses(s string) ([]weave.Address, error) {
+	var addrs []weave.Address
+	for _, raw := range strings.Split(s, ",") {
+		if raw = strings.TrimSpace(raw); raw == "" {
+			continue
+		}
+		a, err := weave.ParseAddress(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %s", raw, err)
+		}
+		addrs = append(addrs, a)
+	}
+	return addrs, nil
+}
+
+// inOneYear returns the time one year from now.
+func inOneYear() time.Time {
+	return time.Now().AddDate(1, 0, 0)
+}
+
+// splitPairs splits comma separated key=value pairs. Malformed pairs result
+// in an empty value.
+func splitPairs(s string) [][2]string {
+	var pairs [][2]string
+	for _, raw := range strings.Split(s, ",") {
+		if raw = strings.TrimSpace(raw); raw == "" {
+			continue
+		}
+		var pair [2]string
+		tokens := strings.SplitN(raw, "=", 2)
+		pair[0] = tokens[0]
+		if len(tokens) == 2 {
+			pair[1] = tokens[1]
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+// dayMinute returns the minute of the day of given HH:MM time.
+func dayMinute(s string) (uint32, error) {
+	if s == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: %s", s, err)
+	}
+	return uint32(t.Hour()*60 + t.Minute()), nil
+}
@@ -1,9 +1,15 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x/msgfee"
+	app "github.com/orkunkl/metro-app/cmd/metro/app"
 )
 
 func msgfeeConf(nodeUrl string, msgPath string) (*coin.Coin, error) {
@@ -19,3 +25,64 @@ func msgfeeConf(nodeUrl string, msgPath string) (*coin.Coin, error) {
 		return nil, errors.Wrap(err, "cannot get fee")
 	}
 }
+
+func cmdSetMsgFee(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for setting a message fee, for example an anti-spam fee
+for metro/train_arrive_station. Transaction must be signed by the fee
+administrator.
+
+Use a zero fee to unset an existing fee.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		msgPathFl = fl.String("path", "", "Message path for which the fee is set.")
+		amountFl  = flCoin(fl, "amount", "", "An amount to which the fee is set. Use zero value to set no fee.")
+	)
+	fl.Parse(args)
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MsgfeeSetMsgFeeMsg{
+			MsgfeeSetMsgFeeMsg: &msgfee.SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  *msgPathFl,
+				Fee:      *amountFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdUpdateMsgFeeConfiguration(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for updating msgfee extension configuration.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		ownerFl    = flAddress(fl, "owner", "", "A new configuration owner.")
+		feeAdminFl = flAddress(fl, "fee-admin", "", "A new fee admin address.")
+	)
+	fl.Parse(args)
+
+	tx := &app.Tx{
+		Sum: &app.Tx_MsgfeeUpdateConfigurationMsg{
+			MsgfeeUpdateConfigurationMsg: &msgfee.UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &msgfee.Configuration{
+					Metadata: &weave.Metadata{Schema: 1},
+					Owner:    *ownerFl,
+					FeeAdmin: *feeAdminFl,
+				},
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
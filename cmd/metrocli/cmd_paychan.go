@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/x/paychan"
+	app "github.com/orkunkl/metro-app/cmd/metro/app"
+)
+
+func cmdOpenChannel(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for opening a payment channel. Source pre-funds the
+channel with the total amount, that is then paid out to the destination in
+signed, off-chain increments. Use transfer-channel to create a payment.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		keyPathFl = fl.String("key", env("BLOGCLI_PRIV_KEY", os.Getenv("HOME")+"/.metro.priv.key"),
+			"Path to the private key file of the channel source. Its public key is used to verify payments.")
+		dstFl     = flAddress(fl, "dst", "", "Address of the payments recipient, for example the metro operator.")
+		amountFl  = flCoin(fl, "amount", "", "Total amount deposited in the channel.")
+		timeoutFl = flTime(fl, "timeout", func() time.Time { return time.Now().Add(30 * 24 * time.Hour) },
+			"Time after which the source can close the channel and get the remaining funds back.")
+		memoFl = fl.String("memo", "", "Short description of the channel.")
+	)
+	fl.Parse(args)
+
+	key, err := decodePrivateKey(*keyPathFl)
+	if err != nil {
+		return fmt.Errorf("cannot load private key: %s", err)
+	}
+
+	pubkey := key.PublicKey()
+	msg := &paychan.CreateMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Source:       pubkey.Address(),
+		SourcePubkey: pubkey,
+		Destination:  *dstFl,
+		Total:        amountFl,
+		Timeout:      timeoutFl.UnixTime(),
+		Memo:         *memoFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_PaychanCreateMsg{
+			PaychanCreateMsg: msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdTransferChannel(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for a payment channel transfer. The payment is signed by
+the channel source and can be submitted by anyone, for example a toll gate
+settling in bulk.
+
+Amount is cumulative: it is the total that the destination receives from the
+channel so far, and must grow with every payment. Only the latest payment has
+to be submitted.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("METROCLI_TM_ADDR", "https://BLOG.NETWORK.iov.one:443"),
+			"Tendermint node address, used to fetch the chain ID if not provided. You can use METROCLI_TM_ADDR environment variable to set it.")
+		keyPathFl = fl.String("key", env("BLOGCLI_PRIV_KEY", os.Getenv("HOME")+"/.metro.priv.key"),
+			"Path to the private key file of the channel source.")
+		chainIDFl = fl.String("chain-id", "", "Chain ID of the network. If not provided, fetched from the tendermint node.")
+		channelFl = flSeq(fl, "channel", "", "ID of the payment channel.")
+		amountFl  = flCoin(fl, "amount", "", "Cumulative amount transferred to the destination.")
+		memoFl    = fl.String("memo", "", "Short description of the payment.")
+	)
+	fl.Parse(args)
+
+	key, err := decodePrivateKey(*keyPathFl)
+	if err != nil {
+		return fmt.Errorf("cannot load private key: %s", err)
+	}
+
+	chainID := *chainIDFl
+	if chainID == "" {
+		genesis, err := fetchGenesis(*tmAddrFl)
+		if err != nil {
+			return fmt.Errorf("cannot fetch genesis: %s", err)
+		}
+		chainID = genesis.ChainID
+	}
+
+	payment := &paychan.Payment{
+		ChainID:   chainID,
+		ChannelID: *channelFl,
+		Amount:    amountFl,
+		Memo:      *memoFl,
+	}
+	raw, err := payment.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot serialize payment: %s", err)
+	}
+	sig, err := key.Sign(raw)
+	if err != nil {
+		return fmt.Errorf("cannot sign payment: %s", err)
+	}
+
+	msg := &paychan.TransferMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Payment:   payment,
+		Signature: sig,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_PaychanTransferMsg{
+			PaychanTransferMsg: msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdCloseChannel(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for closing a payment channel. Destination can close the
+channel at any time. Source can close it only after the timeout. Remaining
+funds are returned to the source.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		channelFl = flSeq(fl, "channel", "", "ID of the payment channel.")
+		memoFl    = fl.String("memo", "", "Short description of the closing reason.")
+	)
+	fl.Parse(args)
+
+	msg := &paychan.CloseMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		ChannelID: *channelFl,
+		Memo:      *memoFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_PaychanCloseMsg{
+			PaychanCloseMsg: msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdInspectChannel(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Print the state of a payment channel, including the amount that can still be
+transferred. Use "query -path /paychans" to list all channels.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("METROCLI_TM_ADDR", "https://BLOG.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use METROCLI_TM_ADDR environment variable to set it.")
+		channelFl = flSeq(fl, "channel", "", "ID of the payment channel.")
+	)
+	fl.Parse(args)
+
+	if len(*channelFl) == 0 {
+		return errors.New("channel ID is required")
+	}
+
+	var pc paychan.PaymentChannel
+	if err := paychan.NewPaymentChannelBucket().One(tendermintStore(*tmAddrFl), *channelFl, &pc); err != nil {
+		return fmt.Errorf("cannot get payment channel: %s", err)
+	}
+	remaining, err := pc.Total.Subtract(*pc.Transferred)
+	if err != nil {
+		return fmt.Errorf("cannot compute remaining amount: %s", err)
+	}
+
+	pretty, err := json.MarshalIndent(struct {
+		*paychan.PaymentChannel
+		Remaining coin.Coin `json:"remaining"`
+	}{
+		PaymentChannel: &pc,
+		Remaining:      remaining,
+	}, "", "\t")
+	if err != nil {
+		return fmt.Errorf("cannot JSON serialize: %s", err)
+	}
+	_, err = output.Write(pretty)
+	return err
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/paychan"
+)
+
+func TestCmdOpenChannelHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-key", mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex))),
+		"-dst", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+		"-amount", "20 METR",
+		"-timeout", "2030-01-01 00:00",
+		"-memo", "monthly commute",
+	}
+	if err := cmdOpenChannel(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new payment channel transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*paychan.CreateMsg)
+
+	assert.Equal(t, fromHex(t, addr), []byte(msg.Source))
+	assert.Equal(t, fromHex(t, "b1ca7e78f74423ae01da3b51e676934d9105f282"), []byte(msg.Destination))
+	assert.Equal(t, coin.NewCoinp(20, 0, "METR"), msg.Total)
+	assert.Equal(t, "monthly commute", msg.Memo)
+}
+
+func TestCmdTransferChannelHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-key", mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex))),
+		"-chain-id", "clitest-chain",
+		"-channel", "3",
+		"-amount", "2 METR",
+	}
+	if err := cmdTransferChannel(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new payment transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*paychan.TransferMsg)
+
+	assert.Equal(t, "clitest-chain", msg.Payment.ChainID)
+	assert.Equal(t, sequenceID(3), msg.Payment.ChannelID)
+	assert.Equal(t, coin.NewCoinp(2, 0, "METR"), msg.Payment.Amount)
+
+	// Payment must be signed by the channel source.
+	key, err := decodePrivateKey(mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex))))
+	if err != nil {
+		t.Fatalf("cannot load private key: %s", err)
+	}
+	raw, err := msg.Payment.Marshal()
+	if err != nil {
+		t.Fatalf("cannot serialize payment: %s", err)
+	}
+	if !key.PublicKey().Verify(raw, msg.Signature) {
+		t.Fatal("invalid payment signature")
+	}
+}
@@ -11,11 +11,14 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/paychan"
 	"github.com/orkunkl/metro-app/cmd/metro/client"
 	"github.com/orkunkl/metro-app/x/metro"
 )
@@ -32,7 +35,7 @@ Execute a ABCI query and print JSON encoded result.
 		tmAddrFl = fl.String("tm", env("METROCLI_TM_ADDR", "https://blog.NETWORK:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use METROCLI_TM_ADDR environment variable to set it.")
 		pathFl        = fl.String("path", "", "Path to be queried. Must be one of the supported.")
-		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates")
+		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'station/2006-01-02 15:04' for /tr-arrival/station_time, 'station/seconds' for /tr-departure/station_dwell and 'entry/exit/student/2006-01-02 15:04' for /farequote and 'address/2006-01-02 15:04' for /passes/valid and 'station/2006-01-02 15:04' for /incidents/active. Use -prefix with a station ID to list /equipment of a station. Use 'from/to/accessible' for /routes. Use 'station/2006-01-02 15:04/limit' for /departures.")
 		prefixQueryFl = fl.Bool("prefix", false, "If true, use prefix queries instead of the exact match with provided data.")
 	)
 	fl.Parse(args)
@@ -103,21 +106,211 @@ var queries = map[string]struct {
 	// from decKey if we use secondary index for matching.
 	encID func(string) ([]byte, error)
 }{
+	"/stations": {
+		newObj: func() model { return &metro.Station{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
 	"/trains": {
 		newObj: func() model { return &metro.Train{} },
 		decKey: rawKey,
 		encID:  numericID,
 	},
+	"/trainchanges": {
+		newObj: func() model { return &metro.TrainChange{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/trainchanges/train": {
+		newObj: func() model { return &metro.TrainChange{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
 	"/passengers": {
 		newObj: func() model { return &metro.Passenger{} },
 		decKey: rawKey,
 		encID:  numericID,
 	},
+	"/passengers/address": {
+		newObj: func() model { return &metro.Passenger{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
 	"/tr-arrival": {
 		newObj: func() model { return &metro.TrainArriveStationEvent{} },
 		decKey: rawKey,
 		encID:  numericID,
 	},
+	"/tr-arrival/station": {
+		newObj: func() model { return &metro.TrainArriveStationEvent{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/tr-arrival/train": {
+		newObj: func() model { return &metro.TrainArriveStationEvent{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/tr-arrival/station_time": {
+		newObj: func() model { return &metro.TrainArriveStationEvent{} },
+		decKey: rawKey,
+		encID:  stationTimeID,
+	},
+	"/tr-departure": {
+		newObj: func() model { return &metro.TrainDepartStationEvent{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/tr-departure/station": {
+		newObj: func() model { return &metro.TrainDepartStationEvent{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/tr-departure/train": {
+		newObj: func() model { return &metro.TrainDepartStationEvent{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/tr-departure/station_dwell": {
+		newObj: func() model { return &metro.TrainDepartStationEvent{} },
+		decKey: rawKey,
+		encID:  stationDwellID,
+	},
+	"/farechanges": {
+		newObj: func() model { return &metro.FareChange{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/farechanges/pending": {
+		newObj: func() model { return &metro.FareChange{} },
+		decKey: rawKey,
+		encID:  stringID,
+	},
+	"/incidents": {
+		newObj: func() model { return &metro.Incident{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/incidents/open": {
+		newObj: func() model { return &metro.Incident{} },
+		decKey: rawKey,
+		encID:  stringID,
+	},
+	"/incidents/active": {
+		newObj: func() model { return &metro.Incident{} },
+		decKey: rawKey,
+		encID:  activeIncidentsID,
+	},
+	"/lines": {
+		newObj: func() model { return &metro.Line{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/trips": {
+		newObj: func() model { return &metro.Trip{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/trips/passenger": {
+		newObj: func() model { return &metro.Trip{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/trips/open": {
+		newObj: func() model { return &metro.Trip{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/journeys": {
+		newObj: func() model { return &metro.Journey{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/journeys/passenger": {
+		newObj: func() model { return &metro.Journey{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/journeys/open": {
+		newObj: func() model { return &metro.Journey{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/passes": {
+		newObj: func() model { return &metro.Pass{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/passes/passenger": {
+		newObj: func() model { return &metro.Pass{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/passes/holder": {
+		newObj: func() model { return &metro.Pass{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+	"/passes/valid": {
+		newObj: func() model { return &metro.Pass{} },
+		decKey: rawKey,
+		encID:  validPassID,
+	},
+	"/fares": {
+		newObj: func() model { return &metro.FareTable{} },
+		decKey: rawKey,
+		encID:  stringID,
+	},
+	"/farequote": {
+		newObj: func() model { return &metro.FareQuote{} },
+		decKey: rawKey,
+		encID:  fareQuoteID,
+	},
+	"/equipment": {
+		newObj: func() model { return &metro.Equipment{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/connections": {
+		newObj: func() model { return &metro.Connection{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/routes": {
+		newObj: func() model { return &metro.Route{} },
+		decKey: rawKey,
+		encID:  routeID,
+	},
+	"/timetables": {
+		newObj: func() model { return &metro.Timetable{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/timetables/line": {
+		newObj: func() model { return &metro.Timetable{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/scheduledstops": {
+		newObj: func() model { return &metro.ScheduledStop{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/linedelays": {
+		newObj: func() model { return &metro.LineDelay{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/positions": {
+		newObj: func() model { return &metro.TrainPosition{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/departures": {
+		newObj: func() model { return &metro.Departures{} },
+		decKey: rawKey,
+		encID:  departuresID,
+	},
 	"/wallets": {
 		newObj: func() model { return &cash.Set{} },
 		decKey: rawKey,
@@ -128,6 +321,16 @@ var queries = map[string]struct {
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
+	"/paychans": {
+		newObj: func() model { return &paychan.PaymentChannel{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/tokens": {
+		newObj: func() model { return &currency.TokenInfo{} },
+		decKey: stringKey,
+		encID:  stringID,
+	},
 }
 
 // model is an entity used by weave to store data. This interface is
@@ -171,6 +374,185 @@ func refID(s string) ([]byte, error) {
 	return orm.MarshalVersionedID(ref), nil
 }
 
+// stationTimeID expects `station/time` pair, where time is using the
+// flagTimeFormat. Providing only the station ID allows to query all arrivals
+// at the station, using a prefix query.
+func stationTimeID(s string) ([]byte, error) {
+	tokens := strings.SplitN(s, "/", 2)
+	station, err := numericID(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode station: %s", err)
+	}
+	if len(tokens) == 1 {
+		return station, nil
+	}
+	t, err := time.Parse(flagTimeFormat, tokens[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode time: %s", err)
+	}
+	return metro.StationTimeIndexKey(station, weave.AsUnixTime(t)), nil
+}
+
+// stationDwellID expects `station/seconds` pair. Providing only the station ID
+// allows to query all departures from the station ordered by the dwell time,
+// using a prefix query.
+func stationDwellID(s string) ([]byte, error) {
+	tokens := strings.SplitN(s, "/", 2)
+	station, err := numericID(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode station: %s", err)
+	}
+	if len(tokens) == 1 {
+		return station, nil
+	}
+	dwell, err := strconv.ParseInt(tokens[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode dwell: %s", err)
+	}
+	return metro.StationDwellIndexKey(station, dwell), nil
+}
+
+// fareQuoteID expects `entry/exit/category/time` tuple, where time is using
+// the flagTimeFormat. Category and time are optional and default to a standard
+// passenger travelling now.
+func fareQuoteID(s string) ([]byte, error) {
+	tokens := strings.SplitN(s, "/", 4)
+	if len(tokens) < 2 {
+		return nil, errors.New("invalid format, use 'entry/exit/category/time'")
+	}
+	entry, err := numericID(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode entry station: %s", err)
+	}
+	exit, err := numericID(tokens[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode exit station: %s", err)
+	}
+	req := metro.FareQuoteRequest{
+		EntryStationKey: entry,
+		ExitStationKey:  exit,
+		At:              weave.AsUnixTime(time.Now()),
+	}
+	if len(tokens) > 2 {
+		if req.Category, err = metro.ParsePassengerCategory(tokens[2]); err != nil {
+			return nil, err
+		}
+	}
+	if len(tokens) > 3 {
+		t, err := time.Parse(flagTimeFormat, tokens[3])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode time: %s", err)
+		}
+		req.At = weave.AsUnixTime(t)
+	}
+	return req.Marshal()
+}
+
+// validPassID expects an address, optionally followed by `/time` at which
+// the pass is checked. The current time is used if not provided.
+func validPassID(s string) ([]byte, error) {
+	tokens := strings.SplitN(s, "/", 2)
+	addr, err := weave.ParseAddress(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode address: %s", err)
+	}
+	req := metro.ValidPassRequest{
+		Address: addr,
+		At:      weave.AsUnixTime(time.Now()),
+	}
+	if len(tokens) > 1 {
+		t, err := time.Parse(flagTimeFormat, tokens[1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode time: %s", err)
+		}
+		req.At = weave.AsUnixTime(t)
+	}
+	return req.Marshal()
+}
+
+// activeIncidentsID expects a station ID, optionally followed by `/time` at
+// which the incidents are active. The current time is used if not provided.
+func activeIncidentsID(s string) ([]byte, error) {
+	tokens := strings.SplitN(s, "/", 2)
+	station, err := numericID(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode station: %s", err)
+	}
+	req := metro.ActiveIncidentsRequest{
+		StationKey: station,
+		At:         weave.AsUnixTime(time.Now()),
+	}
+	if len(tokens) > 1 {
+		t, err := time.Parse(flagTimeFormat, tokens[1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode time: %s", err)
+		}
+		req.At = weave.AsUnixTime(t)
+	}
+	return req.Marshal()
+}
+
+// routeID expects `from/to` pair of station IDs, optionally followed by
+// `/accessible` to plan a route for passengers with reduced mobility.
+func routeID(s string) ([]byte, error) {
+	tokens := strings.Split(s, "/")
+	if len(tokens) < 2 || len(tokens) > 3 {
+		return nil, errors.New("invalid format, use 'from/to' or 'from/to/accessible'")
+	}
+	from, err := numericID(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode from station: %s", err)
+	}
+	to, err := numericID(tokens[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode to station: %s", err)
+	}
+	req := metro.RouteRequest{
+		FromStationKey: from,
+		ToStationKey:   to,
+	}
+	if len(tokens) == 3 {
+		if tokens[2] != "accessible" {
+			return nil, fmt.Errorf("unknown route option %q", tokens[2])
+		}
+		req.Accessible = true
+	}
+	return req.Marshal()
+}
+
+// departuresID expects a station ID, optionally followed by `/time` using the
+// flagTimeFormat and `/limit`. Time defaults to now.
+func departuresID(s string) ([]byte, error) {
+	tokens := strings.SplitN(s, "/", 3)
+	station, err := numericID(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode station: %s", err)
+	}
+	req := metro.DeparturesRequest{
+		StationKey: station,
+		At:         weave.AsUnixTime(time.Now()),
+	}
+	if len(tokens) > 1 {
+		t, err := time.Parse(flagTimeFormat, tokens[1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode time: %s", err)
+		}
+		req.At = weave.AsUnixTime(t)
+	}
+	if len(tokens) > 2 {
+		n, err := strconv.ParseUint(tokens[2], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode limit: %s", err)
+		}
+		req.Limit = uint32(n)
+	}
+	return req.Marshal()
+}
+
+func stringID(s string) ([]byte, error) {
+	return []byte(s), nil
+}
+
 func addressID(s string) ([]byte, error) {
 	return weave.ParseAddress(s)
 }
@@ -208,6 +590,11 @@ func sequenceKey(raw []byte) (string, error) {
 	return fmt.Sprint(int64(n)), nil
 }
 
+func stringKey(raw []byte) (string, error) {
+	// Skip the prefix, being the characters before : (including separator)
+	return string(raw[bytes.Index(raw, []byte(":"))+1:]), nil
+}
+
 func rawKey(raw []byte) (string, error) {
 	return hex.EncodeToString(raw), nil
 }
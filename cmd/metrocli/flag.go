@@ -179,6 +179,44 @@ func (b *flagseq) Set(raw string) error {
 	return nil
 }
 
+// flSeqList returns a list of sequence values that is being initialized with
+// given default value and optionally overwritten by a command line argument if
+// provided. Sequences are separated by a comma and each one can use any of the
+// formats supported by flSeq.
+func flSeqList(fl *flag.FlagSet, name, defaultVal, usage string) *flagseqlist {
+	var l flagseqlist
+	if defaultVal != "" {
+		if err := l.Set(defaultVal); err != nil {
+			flagDie("Cannot parse %q sequence list flag value. %s", name, err)
+		}
+	}
+	fl.Var(&l, name, usage)
+	return &l
+}
+
+type flagseqlist [][]byte
+
+func (l flagseqlist) String() string {
+	chunks := make([]string, 0, len(l))
+	for _, b := range l {
+		chunks = append(chunks, flagseq(b).String())
+	}
+	return strings.Join(chunks, ",")
+}
+
+func (l *flagseqlist) Set(raw string) error {
+	var val [][]byte
+	for _, chunk := range strings.Split(raw, ",") {
+		b, err := unpackSequence(strings.TrimSpace(chunk))
+		if err != nil {
+			return err
+		}
+		val = append(val, b)
+	}
+	*l = val
+	return nil
+}
+
 func flFraction(fl *flag.FlagSet, name, defaultVal, usage string) *flagfraction {
 	var ff flagfraction
 	if defaultVal != "" {
@@ -36,16 +36,23 @@ import (
 var commands = map[string]func(input io.Reader, output io.Writer, args []string) error{
 	"as-batch":                  cmdAsBatch,
 	"as-sequence":               cmdAsSequence,
+	"close-channel":             cmdCloseChannel,
 	"from-sequence":             cmdFromSequence,
+	"inspect-channel":           cmdInspectChannel,
+	"issue-token":               cmdIssueToken,
 	"keyaddr":                   cmdKeyaddr,
 	"keygen":                    cmdKeygen,
 	"mnemonic":                  cmdMnemonic,
 	"multisig":                  cmdMultisig,
+	"open-channel":              cmdOpenChannel,
 	"query":                     cmdQuery,
 	"send-tokens":               cmdSendTokens,
+	"set-msgfee":                cmdSetMsgFee,
 	"set-validators":            cmdSetValidators,
 	"sign":                      cmdSignTransaction,
 	"submit":                    cmdSubmitTransaction,
+	"transfer-channel":          cmdTransferChannel,
+	"update-msgfee-config":      cmdUpdateMsgFeeConfiguration,
 	"version":                   cmdVersion,
 	"view":                      cmdTransactionView,
 	"with-fee":                  cmdWithFee,
@@ -53,6 +60,36 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"with-multisig-participant": cmdWithMultisigParticipant,
 	"register-passenger":        cmdRegisterPassenger,
 	"train-arrive-at-station":   cmdTrainArriveStation,
+	"train-depart-from-station": cmdTrainDepartStation,
+	"create-line":               cmdCreateLine,
+	"update-line":               cmdUpdateLine,
+	"tap-in":                    cmdTapIn,
+	"tap-out":                   cmdTapOut,
+	"start-journey":             cmdStartJourney,
+	"end-journey":               cmdEndJourney,
+	"purchase-pass":             cmdPurchasePass,
+	"cancel-pass":               cmdCancelPass,
+	"release-pass":              cmdReleasePass,
+	"update-fare-table":         cmdUpdateFareTable,
+	"schedule-fare-change":      cmdScheduleFareChange,
+	"set-passenger-category":    cmdSetPassengerCategory,
+	"pay-fare":                  cmdPayFare,
+	"update-passenger":          cmdUpdatePassenger,
+	"deregister-passenger":      cmdDeregisterPassenger,
+	"create-station":            cmdCreateStation,
+	"update-station":            cmdUpdateStation,
+	"retire-station":            cmdRetireStation,
+	"register-train":            cmdRegisterTrain,
+	"reassign-train":            cmdReassignTrain,
+	"decommission-train":        cmdDecommissionTrain,
+	"report-equipment":          cmdReportEquipment,
+	"set-connection":            cmdSetConnection,
+	"publish-timetable":         cmdPublishTimetable,
+	"supersede-timetable":       cmdSupersedeTimetable,
+	"schedule-stop":             cmdScheduleStop,
+	"open-incident":             cmdOpenIncident,
+	"update-incident":           cmdUpdateIncident,
+	"resolve-incident":          cmdResolveIncident,
 }
 
 func main() {
@@ -1,6 +1,11 @@
 package metro
 
 import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 )
 
@@ -28,6 +33,32 @@ func NewTrainBucket() orm.SerialModelBucket {
 	return b
 }
 
+type TrainChangeBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewTrainChangeBucket returns a new train audit trail bucket
+func NewTrainChangeBucket() orm.SerialModelBucket {
+	b := &TrainChangeBucket{
+		orm.NewSerialModelBucket("trainchg", &TrainChange{},
+			orm.WithIndexSerial("train", trainChangeTrainIndexer, false),
+		),
+	}
+	return b
+}
+
+// trainChangeTrainIndexer enables querying the audit trail of a train
+func trainChangeTrainIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	c, ok := obj.Value().(*TrainChange)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return c.TrainKey, nil
+}
+
 type PassengerBucket struct {
 	orm.SerialModelBucket
 }
@@ -35,11 +66,30 @@ type PassengerBucket struct {
 // NewPassengerBucket returns a new passenger bucket
 func NewPassengerBucket() orm.SerialModelBucket {
 	b := &PassengerBucket{
-		orm.NewSerialModelBucket("pass", &Passenger{}),
+		orm.NewSerialModelBucket("pass", &Passenger{},
+			orm.WithIndexSerial("address", passengerAddressIndexer, true),
+		),
 	}
 	return b
 }
 
+// passengerAddressIndexer indexes passengers by address. Being unique, it
+// ensures an address can be bound to a single passenger only. Deregistered
+// passengers release their address.
+func passengerAddressIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	p, ok := obj.Value().(*Passenger)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	if p.IsDeregistered() {
+		return nil, nil
+	}
+	return p.Address, nil
+}
+
 type TrainArriveStationEventBucket struct {
 	orm.SerialModelBucket
 }
@@ -47,7 +97,536 @@ type TrainArriveStationEventBucket struct {
 // NewTrainArriveStationEvent returns a new train event bucket
 func NewTrainArriveStationEventBucket() orm.SerialModelBucket {
 	b := &TrainArriveStationEventBucket{
-		orm.NewSerialModelBucket("traiarr", &TrainArriveStationEvent{}),
+		orm.NewSerialModelBucket("traiarr", &TrainArriveStationEvent{},
+			orm.WithIndexSerial("station", arrivalStationIndexer, false),
+			orm.WithIndexSerial("train", arrivalTrainIndexer, false),
+			orm.WithIndexSerial("station_time", arrivalStationTimeIndexer, false),
+		),
+	}
+	return b
+}
+
+// arrivalStationIndexer enables querying arrival events by station key
+func arrivalStationIndexer(obj orm.Object) ([]byte, error) {
+	e, err := asArrivalEvent(obj)
+	if err != nil {
+		return nil, err
+	}
+	return e.StationKey, nil
+}
+
+// arrivalTrainIndexer enables querying arrival events by train key
+func arrivalTrainIndexer(obj orm.Object) ([]byte, error) {
+	e, err := asArrivalEvent(obj)
+	if err != nil {
+		return nil, err
+	}
+	return e.TrainKey, nil
+}
+
+// arrivalStationTimeIndexer enables querying arrival events of a station
+// ordered by the arrival time
+func arrivalStationTimeIndexer(obj orm.Object) ([]byte, error) {
+	e, err := asArrivalEvent(obj)
+	if err != nil {
+		return nil, err
+	}
+	return StationTimeIndexKey(e.StationKey, e.ArrivedAt), nil
+}
+
+func asArrivalEvent(obj orm.Object) (*TrainArriveStationEvent, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	e, ok := obj.Value().(*TrainArriveStationEvent)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return e, nil
+}
+
+// StationTimeIndexKey returns the "station_time" index key of an arrival at
+// given station and time. Time is encoded in big endian so that keys of a
+// single station are sorted chronologically.
+func StationTimeIndexKey(stationKey []byte, t weave.UnixTime) []byte {
+	key := make([]byte, len(stationKey)+8)
+	copy(key, stationKey)
+	binary.BigEndian.PutUint64(key[len(stationKey):], uint64(t))
+	return key
+}
+
+type TrainDepartStationEventBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewTrainDepartStationEventBucket returns a new train departure bucket
+func NewTrainDepartStationEventBucket() orm.SerialModelBucket {
+	b := &TrainDepartStationEventBucket{
+		orm.NewSerialModelBucket("traidep", &TrainDepartStationEvent{},
+			orm.WithIndexSerial("station", departureStationIndexer, false),
+			orm.WithIndexSerial("train", departureTrainIndexer, false),
+			orm.WithIndexSerial("arrival", departureArrivalIndexer, true),
+			orm.WithIndexSerial("station_dwell", departureStationDwellIndexer, false),
+		),
+	}
+	return b
+}
+
+// departureStationIndexer enables querying departure events by station key
+func departureStationIndexer(obj orm.Object) ([]byte, error) {
+	e, err := asDepartureEvent(obj)
+	if err != nil {
+		return nil, err
+	}
+	return e.StationKey, nil
+}
+
+// departureTrainIndexer enables querying departure events by train key
+func departureTrainIndexer(obj orm.Object) ([]byte, error) {
+	e, err := asDepartureEvent(obj)
+	if err != nil {
+		return nil, err
+	}
+	return e.TrainKey, nil
+}
+
+// departureArrivalIndexer indexes departures by the arrival they close.
+// Being unique, it ensures an arrival is closed only once.
+func departureArrivalIndexer(obj orm.Object) ([]byte, error) {
+	e, err := asDepartureEvent(obj)
+	if err != nil {
+		return nil, err
+	}
+	return e.ArrivalKey, nil
+}
+
+// departureStationDwellIndexer enables querying departure events of a
+// station ordered by the dwell time
+func departureStationDwellIndexer(obj orm.Object) ([]byte, error) {
+	e, err := asDepartureEvent(obj)
+	if err != nil {
+		return nil, err
+	}
+	return StationDwellIndexKey(e.StationKey, e.Dwell), nil
+}
+
+func asDepartureEvent(obj orm.Object) (*TrainDepartStationEvent, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	e, ok := obj.Value().(*TrainDepartStationEvent)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return e, nil
+}
+
+// StationDwellIndexKey returns the "station_dwell" index key of a departure
+// from given station after given dwell time in seconds. Dwell time is encoded
+// in big endian so that keys of a single station are sorted from the shortest
+// to the longest stop.
+func StationDwellIndexKey(stationKey []byte, dwell int64) []byte {
+	key := make([]byte, len(stationKey)+8)
+	copy(key, stationKey)
+	binary.BigEndian.PutUint64(key[len(stationKey):], uint64(dwell))
+	return key
+}
+
+type LineBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewLineBucket returns a new line bucket
+func NewLineBucket() orm.SerialModelBucket {
+	b := &LineBucket{
+		orm.NewSerialModelBucket("line", &Line{},
+			orm.WithIndexSerial("name", lineNameIndexer, true),
+		),
+	}
+	return b
+}
+
+// lineNameIndexer indexes lines by name. Being unique, it ensures two lines
+// cannot share a name.
+func lineNameIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	l, ok := obj.Value().(*Line)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return []byte(l.Name), nil
+}
+
+type TripBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewTripBucket returns a new trip bucket
+func NewTripBucket() orm.SerialModelBucket {
+	b := &TripBucket{
+		orm.NewSerialModelBucket("trip", &Trip{},
+			orm.WithIndexSerial("passenger", tripPassengerIndexer, false),
+			orm.WithIndexSerial("open", openTripIndexer, true),
+		),
+	}
+	return b
+}
+
+// tripPassengerIndexer enables querying trips by passenger key
+func tripPassengerIndexer(obj orm.Object) ([]byte, error) {
+	t, err := asTrip(obj)
+	if err != nil {
+		return nil, err
+	}
+	return t.PassengerKey, nil
+}
+
+// openTripIndexer indexes trips that are in progress by passenger key. Being
+// unique, it ensures a passenger cannot tap in twice without tapping out.
+func openTripIndexer(obj orm.Object) ([]byte, error) {
+	t, err := asTrip(obj)
+	if err != nil {
+		return nil, err
+	}
+	if !t.IsOpen() {
+		return nil, nil
+	}
+	return t.PassengerKey, nil
+}
+
+func asTrip(obj orm.Object) (*Trip, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	t, ok := obj.Value().(*Trip)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return t, nil
+}
+
+type JourneyBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewJourneyBucket returns a new journey bucket
+func NewJourneyBucket() orm.SerialModelBucket {
+	b := &JourneyBucket{
+		orm.NewSerialModelBucket("journey", &Journey{},
+			orm.WithIndexSerial("passenger", journeyPassengerIndexer, false),
+			orm.WithIndexSerial("open", openJourneyIndexer, true),
+		),
+	}
+	return b
+}
+
+// journeyPassengerIndexer enables querying journeys by passenger key
+func journeyPassengerIndexer(obj orm.Object) ([]byte, error) {
+	j, err := asJourney(obj)
+	if err != nil {
+		return nil, err
+	}
+	return j.PassengerKey, nil
+}
+
+// openJourneyIndexer indexes journeys that are in progress by passenger key.
+// Being unique, it ensures a passenger has at most one open journey.
+func openJourneyIndexer(obj orm.Object) ([]byte, error) {
+	j, err := asJourney(obj)
+	if err != nil {
+		return nil, err
+	}
+	if !j.IsOpen() {
+		return nil, nil
+	}
+	return j.PassengerKey, nil
+}
+
+func asJourney(obj orm.Object) (*Journey, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	j, ok := obj.Value().(*Journey)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return j, nil
+}
+
+type PassBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewPassBucket returns a new pass bucket
+func NewPassBucket() orm.SerialModelBucket {
+	b := &PassBucket{
+		orm.NewSerialModelBucket("metropass", &Pass{},
+			orm.WithIndexSerial("passenger", passPassengerIndexer, false),
+			orm.WithIndexSerial("holder", passHolderIndexer, false),
+		),
+	}
+	return b
+}
+
+// passPassengerIndexer enables querying passes by passenger key
+func passPassengerIndexer(obj orm.Object) ([]byte, error) {
+	p, err := asPass(obj)
+	if err != nil {
+		return nil, err
+	}
+	return p.PassengerKey, nil
+}
+
+// passHolderIndexer enables querying passes by the address of the holder,
+// as presented at a gate
+func passHolderIndexer(obj orm.Object) ([]byte, error) {
+	p, err := asPass(obj)
+	if err != nil {
+		return nil, err
+	}
+	return p.Holder, nil
+}
+
+func asPass(obj orm.Object) (*Pass, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	p, ok := obj.Value().(*Pass)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return p, nil
+}
+
+// NewFareTableBucket returns a new fare table bucket
+func NewFareTableBucket() orm.ModelBucket {
+	return orm.NewModelBucket("faretable", &FareTable{})
+}
+
+type ConnectionBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewConnectionBucket returns a new station connection bucket
+func NewConnectionBucket() orm.SerialModelBucket {
+	b := &ConnectionBucket{
+		orm.NewSerialModelBucket("conn", &Connection{},
+			orm.WithIndexSerial("pair", connectionPairIndexer, true),
+		),
+	}
+	return b
+}
+
+// connectionPairIndexer indexes connections by the pair of stations they
+// join. Being unique, it ensures two stations are connected only once.
+func connectionPairIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	c, ok := obj.Value().(*Connection)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return ConnectionPairKey(c.FromStationKey, c.ToStationKey), nil
+}
+
+// ConnectionPairKey returns the pair index key of a connection between two
+// stations. The key does not depend on the direction.
+func ConnectionPairKey(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	key := make([]byte, 0, len(a)+len(b))
+	key = append(key, a...)
+	return append(key, b...)
+}
+
+type TimetableBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewTimetableBucket returns a new timetable bucket
+func NewTimetableBucket() orm.SerialModelBucket {
+	b := &TimetableBucket{
+		orm.NewSerialModelBucket("timetable", &Timetable{},
+			orm.WithIndexSerial("line", timetableLineIndexer, false),
+			orm.WithIndexSerial("active", activeTimetableIndexer, true),
+		),
+	}
+	return b
+}
+
+// timetableLineIndexer enables querying timetables of a line
+func timetableLineIndexer(obj orm.Object) ([]byte, error) {
+	t, err := asTimetable(obj)
+	if err != nil {
+		return nil, err
+	}
+	return t.LineKey, nil
+}
+
+// activeTimetableIndexer indexes active timetables by line, service pattern
+// and direction. Being unique, it ensures only one timetable applies at a
+// time.
+func activeTimetableIndexer(obj orm.Object) ([]byte, error) {
+	t, err := asTimetable(obj)
+	if err != nil {
+		return nil, err
+	}
+	if !t.IsActive() {
+		return nil, nil
+	}
+	return ActiveTimetableIndexKey(t.LineKey, t.Service, t.Direction), nil
+}
+
+// ActiveTimetableIndexKey returns the "active" index key of the timetable of
+// given line, service pattern and direction.
+func ActiveTimetableIndexKey(lineKey []byte, service ServicePattern, direction Direction) []byte {
+	key := make([]byte, len(lineKey)+2)
+	copy(key, lineKey)
+	key[len(lineKey)] = byte(service)
+	key[len(lineKey)+1] = byte(direction)
+	return key
+}
+
+func asTimetable(obj orm.Object) (*Timetable, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	t, ok := obj.Value().(*Timetable)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return t, nil
+}
+
+type ScheduledStopBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewScheduledStopBucket returns a new scheduled stop bucket
+func NewScheduledStopBucket() orm.SerialModelBucket {
+	b := &ScheduledStopBucket{
+		orm.NewSerialModelBucket("schedstop", &ScheduledStop{},
+			orm.WithIndexSerial("train_station", scheduledStopTrainStationIndexer, false),
+		),
 	}
 	return b
 }
+
+// scheduledStopTrainStationIndexer enables querying stops planned for a
+// train at a station.
+func scheduledStopTrainStationIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	s, ok := obj.Value().(*ScheduledStop)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	return TrainStationIndexKey(s.TrainKey, s.StationKey), nil
+}
+
+// TrainStationIndexKey returns the "train_station" index key of the stops
+// planned for given train at given station.
+func TrainStationIndexKey(trainKey, stationKey []byte) []byte {
+	key := make([]byte, 0, len(trainKey)+len(stationKey))
+	key = append(key, trainKey...)
+	return append(key, stationKey...)
+}
+
+// NewLineDelayBucket returns a new line delay bucket. Delays are stored under
+// the line key.
+func NewLineDelayBucket() orm.ModelBucket {
+	return orm.NewModelBucket("linedelay", &LineDelay{})
+}
+
+// NewTrainPositionBucket returns a new train position bucket. Positions are
+// stored under the train key.
+func NewTrainPositionBucket() orm.ModelBucket {
+	return orm.NewModelBucket("trainpos", &TrainPosition{})
+}
+
+type IncidentBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewIncidentBucket returns a new incident bucket
+func NewIncidentBucket() orm.SerialModelBucket {
+	b := &IncidentBucket{
+		orm.NewSerialModelBucket("incident", &Incident{},
+			orm.WithIndexSerial("open", openIncidentIndexer, false),
+		),
+	}
+	return b
+}
+
+// OpenIncidentIndexKey is the "open" index key shared by all incidents that
+// are not resolved yet.
+var OpenIncidentIndexKey = []byte("open")
+
+// openIncidentIndexer indexes incidents that are not resolved yet, so that
+// they can be found without scanning the whole history.
+func openIncidentIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	i, ok := obj.Value().(*Incident)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	if i.IsResolved() {
+		return nil, nil
+	}
+	return OpenIncidentIndexKey, nil
+}
+
+type FareChangeBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewFareChangeBucket returns a new fare change bucket
+func NewFareChangeBucket() orm.SerialModelBucket {
+	b := &FareChangeBucket{
+		orm.NewSerialModelBucket("farechange", &FareChange{},
+			orm.WithIndexSerial("pending", pendingFareChangeIndexer, false),
+		),
+	}
+	return b
+}
+
+// PendingFareChangeIndexKey is the "pending" index key shared by all fare
+// changes that are not activated yet.
+var PendingFareChangeIndexKey = []byte("pending")
+
+// pendingFareChangeIndexer indexes fare changes waiting for their
+// activation time, so that they can be audited.
+func pendingFareChangeIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	c, ok := obj.Value().(*FareChange)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "unexpected %T model", obj.Value())
+	}
+	if c.IsActivated() {
+		return nil, nil
+	}
+	return PendingFareChangeIndexKey, nil
+}
+
+// NewEquipmentBucket returns a new station equipment bucket. Use
+// EquipmentKey to build the key of a unit.
+func NewEquipmentBucket() orm.ModelBucket {
+	return orm.NewModelBucket("equipment", &Equipment{})
+}
+
+// EquipmentKey returns the key under which the equipment unit of given
+// station is stored. All units of a station share the station key prefix.
+func EquipmentKey(stationKey []byte, identifier string) []byte {
+	key := make([]byte, 0, len(stationKey)+len(identifier))
+	key = append(key, stationKey...)
+	return append(key, identifier...)
+}
@@ -0,0 +1,117 @@
+package metro
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestTrainArriveStationEventIndexes(t *testing.T) {
+	db := store.MemStore()
+	b := NewTrainArriveStationEventBucket()
+
+	stationA := weavetest.SequenceID(1)
+	stationB := weavetest.SequenceID(2)
+	trainA := weavetest.SequenceID(1)
+	trainB := weavetest.SequenceID(2)
+
+	saveAll(t, db, b,
+		&TrainArriveStationEvent{Metadata: &weave.Metadata{Schema: 1}, StationKey: stationA, TrainKey: trainA, ArrivedAt: 300},
+		&TrainArriveStationEvent{Metadata: &weave.Metadata{Schema: 1}, StationKey: stationB, TrainKey: trainA, ArrivedAt: 200},
+		&TrainArriveStationEvent{Metadata: &weave.Metadata{Schema: 1}, StationKey: stationA, TrainKey: trainB, ArrivedAt: 100},
+	)
+
+	var byStation []TrainArriveStationEvent
+	if err := b.ByIndex(db, "station", stationA, &byStation); err != nil {
+		t.Fatalf("cannot query by station: %s", err)
+	}
+	if len(byStation) != 2 {
+		t.Fatalf("want 2 arrivals at station, got %d", len(byStation))
+	}
+
+	var byTrain []TrainArriveStationEvent
+	if err := b.ByIndex(db, "train", trainB, &byTrain); err != nil {
+		t.Fatalf("cannot query by train: %s", err)
+	}
+	if len(byTrain) != 1 || byTrain[0].ArrivedAt != 100 {
+		t.Fatalf("unexpected arrivals of train: %+v", byTrain)
+	}
+
+	it, err := b.IndexScan(db, "station_time", stationA, false)
+	if err != nil {
+		t.Fatalf("cannot scan station time index: %s", err)
+	}
+	defer it.Release()
+	var times []weave.UnixTime
+	for {
+		var e TrainArriveStationEvent
+		err := it.LoadNext(&e)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("cannot load arrival: %s", err)
+		}
+		times = append(times, e.ArrivedAt)
+	}
+	if len(times) != 2 || times[0] != 100 || times[1] != 300 {
+		t.Fatalf("want arrivals ordered by time, got %v", times)
+	}
+}
+
+func TestPassengerAndPassBucketsDoNotShareKeys(t *testing.T) {
+	db := store.MemStore()
+	passengers := NewPassengerBucket()
+	passes := NewPassBucket()
+
+	holder := weavetest.NewCondition().Address()
+	saveAll(t, db, passengers,
+		&Passenger{Metadata: &weave.Metadata{Schema: 1}, Address: holder},
+		&Passenger{Metadata: &weave.Metadata{Schema: 1}, Address: weavetest.NewCondition().Address()},
+	)
+	saveAll(t, db, passes, &Pass{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: weavetest.SequenceID(1),
+		Holder:       holder,
+		Kind:         PassWeekly,
+		ValidFrom:    1000,
+		ValidUntil:   2000,
+		Price:        coin.NewCoin(14, 0, "METR"),
+	})
+
+	var pass Pass
+	if err := passes.ByID(db, weavetest.SequenceID(1), &pass); err != nil {
+		t.Fatalf("cannot load pass: %s", err)
+	}
+	if pass.Kind != PassWeekly || !pass.Holder.Equals(holder) {
+		t.Fatalf("unexpected pass: %+v", pass)
+	}
+	if err := passengers.Has(db, weavetest.SequenceID(3)); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("pass must not be stored as a passenger, got %+v", err)
+	}
+
+	it, err := passengers.PrefixScan(db, nil, false)
+	if err != nil {
+		t.Fatalf("cannot scan passengers: %s", err)
+	}
+	defer it.Release()
+	var n int
+	for {
+		var p Passenger
+		err := it.LoadNext(&p)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("cannot load passenger: %s", err)
+		}
+		n++
+	}
+	if n != 2 {
+		t.Fatalf("want 2 passengers, got %d", n)
+	}
+}
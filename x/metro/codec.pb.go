@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	io "io"
 	math "math"
 )
@@ -24,6 +25,312 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// TrainChangeKind describes what change was made to a train.
+type TrainChangeKind int32
+
+const (
+	TrainChangeInvalid  TrainChangeKind = 0
+	TrainRegistered     TrainChangeKind = 1
+	TrainReassigned     TrainChangeKind = 2
+	TrainDecommissioned TrainChangeKind = 3
+)
+
+var TrainChangeKind_name = map[int32]string{
+	0: "TRAIN_CHANGE_INVALID",
+	1: "TRAIN_CHANGE_REGISTERED",
+	2: "TRAIN_CHANGE_REASSIGNED",
+	3: "TRAIN_CHANGE_DECOMMISSIONED",
+}
+
+var TrainChangeKind_value = map[string]int32{
+	"TRAIN_CHANGE_INVALID":        0,
+	"TRAIN_CHANGE_REGISTERED":     1,
+	"TRAIN_CHANGE_REASSIGNED":     2,
+	"TRAIN_CHANGE_DECOMMISSIONED": 3,
+}
+
+func (x TrainChangeKind) String() string {
+	return proto.EnumName(TrainChangeKind_name, int32(x))
+}
+
+func (TrainChangeKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{0}
+}
+
+// EquipmentType is the kind of a station equipment unit.
+type EquipmentType int32
+
+const (
+	EquipmentInvalid      EquipmentType = 0
+	EquipmentEscalator    EquipmentType = 1
+	EquipmentElevator     EquipmentType = 2
+	EquipmentTollGateEnt  EquipmentType = 3
+	EquipmentTollGateEx   EquipmentType = 4
+	EquipmentTicketOffice EquipmentType = 5
+)
+
+var EquipmentType_name = map[int32]string{
+	0: "EQUIPMENT_TYPE_INVALID",
+	1: "EQUIPMENT_TYPE_ESCALATOR",
+	2: "EQUIPMENT_TYPE_ELEVATOR",
+	3: "EQUIPMENT_TYPE_TOLL_GATE_ENT",
+	4: "EQUIPMENT_TYPE_TOLL_GATE_EX",
+	5: "EQUIPMENT_TYPE_TICKET_OFFICE",
+}
+
+var EquipmentType_value = map[string]int32{
+	"EQUIPMENT_TYPE_INVALID":       0,
+	"EQUIPMENT_TYPE_ESCALATOR":     1,
+	"EQUIPMENT_TYPE_ELEVATOR":      2,
+	"EQUIPMENT_TYPE_TOLL_GATE_ENT": 3,
+	"EQUIPMENT_TYPE_TOLL_GATE_EX":  4,
+	"EQUIPMENT_TYPE_TICKET_OFFICE": 5,
+}
+
+func (x EquipmentType) String() string {
+	return proto.EnumName(EquipmentType_name, int32(x))
+}
+
+func (EquipmentType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{1}
+}
+
+// EquipmentStatus tells if a station equipment unit can be used.
+type EquipmentStatus int32
+
+const (
+	StatusInvalid     EquipmentStatus = 0
+	StatusOperational EquipmentStatus = 1
+	StatusMaintenance EquipmentStatus = 2
+	StatusOutOfOrder  EquipmentStatus = 3
+)
+
+var EquipmentStatus_name = map[int32]string{
+	0: "EQUIPMENT_STATUS_INVALID",
+	1: "EQUIPMENT_STATUS_OPERATIONAL",
+	2: "EQUIPMENT_STATUS_MAINTENANCE",
+	3: "EQUIPMENT_STATUS_OUT_OF_ORDER",
+}
+
+var EquipmentStatus_value = map[string]int32{
+	"EQUIPMENT_STATUS_INVALID":      0,
+	"EQUIPMENT_STATUS_OPERATIONAL":  1,
+	"EQUIPMENT_STATUS_MAINTENANCE":  2,
+	"EQUIPMENT_STATUS_OUT_OF_ORDER": 3,
+}
+
+func (x EquipmentStatus) String() string {
+	return proto.EnumName(EquipmentStatus_name, int32(x))
+}
+
+func (EquipmentStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{2}
+}
+
+// ServicePattern selects the days a timetable applies to.
+type ServicePattern int32
+
+const (
+	ServiceInvalid ServicePattern = 0
+	// Monday to Friday.
+	ServiceWeekday  ServicePattern = 1
+	ServiceSaturday ServicePattern = 2
+	// Sundays and public holidays.
+	ServiceHoliday ServicePattern = 3
+)
+
+var ServicePattern_name = map[int32]string{
+	0: "SERVICE_PATTERN_INVALID",
+	1: "SERVICE_PATTERN_WEEKDAY",
+	2: "SERVICE_PATTERN_SATURDAY",
+	3: "SERVICE_PATTERN_HOLIDAY",
+}
+
+var ServicePattern_value = map[string]int32{
+	"SERVICE_PATTERN_INVALID":  0,
+	"SERVICE_PATTERN_WEEKDAY":  1,
+	"SERVICE_PATTERN_SATURDAY": 2,
+	"SERVICE_PATTERN_HOLIDAY":  3,
+}
+
+func (x ServicePattern) String() string {
+	return proto.EnumName(ServicePattern_name, int32(x))
+}
+
+func (ServicePattern) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{3}
+}
+
+// Direction tells which way trains travel along a line.
+type Direction int32
+
+const (
+	DirectionInvalid Direction = 0
+	// Outbound trains visit stations in the order of the line.
+	DirectionOutbound Direction = 1
+	// Inbound trains visit stations in the reverse order of the line.
+	DirectionInbound Direction = 2
+)
+
+var Direction_name = map[int32]string{
+	0: "DIRECTION_INVALID",
+	1: "DIRECTION_OUTBOUND",
+	2: "DIRECTION_INBOUND",
+}
+
+var Direction_value = map[string]int32{
+	"DIRECTION_INVALID":  0,
+	"DIRECTION_OUTBOUND": 1,
+	"DIRECTION_INBOUND":  2,
+}
+
+func (x Direction) String() string {
+	return proto.EnumName(Direction_name, int32(x))
+}
+
+func (Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{4}
+}
+
+// PassengerCategory is used to grant a fare discount.
+type PassengerCategory int32
+
+const (
+	// Passengers without any concession
+	CategoryStandard        PassengerCategory = 0
+	CategoryStudent         PassengerCategory = 1
+	CategorySenior          PassengerCategory = 2
+	CategoryDisabled        PassengerCategory = 3
+	CategoryTeacher         PassengerCategory = 4
+	CategoryDisabledVeteran PassengerCategory = 5
+)
+
+var PassengerCategory_name = map[int32]string{
+	0: "PASSENGER_CATEGORY_STANDARD",
+	1: "PASSENGER_CATEGORY_STUDENT",
+	2: "PASSENGER_CATEGORY_SENIOR",
+	3: "PASSENGER_CATEGORY_DISABLED",
+	4: "PASSENGER_CATEGORY_TEACHER",
+	5: "PASSENGER_CATEGORY_DISABLED_VETERAN",
+}
+
+var PassengerCategory_value = map[string]int32{
+	"PASSENGER_CATEGORY_STANDARD":         0,
+	"PASSENGER_CATEGORY_STUDENT":          1,
+	"PASSENGER_CATEGORY_SENIOR":           2,
+	"PASSENGER_CATEGORY_DISABLED":         3,
+	"PASSENGER_CATEGORY_TEACHER":          4,
+	"PASSENGER_CATEGORY_DISABLED_VETERAN": 5,
+}
+
+func (x PassengerCategory) String() string {
+	return proto.EnumName(PassengerCategory_name, int32(x))
+}
+
+func (PassengerCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{5}
+}
+
+// JourneyStatus tells how a journey ended.
+type JourneyStatus int32
+
+const (
+	JourneyInvalid   JourneyStatus = 0
+	JourneyOpen      JourneyStatus = 1
+	JourneyCompleted JourneyStatus = 2
+	// The passenger did not exit in time and was charged the maximum fare.
+	JourneyExpired JourneyStatus = 3
+)
+
+var JourneyStatus_name = map[int32]string{
+	0: "JOURNEY_STATUS_INVALID",
+	1: "JOURNEY_STATUS_OPEN",
+	2: "JOURNEY_STATUS_COMPLETED",
+	3: "JOURNEY_STATUS_EXPIRED",
+}
+
+var JourneyStatus_value = map[string]int32{
+	"JOURNEY_STATUS_INVALID":   0,
+	"JOURNEY_STATUS_OPEN":      1,
+	"JOURNEY_STATUS_COMPLETED": 2,
+	"JOURNEY_STATUS_EXPIRED":   3,
+}
+
+func (x JourneyStatus) String() string {
+	return proto.EnumName(JourneyStatus_name, int32(x))
+}
+
+func (JourneyStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{6}
+}
+
+type PassKind int32
+
+const (
+	PassInvalid PassKind = 0
+	PassWeekly  PassKind = 1
+	PassMonthly PassKind = 2
+)
+
+var PassKind_name = map[int32]string{
+	0: "PASS_KIND_INVALID",
+	1: "PASS_KIND_WEEKLY",
+	2: "PASS_KIND_MONTHLY",
+}
+
+var PassKind_value = map[string]int32{
+	"PASS_KIND_INVALID": 0,
+	"PASS_KIND_WEEKLY":  1,
+	"PASS_KIND_MONTHLY": 2,
+}
+
+func (x PassKind) String() string {
+	return proto.EnumName(PassKind_name, int32(x))
+}
+
+func (PassKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{7}
+}
+
+// IncidentSeverity tells how much an incident affects the service.
+type IncidentSeverity int32
+
+const (
+	SeverityInvalid IncidentSeverity = 0
+	// Service is running, passengers are informed only.
+	SeverityInfo IncidentSeverity = 1
+	// Service is running with delays.
+	SeverityMinor IncidentSeverity = 2
+	// Service is severely limited.
+	SeverityMajor IncidentSeverity = 3
+	// Service is suspended at the affected stations.
+	SeveritySuspended IncidentSeverity = 4
+)
+
+var IncidentSeverity_name = map[int32]string{
+	0: "INCIDENT_SEVERITY_INVALID",
+	1: "INCIDENT_SEVERITY_INFO",
+	2: "INCIDENT_SEVERITY_MINOR",
+	3: "INCIDENT_SEVERITY_MAJOR",
+	4: "INCIDENT_SEVERITY_SUSPENDED",
+}
+
+var IncidentSeverity_value = map[string]int32{
+	"INCIDENT_SEVERITY_INVALID":   0,
+	"INCIDENT_SEVERITY_INFO":      1,
+	"INCIDENT_SEVERITY_MINOR":     2,
+	"INCIDENT_SEVERITY_MAJOR":     3,
+	"INCIDENT_SEVERITY_SUSPENDED": 4,
+}
+
+func (x IncidentSeverity) String() string {
+	return proto.EnumName(IncidentSeverity_name, int32(x))
+}
+
+func (IncidentSeverity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{8}
+}
+
 type Station struct {
 	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	PrimaryKey   []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
@@ -35,6 +342,11 @@ type Station struct {
 	TollGateEnt  int64           `protobuf:"varint,8,opt,name=toll_gate_ent,json=tollGateEnt,proto3" json:"toll_gate_ent,omitempty"`
 	TollGateEx   int64           `protobuf:"varint,9,opt,name=toll_gate_ex,json=tollGateEx,proto3" json:"toll_gate_ex,omitempty"`
 	EntranceExit int64           `protobuf:"varint,10,opt,name=entrance_exit,json=entranceExit,proto3" json:"entrance_exit,omitempty"`
+	// addresses of the toll gates installed at the station
+	Gates []github_com_iov_one_weave.Address `protobuf:"bytes,11,rep,name=gates,proto3,casttype=github.com/iov-one/weave.Address" json:"gates,omitempty"`
+	// RetiredAt is set when the station is closed. Retired stations are kept
+	// for the history but trains can no longer arrive at them.
+	RetiredAt github_com_iov_one_weave.UnixTime `protobuf:"varint,12,opt,name=retired_at,json=retiredAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"retired_at,omitempty"`
 }
 
 func (m *Station) Reset()         { *m = Station{} }
@@ -140,10 +452,38 @@ func (m *Station) GetEntranceExit() int64 {
 	return 0
 }
 
+func (m *Station) GetGates() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Gates
+	}
+	return nil
+}
+
+func (m *Station) GetRetiredAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.RetiredAt
+	}
+	return 0
+}
+
 type Train struct {
-	Metadata   *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	PrimaryKey []byte                           `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
-	Address    github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	// Address of the on-board unit that signs train reports.
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Model   string                           `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	// Capacity is the number of passengers the train can carry.
+	Capacity  uint32 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CarCount  uint32 `protobuf:"varint,6,opt,name=car_count,json=carCount,proto3" json:"car_count,omitempty"`
+	HomeDepot string `protobuf:"bytes,7,opt,name=home_depot,json=homeDepot,proto3" json:"home_depot,omitempty"`
+	// DecommissionedAt is set when the train is taken out of service.
+	DecommissionedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,8,opt,name=decommissioned_at,json=decommissionedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"decommissioned_at,omitempty"`
+	// SilentSince is set by the watchdog when the train did not report an
+	// arrival in time. It is cleared with the next arrival.
+	SilentSince github_com_iov_one_weave.UnixTime `protobuf:"varint,9,opt,name=silent_since,json=silentSince,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"silent_since,omitempty"`
+	// WatchdogTaskID is the cron task that marks the train silent unless it
+	// reports an arrival first.
+	WatchdogTaskID []byte `protobuf:"bytes,10,opt,name=watchdog_task_id,json=watchdogTaskId,proto3" json:"watchdog_task_id,omitempty"`
 }
 
 func (m *Train) Reset()         { *m = Train{} }
@@ -200,19 +540,169 @@ func (m *Train) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Train) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *Train) GetCapacity() uint32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *Train) GetCarCount() uint32 {
+	if m != nil {
+		return m.CarCount
+	}
+	return 0
+}
+
+func (m *Train) GetHomeDepot() string {
+	if m != nil {
+		return m.HomeDepot
+	}
+	return ""
+}
+
+func (m *Train) GetDecommissionedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DecommissionedAt
+	}
+	return 0
+}
+
+func (m *Train) GetSilentSince() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.SilentSince
+	}
+	return 0
+}
+
+func (m *Train) GetWatchdogTaskID() []byte {
+	if m != nil {
+		return m.WatchdogTaskID
+	}
+	return nil
+}
+
+// TrainChange is an audit trail entry of a change made to a train.
+type TrainChange struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	TrainKey   []byte          `protobuf:"bytes,3,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+	Kind       TrainChangeKind `protobuf:"varint,4,opt,name=kind,proto3,enum=metro.TrainChangeKind" json:"kind,omitempty"`
+	// Address is the signing address of the train after the change.
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// PreviousAddress is set when the signing address was reassigned.
+	PreviousAddress github_com_iov_one_weave.Address  `protobuf:"bytes,6,opt,name=previous_address,json=previousAddress,proto3,casttype=github.com/iov-one/weave.Address" json:"previous_address,omitempty"`
+	ChangedAt       github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=changed_at,json=changedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"changed_at,omitempty"`
+}
+
+func (m *TrainChange) Reset()         { *m = TrainChange{} }
+func (m *TrainChange) String() string { return proto.CompactTextString(m) }
+func (*TrainChange) ProtoMessage()    {}
+func (*TrainChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{2}
+}
+func (m *TrainChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainChange.Merge(m, src)
+}
+func (m *TrainChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainChange proto.InternalMessageInfo
+
+func (m *TrainChange) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TrainChange) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *TrainChange) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+func (m *TrainChange) GetKind() TrainChangeKind {
+	if m != nil {
+		return m.Kind
+	}
+	return TrainChangeInvalid
+}
+
+func (m *TrainChange) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *TrainChange) GetPreviousAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.PreviousAddress
+	}
+	return nil
+}
+
+func (m *TrainChange) GetChangedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return 0
+}
+
 type Passenger struct {
 	Metadata     *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	PrimaryKey   []byte                            `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
 	Address      github_com_iov_one_weave.Address  `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
 	RegisteredAt github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=registered_at,json=registeredAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"registered_at,omitempty"`
 	Name         string                            `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// Category grants the passenger a fare concession until it expires.
+	Category          PassengerCategory                 `protobuf:"varint,6,opt,name=category,proto3,enum=metro.PassengerCategory" json:"category,omitempty"`
+	CategoryExpiresAt github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=category_expires_at,json=categoryExpiresAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"category_expires_at,omitempty"`
+	// DeregisteredAt is set when the passenger closes the account. Deregistered
+	// passengers are kept as a tombstone and cannot be referenced anymore.
+	DeregisteredAt github_com_iov_one_weave.UnixTime `protobuf:"varint,8,opt,name=deregistered_at,json=deregisteredAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"deregistered_at,omitempty"`
 }
 
 func (m *Passenger) Reset()         { *m = Passenger{} }
 func (m *Passenger) String() string { return proto.CompactTextString(m) }
 func (*Passenger) ProtoMessage()    {}
 func (*Passenger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_966ccfa1a9e1c00b, []int{2}
+	return fileDescriptor_966ccfa1a9e1c00b, []int{3}
 }
 func (m *Passenger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,28 +766,50 @@ func (m *Passenger) GetName() string {
 	return ""
 }
 
-type TrainArriveStationEvent struct {
+func (m *Passenger) GetCategory() PassengerCategory {
+	if m != nil {
+		return m.Category
+	}
+	return CategoryStandard
+}
+
+func (m *Passenger) GetCategoryExpiresAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CategoryExpiresAt
+	}
+	return 0
+}
+
+func (m *Passenger) GetDeregisteredAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DeregisteredAt
+	}
+	return 0
+}
+
+// Line is a metro line that visits an ordered list of stations.
+type Line struct {
 	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	PrimaryKey []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
-	// pk of station
-	StationKey []byte `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
-	// pk of train
-	TrainKey  []byte                            `protobuf:"bytes,4,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
-	ArrivedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=arrived_at,json=arrivedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"arrived_at,omitempty"`
+	Name       string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// color of the line as shown on the network map, for example "#e30613"
+	Color string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	// pks of the stations, ordered from one terminus to the other
+	StationKeys [][]byte `protobuf:"bytes,5,rep,name=station_keys,json=stationKeys,proto3" json:"station_keys,omitempty"`
 }
 
-func (m *TrainArriveStationEvent) Reset()         { *m = TrainArriveStationEvent{} }
-func (m *TrainArriveStationEvent) String() string { return proto.CompactTextString(m) }
-func (*TrainArriveStationEvent) ProtoMessage()    {}
-func (*TrainArriveStationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_966ccfa1a9e1c00b, []int{3}
+func (m *Line) Reset()         { *m = Line{} }
+func (m *Line) String() string { return proto.CompactTextString(m) }
+func (*Line) ProtoMessage()    {}
+func (*Line) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{4}
 }
-func (m *TrainArriveStationEvent) XXX_Unmarshal(b []byte) error {
+func (m *Line) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TrainArriveStationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Line) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TrainArriveStationEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Line.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -307,70 +819,97 @@ func (m *TrainArriveStationEvent) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *TrainArriveStationEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TrainArriveStationEvent.Merge(m, src)
+func (m *Line) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Line.Merge(m, src)
 }
-func (m *TrainArriveStationEvent) XXX_Size() int {
+func (m *Line) XXX_Size() int {
 	return m.Size()
 }
-func (m *TrainArriveStationEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_TrainArriveStationEvent.DiscardUnknown(m)
+func (m *Line) XXX_DiscardUnknown() {
+	xxx_messageInfo_Line.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TrainArriveStationEvent proto.InternalMessageInfo
+var xxx_messageInfo_Line proto.InternalMessageInfo
 
-func (m *TrainArriveStationEvent) GetMetadata() *weave.Metadata {
+func (m *Line) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *TrainArriveStationEvent) GetPrimaryKey() []byte {
+func (m *Line) GetPrimaryKey() []byte {
 	if m != nil {
 		return m.PrimaryKey
 	}
 	return nil
 }
 
-func (m *TrainArriveStationEvent) GetStationKey() []byte {
+func (m *Line) GetName() string {
 	if m != nil {
-		return m.StationKey
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *TrainArriveStationEvent) GetTrainKey() []byte {
+func (m *Line) GetColor() string {
 	if m != nil {
-		return m.TrainKey
+		return m.Color
 	}
-	return nil
+	return ""
 }
 
-func (m *TrainArriveStationEvent) GetArrivedAt() github_com_iov_one_weave.UnixTime {
+func (m *Line) GetStationKeys() [][]byte {
 	if m != nil {
-		return m.ArrivedAt
+		return m.StationKeys
 	}
-	return 0
+	return nil
 }
 
-type RegisterPassengerMsg struct {
+// Configuration is the metro extension configuration kept in gconf.
+type Configuration struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Name     string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Owner is present to implement gconf.OwnedConfig interface
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// Admin is allowed to manage the metro network topology. It can be the
+	// address of a multisig contract.
+	Admin github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=admin,proto3,casttype=github.com/iov-one/weave.Address" json:"admin,omitempty"`
+	// FareCollector is the address that all fares are paid to.
+	FareCollector github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=fare_collector,json=fareCollector,proto3,casttype=github.com/iov-one/weave.Address" json:"fare_collector,omitempty"`
+	// FareAdmin is allowed to update the fare table.
+	FareAdmin github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=fare_admin,json=fareAdmin,proto3,casttype=github.com/iov-one/weave.Address" json:"fare_admin,omitempty"`
+	// CategoryIssuer is allowed to assign passenger categories.
+	CategoryIssuer github_com_iov_one_weave.Address `protobuf:"bytes,6,opt,name=category_issuer,json=categoryIssuer,proto3,casttype=github.com/iov-one/weave.Address" json:"category_issuer,omitempty"`
+	// FleetManager is allowed to register, reassign and decommission trains.
+	FleetManager github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=fleet_manager,json=fleetManager,proto3,casttype=github.com/iov-one/weave.Address" json:"fleet_manager,omitempty"`
+	// Maintainers are allowed to report the status of station equipment.
+	Maintainers []github_com_iov_one_weave.Address `protobuf:"bytes,8,rep,name=maintainers,proto3,casttype=github.com/iov-one/weave.Address" json:"maintainers,omitempty"`
+	// Operators are allowed to announce service incidents.
+	Operators []github_com_iov_one_weave.Address `protobuf:"bytes,9,rep,name=operators,proto3,casttype=github.com/iov-one/weave.Address" json:"operators,omitempty"`
+	// TrainSilenceMinutes is how long a train can go without reporting an
+	// arrival before it is marked silent. Zero disables the watchdog.
+	TrainSilenceMinutes uint32 `protobuf:"varint,10,opt,name=train_silence_minutes,json=trainSilenceMinutes,proto3" json:"train_silence_minutes,omitempty"`
+	// MaxJourneyMinutes is how long a journey can last before it expires.
+	MaxJourneyMinutes uint32 `protobuf:"varint,11,opt,name=max_journey_minutes,json=maxJourneyMinutes,proto3" json:"max_journey_minutes,omitempty"`
+	// MaxFare is charged for a journey that expired.
+	MaxFare *coin.Coin `protobuf:"bytes,12,opt,name=max_fare,json=maxFare,proto3" json:"max_fare,omitempty"`
+	// CurrencyIssuer is allowed to register new currencies, for example the
+	// fare credit token. Currencies cannot be registered without it.
+	CurrencyIssuer github_com_iov_one_weave.Address `protobuf:"bytes,13,opt,name=currency_issuer,json=currencyIssuer,proto3,casttype=github.com/iov-one/weave.Address" json:"currency_issuer,omitempty"`
 }
 
-func (m *RegisterPassengerMsg) Reset()         { *m = RegisterPassengerMsg{} }
-func (m *RegisterPassengerMsg) String() string { return proto.CompactTextString(m) }
-func (*RegisterPassengerMsg) ProtoMessage()    {}
-func (*RegisterPassengerMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_966ccfa1a9e1c00b, []int{4}
+func (m *Configuration) Reset()         { *m = Configuration{} }
+func (m *Configuration) String() string { return proto.CompactTextString(m) }
+func (*Configuration) ProtoMessage()    {}
+func (*Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{5}
 }
-func (m *RegisterPassengerMsg) XXX_Unmarshal(b []byte) error {
+func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterPassengerMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterPassengerMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -380,51 +919,136 @@ func (m *RegisterPassengerMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *RegisterPassengerMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterPassengerMsg.Merge(m, src)
+func (m *Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration.Merge(m, src)
 }
-func (m *RegisterPassengerMsg) XXX_Size() int {
+func (m *Configuration) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterPassengerMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterPassengerMsg.DiscardUnknown(m)
+func (m *Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterPassengerMsg proto.InternalMessageInfo
+var xxx_messageInfo_Configuration proto.InternalMessageInfo
 
-func (m *RegisterPassengerMsg) GetMetadata() *weave.Metadata {
+func (m *Configuration) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *RegisterPassengerMsg) GetName() string {
+func (m *Configuration) GetOwner() github_com_iov_one_weave.Address {
 	if m != nil {
-		return m.Name
+		return m.Owner
 	}
-	return ""
+	return nil
 }
 
-type TrainArriveStationEventMsg struct {
-	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// id of station
-	StationKey []byte `protobuf:"bytes,2,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
-	TrainKey   []byte `protobuf:"bytes,3,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+func (m *Configuration) GetAdmin() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
 }
 
-func (m *TrainArriveStationEventMsg) Reset()         { *m = TrainArriveStationEventMsg{} }
-func (m *TrainArriveStationEventMsg) String() string { return proto.CompactTextString(m) }
-func (*TrainArriveStationEventMsg) ProtoMessage()    {}
-func (*TrainArriveStationEventMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_966ccfa1a9e1c00b, []int{5}
+func (m *Configuration) GetFareCollector() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.FareCollector
+	}
+	return nil
 }
-func (m *TrainArriveStationEventMsg) XXX_Unmarshal(b []byte) error {
+
+func (m *Configuration) GetFareAdmin() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.FareAdmin
+	}
+	return nil
+}
+
+func (m *Configuration) GetCategoryIssuer() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.CategoryIssuer
+	}
+	return nil
+}
+
+func (m *Configuration) GetFleetManager() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.FleetManager
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaintainers() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Maintainers
+	}
+	return nil
+}
+
+func (m *Configuration) GetOperators() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+func (m *Configuration) GetTrainSilenceMinutes() uint32 {
+	if m != nil {
+		return m.TrainSilenceMinutes
+	}
+	return 0
+}
+
+func (m *Configuration) GetMaxJourneyMinutes() uint32 {
+	if m != nil {
+		return m.MaxJourneyMinutes
+	}
+	return 0
+}
+
+func (m *Configuration) GetMaxFare() *coin.Coin {
+	if m != nil {
+		return m.MaxFare
+	}
+	return nil
+}
+
+func (m *Configuration) GetCurrencyIssuer() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.CurrencyIssuer
+	}
+	return nil
+}
+
+// Equipment is a single unit, for example an elevator, installed at a
+// station. It is stored under the station key followed by the identifier, so
+// that all equipment of a station can be queried with a prefix query.
+type Equipment struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StationKey []byte          `protobuf:"bytes,2,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	Type       EquipmentType   `protobuf:"varint,3,opt,name=type,proto3,enum=metro.EquipmentType" json:"type,omitempty"`
+	// Identifier distinguishes units of a station, for example "E1".
+	Identifier string                            `protobuf:"bytes,4,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Status     EquipmentStatus                   `protobuf:"varint,5,opt,name=status,proto3,enum=metro.EquipmentStatus" json:"status,omitempty"`
+	UpdatedAt  github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"updated_at,omitempty"`
+	// ReportedBy is the maintainer that reported the current status.
+	ReportedBy github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=reported_by,json=reportedBy,proto3,casttype=github.com/iov-one/weave.Address" json:"reported_by,omitempty"`
+}
+
+func (m *Equipment) Reset()         { *m = Equipment{} }
+func (m *Equipment) String() string { return proto.CompactTextString(m) }
+func (*Equipment) ProtoMessage()    {}
+func (*Equipment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{6}
+}
+func (m *Equipment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TrainArriveStationEventMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Equipment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TrainArriveStationEventMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Equipment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -434,566 +1058,22648 @@ func (m *TrainArriveStationEventMsg) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *TrainArriveStationEventMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TrainArriveStationEventMsg.Merge(m, src)
+func (m *Equipment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Equipment.Merge(m, src)
 }
-func (m *TrainArriveStationEventMsg) XXX_Size() int {
+func (m *Equipment) XXX_Size() int {
 	return m.Size()
 }
-func (m *TrainArriveStationEventMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_TrainArriveStationEventMsg.DiscardUnknown(m)
+func (m *Equipment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Equipment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TrainArriveStationEventMsg proto.InternalMessageInfo
+var xxx_messageInfo_Equipment proto.InternalMessageInfo
 
-func (m *TrainArriveStationEventMsg) GetMetadata() *weave.Metadata {
+func (m *Equipment) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *TrainArriveStationEventMsg) GetStationKey() []byte {
+func (m *Equipment) GetStationKey() []byte {
 	if m != nil {
 		return m.StationKey
 	}
 	return nil
 }
 
-func (m *TrainArriveStationEventMsg) GetTrainKey() []byte {
+func (m *Equipment) GetType() EquipmentType {
 	if m != nil {
-		return m.TrainKey
+		return m.Type
 	}
-	return nil
+	return EquipmentInvalid
 }
 
-func init() {
-	proto.RegisterType((*Station)(nil), "metro.Station")
-	proto.RegisterType((*Train)(nil), "metro.Train")
-	proto.RegisterType((*Passenger)(nil), "metro.Passenger")
-	proto.RegisterType((*TrainArriveStationEvent)(nil), "metro.TrainArriveStationEvent")
-	proto.RegisterType((*RegisterPassengerMsg)(nil), "metro.RegisterPassengerMsg")
-	proto.RegisterType((*TrainArriveStationEventMsg)(nil), "metro.TrainArriveStationEventMsg")
+func (m *Equipment) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("x/metro/codec.proto", fileDescriptor_966ccfa1a9e1c00b) }
+func (m *Equipment) GetStatus() EquipmentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusInvalid
+}
 
-var fileDescriptor_966ccfa1a9e1c00b = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xc1, 0x6a, 0x13, 0x5f,
-	0x14, 0xc6, 0x7b, 0xa7, 0x4d, 0x93, 0x39, 0x49, 0xff, 0x7f, 0xb8, 0x16, 0x1c, 0x82, 0x4c, 0xc6,
-	0xa8, 0x10, 0x11, 0x33, 0xa0, 0x7b, 0x21, 0xc1, 0x22, 0x28, 0xc5, 0x32, 0x56, 0x5c, 0x0e, 0xb7,
-	0x33, 0xa7, 0xe3, 0x25, 0x99, 0xb9, 0xe1, 0xce, 0x31, 0xa6, 0x6f, 0xe0, 0xd2, 0x85, 0xaf, 0xe0,
-	0xbb, 0xb8, 0xec, 0xd2, 0x85, 0x04, 0x49, 0x5f, 0x42, 0xba, 0x92, 0xb9, 0x33, 0x49, 0x83, 0x50,
-	0x21, 0xd0, 0x85, 0xbb, 0x73, 0xbf, 0xfb, 0xdd, 0x73, 0x0e, 0xbf, 0xf9, 0x18, 0xb8, 0x35, 0xf3,
-	0x53, 0x24, 0xad, 0xfc, 0x48, 0xc5, 0x18, 0xf5, 0x27, 0x5a, 0x91, 0xe2, 0x35, 0x23, 0xb5, 0x9b,
-	0x6b, 0x5a, 0x7b, 0x3f, 0x51, 0x89, 0x32, 0xa5, 0x5f, 0x54, 0xa5, 0xda, 0xfd, 0x61, 0x41, 0xfd,
-	0x0d, 0x09, 0x92, 0x2a, 0xe3, 0x8f, 0xa0, 0x91, 0x22, 0x89, 0x58, 0x90, 0x70, 0x98, 0xc7, 0x7a,
-	0xcd, 0x27, 0xff, 0xf7, 0x3f, 0xa2, 0x98, 0x62, 0xff, 0xb0, 0x92, 0x83, 0x95, 0x81, 0xbb, 0x60,
-	0x4d, 0x46, 0x8e, 0xe5, 0xb1, 0x5e, 0x6b, 0xf8, 0xdf, 0x62, 0xde, 0x81, 0x23, 0x2d, 0x53, 0xa1,
-	0xcf, 0x5e, 0xe1, 0x59, 0x60, 0x4d, 0x46, 0xdc, 0x81, 0x7a, 0x5e, 0xf6, 0x75, 0xb6, 0x3d, 0xd6,
-	0xb3, 0x83, 0xe5, 0x91, 0xdf, 0x01, 0x1b, 0xf3, 0x48, 0x8c, 0x05, 0x29, 0xed, 0xec, 0x78, 0xac,
-	0xb7, 0x1d, 0x5c, 0x09, 0xbc, 0x0d, 0x0d, 0x1c, 0xe3, 0xd4, 0x5c, 0xd6, 0xcc, 0xe5, 0xea, 0xcc,
-	0x3d, 0x68, 0xc9, 0x3c, 0x9c, 0xa0, 0x56, 0x59, 0x28, 0x62, 0xe1, 0xec, 0x7a, 0xac, 0xd7, 0x08,
-	0x40, 0xe6, 0x47, 0x85, 0x34, 0x88, 0x05, 0xbf, 0x07, 0x7b, 0x24, 0xa3, 0x11, 0x52, 0xa8, 0x4e,
-	0x4f, 0x65, 0x84, 0x4e, 0xdd, 0xb4, 0x68, 0x95, 0xe2, 0x6b, 0xa3, 0xf1, 0x2e, 0xec, 0x91, 0x1a,
-	0x8f, 0xc3, 0x44, 0x10, 0x86, 0x98, 0x91, 0xd3, 0x30, 0xa6, 0x66, 0x21, 0xbe, 0x10, 0x84, 0x07,
-	0x19, 0x15, 0xa3, 0xd6, 0x3c, 0x33, 0xc7, 0x36, 0x16, 0x58, 0x59, 0x66, 0xc5, 0x28, 0xcc, 0x48,
-	0x8b, 0x2c, 0x2a, 0x0c, 0x92, 0x1c, 0x28, 0x47, 0x2d, 0xc5, 0x83, 0x99, 0xa4, 0xee, 0x17, 0x06,
-	0xb5, 0x63, 0x2d, 0xe4, 0x0d, 0xc3, 0x7d, 0x06, 0x75, 0x11, 0xc7, 0x1a, 0xf3, 0xdc, 0xc0, 0x6d,
-	0x0d, 0xef, 0x5f, 0xce, 0x3b, 0x5e, 0x22, 0xe9, 0xfd, 0x87, 0x93, 0x7e, 0xa4, 0x52, 0x5f, 0xaa,
-	0xe9, 0x63, 0x95, 0xa1, 0x5f, 0x4e, 0x18, 0x94, 0xde, 0x60, 0xf9, 0xa8, 0xfb, 0x8b, 0x81, 0x7d,
-	0x24, 0xf2, 0x1c, 0xb3, 0x04, 0xf5, 0x3f, 0xb5, 0x1a, 0x7f, 0x09, 0x7b, 0x1a, 0x13, 0x99, 0x13,
-	0x6a, 0x8c, 0x43, 0x41, 0x65, 0x42, 0x86, 0x0f, 0x2e, 0xe7, 0x9d, 0xbb, 0xd7, 0x76, 0x79, 0x9b,
-	0xc9, 0xd9, 0xb1, 0x4c, 0x31, 0x68, 0x5d, 0xbd, 0x1d, 0x10, 0xe7, 0xb0, 0x93, 0x89, 0x14, 0x4d,
-	0x8e, 0xec, 0xc0, 0xd4, 0xdd, 0x4f, 0x16, 0xdc, 0x36, 0x5f, 0x64, 0xa0, 0xb5, 0x9c, 0x62, 0x95,
-	0xfd, 0x83, 0x29, 0x66, 0x74, 0xb3, 0x20, 0x7c, 0x68, 0x56, 0x89, 0x0f, 0x47, 0x78, 0x56, 0xc1,
-	0x30, 0xc6, 0x6a, 0x66, 0x61, 0x84, 0x7c, 0x55, 0xf3, 0x87, 0x60, 0x53, 0xb1, 0x98, 0xb1, 0xef,
-	0x18, 0x7b, 0x6b, 0x31, 0xef, 0x34, 0xcc, 0xb6, 0x85, 0xb9, 0x41, 0x55, 0xc5, 0x9f, 0x03, 0x08,
-	0xb3, 0xbe, 0x21, 0x54, 0xdb, 0x84, 0x90, 0x5d, 0x3d, 0x1c, 0x50, 0xf7, 0x1d, 0xec, 0x07, 0x15,
-	0xae, 0x55, 0x18, 0x0e, 0xf3, 0x64, 0x33, 0x0c, 0x4b, 0xc6, 0xd6, 0x1a, 0xe3, 0xaf, 0x0c, 0xda,
-	0xd7, 0x30, 0xde, 0xb8, 0xff, 0x1f, 0x18, 0xad, 0xcd, 0x30, 0x6e, 0xff, 0x0d, 0xe3, 0xd0, 0xf9,
-	0xb6, 0x70, 0xd9, 0xf9, 0xc2, 0x65, 0x3f, 0x17, 0x2e, 0xfb, 0x7c, 0xe1, 0x6e, 0x9d, 0x5f, 0xb8,
-	0x5b, 0xdf, 0x2f, 0xdc, 0xad, 0x93, 0x5d, 0xf3, 0x77, 0x7c, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x51, 0x32, 0x35, 0x5c, 0x5e, 0x05, 0x00, 0x00,
+func (m *Equipment) GetUpdatedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
 }
 
-func (m *Station) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Equipment) GetReportedBy() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.ReportedBy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Station) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+// Connection is a track between two neighbouring stations. Connections can be
+// travelled in both directions.
+type Connection struct {
+	Metadata       *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey     []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	FromStationKey []byte          `protobuf:"bytes,3,opt,name=from_station_key,json=fromStationKey,proto3" json:"from_station_key,omitempty"`
+	ToStationKey   []byte          `protobuf:"bytes,4,opt,name=to_station_key,json=toStationKey,proto3" json:"to_station_key,omitempty"`
+	TravelMinutes  uint32          `protobuf:"varint,5,opt,name=travel_minutes,json=travelMinutes,proto3" json:"travel_minutes,omitempty"`
+}
+
+func (m *Connection) Reset()         { *m = Connection{} }
+func (m *Connection) String() string { return proto.CompactTextString(m) }
+func (*Connection) ProtoMessage()    {}
+func (*Connection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{7}
+}
+func (m *Connection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Connection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Connection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if len(m.PrimaryKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
-		i += copy(dAtA[i:], m.PrimaryKey)
-	}
-	if len(m.Station) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Station)))
-		i += copy(dAtA[i:], m.Station)
-	}
-	if m.Escalator != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Escalator))
-	}
-	if m.Elevator != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Elevator))
-	}
-	if m.IsPeronAda {
-		dAtA[i] = 0x30
-		i++
-		if m.IsPeronAda {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			return nil, err
 		}
-		i++
-	}
-	if m.TicketOffice != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.TicketOffice))
+		return b[:n], nil
 	}
-	if m.TollGateEnt != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.TollGateEnt))
+}
+func (m *Connection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Connection.Merge(m, src)
+}
+func (m *Connection) XXX_Size() int {
+	return m.Size()
+}
+func (m *Connection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Connection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Connection proto.InternalMessageInfo
+
+func (m *Connection) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if m.TollGateEx != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.TollGateEx))
+	return nil
+}
+
+func (m *Connection) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
 	}
-	if m.EntranceExit != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EntranceExit))
+	return nil
+}
+
+func (m *Connection) GetFromStationKey() []byte {
+	if m != nil {
+		return m.FromStationKey
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Train) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Connection) GetToStationKey() []byte {
+	if m != nil {
+		return m.ToStationKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Train) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+func (m *Connection) GetTravelMinutes() uint32 {
+	if m != nil {
+		return m.TravelMinutes
 	}
-	if len(m.PrimaryKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
-		i += copy(dAtA[i:], m.PrimaryKey)
-	}
-	if len(m.Address) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
-	}
-	return i, nil
+	return 0
 }
 
-func (m *Passenger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// RouteRequest is the query data of the route planner.
+type RouteRequest struct {
+	FromStationKey []byte `protobuf:"bytes,1,opt,name=from_station_key,json=fromStationKey,proto3" json:"from_station_key,omitempty"`
+	ToStationKey   []byte `protobuf:"bytes,2,opt,name=to_station_key,json=toStationKey,proto3" json:"to_station_key,omitempty"`
+	// Accessible routes avoid stations without an elevator or without an
+	// island platform.
+	Accessible bool `protobuf:"varint,3,opt,name=accessible,proto3" json:"accessible,omitempty"`
 }
 
-func (m *Passenger) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+func (m *RouteRequest) Reset()         { *m = RouteRequest{} }
+func (m *RouteRequest) String() string { return proto.CompactTextString(m) }
+func (*RouteRequest) ProtoMessage()    {}
+func (*RouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{8}
+}
+func (m *RouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
-	}
-	if len(m.PrimaryKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
-		i += copy(dAtA[i:], m.PrimaryKey)
-	}
-	if len(m.Address) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
+		return b[:n], nil
 	}
-	if m.RegisteredAt != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.RegisteredAt))
+}
+func (m *RouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteRequest.Merge(m, src)
+}
+func (m *RouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteRequest proto.InternalMessageInfo
+
+func (m *RouteRequest) GetFromStationKey() []byte {
+	if m != nil {
+		return m.FromStationKey
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	return nil
+}
+
+func (m *RouteRequest) GetToStationKey() []byte {
+	if m != nil {
+		return m.ToStationKey
 	}
-	return i, nil
+	return nil
 }
 
-func (m *TrainArriveStationEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RouteRequest) GetAccessible() bool {
+	if m != nil {
+		return m.Accessible
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *TrainArriveStationEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+// Route is the fastest way between two stations.
+type Route struct {
+	Hops         []RouteHop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops"`
+	TotalMinutes uint32     `protobuf:"varint,2,opt,name=total_minutes,json=totalMinutes,proto3" json:"total_minutes,omitempty"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{9}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n4
-	}
-	if len(m.PrimaryKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
-		i += copy(dAtA[i:], m.PrimaryKey)
-	}
-	if len(m.StationKey) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
-		i += copy(dAtA[i:], m.StationKey)
-	}
-	if len(m.TrainKey) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
-		i += copy(dAtA[i:], m.TrainKey)
+		return b[:n], nil
 	}
-	if m.ArrivedAt != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ArrivedAt))
+}
+func (m *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(m, src)
+}
+func (m *Route) XXX_Size() int {
+	return m.Size()
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetHops() []RouteHop {
+	if m != nil {
+		return m.Hops
 	}
-	return i, nil
+	return nil
 }
 
-func (m *RegisterPassengerMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Route) GetTotalMinutes() uint32 {
+	if m != nil {
+		return m.TotalMinutes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RegisterPassengerMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+// RouteHop is a station visited on a route.
+type RouteHop struct {
+	StationKey []byte `protobuf:"bytes,1,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	// Minutes is the travel time from the start of the route.
+	Minutes uint32 `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+}
+
+func (m *RouteHop) Reset()         { *m = RouteHop{} }
+func (m *RouteHop) String() string { return proto.CompactTextString(m) }
+func (*RouteHop) ProtoMessage()    {}
+func (*RouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{10}
+}
+func (m *RouteHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n5
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+}
+func (m *RouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteHop.Merge(m, src)
+}
+func (m *RouteHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteHop proto.InternalMessageInfo
+
+func (m *RouteHop) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
 	}
-	return i, nil
+	return nil
 }
 
-func (m *TrainArriveStationEventMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RouteHop) GetMinutes() uint32 {
+	if m != nil {
+		return m.Minutes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TrainArriveStationEventMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+// Timetable holds scheduled departures of a line in one direction for one
+// service pattern. There is at most one active timetable for each line,
+// direction and service pattern. Superseded timetables are kept for the
+// history.
+type Timetable struct {
+	Metadata    *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey  []byte                            `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	LineKey     []byte                            `protobuf:"bytes,3,opt,name=line_key,json=lineKey,proto3" json:"line_key,omitempty"`
+	Service     ServicePattern                    `protobuf:"varint,4,opt,name=service,proto3,enum=metro.ServicePattern" json:"service,omitempty"`
+	Direction   Direction                         `protobuf:"varint,5,opt,name=direction,proto3,enum=metro.Direction" json:"direction,omitempty"`
+	Stops       []TimetableStop                   `protobuf:"bytes,6,rep,name=stops,proto3" json:"stops"`
+	PublishedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=published_at,json=publishedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"published_at,omitempty"`
+	// SupersededBy is the key of the timetable that replaced this one.
+	SupersededBy []byte                            `protobuf:"bytes,8,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	SupersededAt github_com_iov_one_weave.UnixTime `protobuf:"varint,9,opt,name=superseded_at,json=supersededAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"superseded_at,omitempty"`
+}
+
+func (m *Timetable) Reset()         { *m = Timetable{} }
+func (m *Timetable) String() string { return proto.CompactTextString(m) }
+func (*Timetable) ProtoMessage()    {}
+func (*Timetable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{11}
+}
+func (m *Timetable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Timetable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Timetable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n6
+		return b[:n], nil
 	}
-	if len(m.StationKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
-		i += copy(dAtA[i:], m.StationKey)
+}
+func (m *Timetable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timetable.Merge(m, src)
+}
+func (m *Timetable) XXX_Size() int {
+	return m.Size()
+}
+func (m *Timetable) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timetable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timetable proto.InternalMessageInfo
+
+func (m *Timetable) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.TrainKey) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
-		i += copy(dAtA[i:], m.TrainKey)
+	return nil
+}
+
+func (m *Timetable) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
 	}
-	return i, nil
+	return nil
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Timetable) GetLineKey() []byte {
+	if m != nil {
+		return m.LineKey
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return nil
 }
-func (m *Station) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.PrimaryKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Station)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.Escalator != 0 {
-		n += 1 + sovCodec(uint64(m.Escalator))
+
+func (m *Timetable) GetService() ServicePattern {
+	if m != nil {
+		return m.Service
 	}
-	if m.Elevator != 0 {
-		n += 1 + sovCodec(uint64(m.Elevator))
+	return ServiceInvalid
+}
+
+func (m *Timetable) GetDirection() Direction {
+	if m != nil {
+		return m.Direction
 	}
-	if m.IsPeronAda {
-		n += 2
+	return DirectionInvalid
+}
+
+func (m *Timetable) GetStops() []TimetableStop {
+	if m != nil {
+		return m.Stops
 	}
-	if m.TicketOffice != 0 {
-		n += 1 + sovCodec(uint64(m.TicketOffice))
+	return nil
+}
+
+func (m *Timetable) GetPublishedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.PublishedAt
 	}
-	if m.TollGateEnt != 0 {
-		n += 1 + sovCodec(uint64(m.TollGateEnt))
+	return 0
+}
+
+func (m *Timetable) GetSupersededBy() []byte {
+	if m != nil {
+		return m.SupersededBy
 	}
-	if m.TollGateEx != 0 {
-		n += 1 + sovCodec(uint64(m.TollGateEx))
+	return nil
+}
+
+func (m *Timetable) GetSupersededAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.SupersededAt
 	}
-	if m.EntranceExit != 0 {
-		n += 1 + sovCodec(uint64(m.EntranceExit))
+	return 0
+}
+
+// TimetableStop lists scheduled departures from a station.
+type TimetableStop struct {
+	StationKey []byte `protobuf:"bytes,1,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	// Departures are UTC minutes of the day, in ascending order.
+	Departures []uint32 `protobuf:"varint,2,rep,packed,name=departures,proto3" json:"departures,omitempty"`
+}
+
+func (m *TimetableStop) Reset()         { *m = TimetableStop{} }
+func (m *TimetableStop) String() string { return proto.CompactTextString(m) }
+func (*TimetableStop) ProtoMessage()    {}
+func (*TimetableStop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{12}
+}
+func (m *TimetableStop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimetableStop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimetableStop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *TimetableStop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimetableStop.Merge(m, src)
+}
+func (m *TimetableStop) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimetableStop) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimetableStop.DiscardUnknown(m)
 }
 
-func (m *Train) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_TimetableStop proto.InternalMessageInfo
+
+func (m *TimetableStop) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *TimetableStop) GetDepartures() []uint32 {
+	if m != nil {
+		return m.Departures
 	}
-	l = len(m.PrimaryKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+// DeparturesRequest is the query data of the next departures query.
+type DeparturesRequest struct {
+	StationKey []byte                            `protobuf:"bytes,1,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	At         github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=at,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"at,omitempty"`
+	Limit      uint32                            `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *DeparturesRequest) Reset()         { *m = DeparturesRequest{} }
+func (m *DeparturesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeparturesRequest) ProtoMessage()    {}
+func (*DeparturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{13}
+}
+func (m *DeparturesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeparturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeparturesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *DeparturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeparturesRequest.Merge(m, src)
+}
+func (m *DeparturesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeparturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeparturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeparturesRequest proto.InternalMessageInfo
+
+func (m *DeparturesRequest) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
 	}
-	return n
+	return nil
 }
 
-func (m *Passenger) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeparturesRequest) GetAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.At
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return 0
+}
+
+func (m *DeparturesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	l = len(m.PrimaryKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return 0
+}
+
+// Departures is the list of next scheduled departures from a station.
+type Departures struct {
+	Departures []Departure `protobuf:"bytes,1,rep,name=departures,proto3" json:"departures"`
+}
+
+func (m *Departures) Reset()         { *m = Departures{} }
+func (m *Departures) String() string { return proto.CompactTextString(m) }
+func (*Departures) ProtoMessage()    {}
+func (*Departures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{14}
+}
+func (m *Departures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Departures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Departures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *Departures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Departures.Merge(m, src)
+}
+func (m *Departures) XXX_Size() int {
+	return m.Size()
+}
+func (m *Departures) XXX_DiscardUnknown() {
+	xxx_messageInfo_Departures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Departures proto.InternalMessageInfo
+
+func (m *Departures) GetDepartures() []Departure {
+	if m != nil {
+		return m.Departures
 	}
-	if m.RegisteredAt != 0 {
-		n += 1 + sovCodec(uint64(m.RegisteredAt))
+	return nil
+}
+
+// Departure is a single scheduled departure.
+type Departure struct {
+	LineKey   []byte                            `protobuf:"bytes,1,opt,name=line_key,json=lineKey,proto3" json:"line_key,omitempty"`
+	Direction Direction                         `protobuf:"varint,2,opt,name=direction,proto3,enum=metro.Direction" json:"direction,omitempty"`
+	DepartsAt github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=departs_at,json=departsAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"departs_at,omitempty"`
+}
+
+func (m *Departure) Reset()         { *m = Departure{} }
+func (m *Departure) String() string { return proto.CompactTextString(m) }
+func (*Departure) ProtoMessage()    {}
+func (*Departure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{15}
+}
+func (m *Departure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Departure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Departure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *Departure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Departure.Merge(m, src)
+}
+func (m *Departure) XXX_Size() int {
+	return m.Size()
+}
+func (m *Departure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Departure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Departure proto.InternalMessageInfo
+
+func (m *Departure) GetLineKey() []byte {
+	if m != nil {
+		return m.LineKey
 	}
-	return n
+	return nil
 }
 
-func (m *TrainArriveStationEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Departure) GetDirection() Direction {
+	if m != nil {
+		return m.Direction
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return DirectionInvalid
+}
+
+func (m *Departure) GetDepartsAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DepartsAt
 	}
-	l = len(m.PrimaryKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return 0
+}
+
+// FareTable holds the pricing rules of all trips.
+//
+// The fare of a trip is the sum of the base fare, the stop fare for every stop
+// travelled and the zone fare for every zone boundary crossed. The sum is
+// adjusted by the time of day multiplier and reduced by the passenger's
+// category discount.
+type FareTable struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	BaseFare coin.Coin       `protobuf:"bytes,2,opt,name=base_fare,json=baseFare,proto3" json:"base_fare"`
+	StopFare coin.Coin       `protobuf:"bytes,3,opt,name=stop_fare,json=stopFare,proto3" json:"stop_fare"`
+	ZoneFare coin.Coin       `protobuf:"bytes,4,opt,name=zone_fare,json=zoneFare,proto3" json:"zone_fare"`
+	// zone of every station, stations without a zone are in zone 0
+	Zones           []StationZone    `protobuf:"bytes,5,rep,name=zones,proto3" json:"zones"`
+	TimeMultipliers []TimeMultiplier `protobuf:"bytes,6,rep,name=time_multipliers,json=timeMultipliers,proto3" json:"time_multipliers"`
+	Discounts       []Discount       `protobuf:"bytes,7,rep,name=discounts,proto3" json:"discounts"`
+	// price of a weekly pass, passes are not sold if not set
+	WeeklyPass *coin.Coin `protobuf:"bytes,8,opt,name=weekly_pass,json=weeklyPass,proto3" json:"weekly_pass,omitempty"`
+	// price of a monthly pass, passes are not sold if not set
+	MonthlyPass *coin.Coin `protobuf:"bytes,9,opt,name=monthly_pass,json=monthlyPass,proto3" json:"monthly_pass,omitempty"`
+}
+
+func (m *FareTable) Reset()         { *m = FareTable{} }
+func (m *FareTable) String() string { return proto.CompactTextString(m) }
+func (*FareTable) ProtoMessage()    {}
+func (*FareTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{16}
+}
+func (m *FareTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FareTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FareTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.StationKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *FareTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FareTable.Merge(m, src)
+}
+func (m *FareTable) XXX_Size() int {
+	return m.Size()
+}
+func (m *FareTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_FareTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FareTable proto.InternalMessageInfo
+
+func (m *FareTable) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.TrainKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *FareTable) GetBaseFare() coin.Coin {
+	if m != nil {
+		return m.BaseFare
 	}
-	if m.ArrivedAt != 0 {
-		n += 1 + sovCodec(uint64(m.ArrivedAt))
+	return coin.Coin{}
+}
+
+func (m *FareTable) GetStopFare() coin.Coin {
+	if m != nil {
+		return m.StopFare
 	}
-	return n
+	return coin.Coin{}
 }
 
-func (m *RegisterPassengerMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FareTable) GetZoneFare() coin.Coin {
+	if m != nil {
+		return m.ZoneFare
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return coin.Coin{}
+}
+
+func (m *FareTable) GetZones() []StationZone {
+	if m != nil {
+		return m.Zones
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *FareTable) GetTimeMultipliers() []TimeMultiplier {
+	if m != nil {
+		return m.TimeMultipliers
 	}
-	return n
+	return nil
 }
 
-func (m *TrainArriveStationEventMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FareTable) GetDiscounts() []Discount {
+	if m != nil {
+		return m.Discounts
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *FareTable) GetWeeklyPass() *coin.Coin {
+	if m != nil {
+		return m.WeeklyPass
 	}
-	l = len(m.StationKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *FareTable) GetMonthlyPass() *coin.Coin {
+	if m != nil {
+		return m.MonthlyPass
 	}
-	l = len(m.TrainKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+// FareChange is a fare table announced in advance. It replaces the fare table
+// in use when the block time reaches its activation time.
+type FareChange struct {
+	Metadata   *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey []byte                            `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	FareTable  *FareTable                        `protobuf:"bytes,3,opt,name=fare_table,json=fareTable,proto3" json:"fare_table,omitempty"`
+	ActivateAt github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=activate_at,json=activateAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"activate_at,omitempty"`
+	// ScheduledBy is the fare admin that announced the change.
+	ScheduledBy github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=scheduled_by,json=scheduledBy,proto3,casttype=github.com/iov-one/weave.Address" json:"scheduled_by,omitempty"`
+	// TaskID is the cron task that activates the change.
+	TaskID []byte `protobuf:"bytes,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// ActivatedAt is set once the fare table is in use.
+	ActivatedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=activated_at,json=activatedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"activated_at,omitempty"`
+}
+
+func (m *FareChange) Reset()         { *m = FareChange{} }
+func (m *FareChange) String() string { return proto.CompactTextString(m) }
+func (*FareChange) ProtoMessage()    {}
+func (*FareChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{17}
+}
+func (m *FareChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FareChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FareChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FareChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FareChange.Merge(m, src)
+}
+func (m *FareChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *FareChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FareChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FareChange proto.InternalMessageInfo
+
+func (m *FareChange) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *FareChange) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *FareChange) GetFareTable() *FareTable {
+	if m != nil {
+		return m.FareTable
+	}
+	return nil
+}
+
+func (m *FareChange) GetActivateAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ActivateAt
+	}
+	return 0
+}
+
+func (m *FareChange) GetScheduledBy() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.ScheduledBy
+	}
+	return nil
+}
+
+func (m *FareChange) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
+}
+
+func (m *FareChange) GetActivatedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ActivatedAt
+	}
+	return 0
+}
+
+type StationZone struct {
+	StationKey []byte `protobuf:"bytes,1,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	Zone       uint32 `protobuf:"varint,2,opt,name=zone,proto3" json:"zone,omitempty"`
+}
+
+func (m *StationZone) Reset()         { *m = StationZone{} }
+func (m *StationZone) String() string { return proto.CompactTextString(m) }
+func (*StationZone) ProtoMessage()    {}
+func (*StationZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{18}
+}
+func (m *StationZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StationZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StationZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StationZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StationZone.Merge(m, src)
+}
+func (m *StationZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *StationZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_StationZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StationZone proto.InternalMessageInfo
+
+func (m *StationZone) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *StationZone) GetZone() uint32 {
+	if m != nil {
+		return m.Zone
+	}
+	return 0
+}
+
+// TimeMultiplier changes the fare of trips started within a period of a day,
+// for example during the rush hours.
+type TimeMultiplier struct {
+	// start of the period, in minutes since midnight UTC, inclusive
+	StartMinute uint32 `protobuf:"varint,1,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	// end of the period, in minutes since midnight UTC, exclusive
+	EndMinute uint32 `protobuf:"varint,2,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	// percent of the fare that is charged, for example 150 for one and a half
+	// of the regular fare
+	Percent uint32 `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (m *TimeMultiplier) Reset()         { *m = TimeMultiplier{} }
+func (m *TimeMultiplier) String() string { return proto.CompactTextString(m) }
+func (*TimeMultiplier) ProtoMessage()    {}
+func (*TimeMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{19}
+}
+func (m *TimeMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeMultiplier.Merge(m, src)
+}
+func (m *TimeMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeMultiplier proto.InternalMessageInfo
+
+func (m *TimeMultiplier) GetStartMinute() uint32 {
+	if m != nil {
+		return m.StartMinute
+	}
+	return 0
+}
+
+func (m *TimeMultiplier) GetEndMinute() uint32 {
+	if m != nil {
+		return m.EndMinute
+	}
+	return 0
+}
+
+func (m *TimeMultiplier) GetPercent() uint32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+type Discount struct {
+	Category PassengerCategory `protobuf:"varint,1,opt,name=category,proto3,enum=metro.PassengerCategory" json:"category,omitempty"`
+	// percent of the fare that is not charged
+	Percent uint32 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (m *Discount) Reset()         { *m = Discount{} }
+func (m *Discount) String() string { return proto.CompactTextString(m) }
+func (*Discount) ProtoMessage()    {}
+func (*Discount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{20}
+}
+func (m *Discount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Discount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Discount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Discount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Discount.Merge(m, src)
+}
+func (m *Discount) XXX_Size() int {
+	return m.Size()
+}
+func (m *Discount) XXX_DiscardUnknown() {
+	xxx_messageInfo_Discount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Discount proto.InternalMessageInfo
+
+func (m *Discount) GetCategory() PassengerCategory {
+	if m != nil {
+		return m.Category
+	}
+	return CategoryStandard
+}
+
+func (m *Discount) GetPercent() uint32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+// FareQuoteRequest is the query data of the /farequote path.
+type FareQuoteRequest struct {
+	EntryStationKey []byte            `protobuf:"bytes,1,opt,name=entry_station_key,json=entryStationKey,proto3" json:"entry_station_key,omitempty"`
+	ExitStationKey  []byte            `protobuf:"bytes,2,opt,name=exit_station_key,json=exitStationKey,proto3" json:"exit_station_key,omitempty"`
+	Category        PassengerCategory `protobuf:"varint,3,opt,name=category,proto3,enum=metro.PassengerCategory" json:"category,omitempty"`
+	// time of entering the entry station
+	At github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=at,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"at,omitempty"`
+}
+
+func (m *FareQuoteRequest) Reset()         { *m = FareQuoteRequest{} }
+func (m *FareQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*FareQuoteRequest) ProtoMessage()    {}
+func (*FareQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{21}
+}
+func (m *FareQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FareQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FareQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FareQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FareQuoteRequest.Merge(m, src)
+}
+func (m *FareQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FareQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FareQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FareQuoteRequest proto.InternalMessageInfo
+
+func (m *FareQuoteRequest) GetEntryStationKey() []byte {
+	if m != nil {
+		return m.EntryStationKey
+	}
+	return nil
+}
+
+func (m *FareQuoteRequest) GetExitStationKey() []byte {
+	if m != nil {
+		return m.ExitStationKey
+	}
+	return nil
+}
+
+func (m *FareQuoteRequest) GetCategory() PassengerCategory {
+	if m != nil {
+		return m.Category
+	}
+	return CategoryStandard
+}
+
+func (m *FareQuoteRequest) GetAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.At
+	}
+	return 0
+}
+
+// ValidPassRequest is the data of the /passes/valid query.
+type ValidPassRequest struct {
+	Address github_com_iov_one_weave.Address  `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	At      github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=at,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"at,omitempty"`
+}
+
+func (m *ValidPassRequest) Reset()         { *m = ValidPassRequest{} }
+func (m *ValidPassRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidPassRequest) ProtoMessage()    {}
+func (*ValidPassRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{22}
+}
+func (m *ValidPassRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidPassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidPassRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidPassRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidPassRequest.Merge(m, src)
+}
+func (m *ValidPassRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidPassRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidPassRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidPassRequest proto.InternalMessageInfo
+
+func (m *ValidPassRequest) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ValidPassRequest) GetAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.At
+	}
+	return 0
+}
+
+// FareQuote is the result of the /farequote query.
+type FareQuote struct {
+	Fare  coin.Coin `protobuf:"bytes,1,opt,name=fare,proto3" json:"fare"`
+	Stops uint32    `protobuf:"varint,2,opt,name=stops,proto3" json:"stops,omitempty"`
+	Zones uint32    `protobuf:"varint,3,opt,name=zones,proto3" json:"zones,omitempty"`
+}
+
+func (m *FareQuote) Reset()         { *m = FareQuote{} }
+func (m *FareQuote) String() string { return proto.CompactTextString(m) }
+func (*FareQuote) ProtoMessage()    {}
+func (*FareQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{23}
+}
+func (m *FareQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FareQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FareQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FareQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FareQuote.Merge(m, src)
+}
+func (m *FareQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *FareQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_FareQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FareQuote proto.InternalMessageInfo
+
+func (m *FareQuote) GetFare() coin.Coin {
+	if m != nil {
+		return m.Fare
+	}
+	return coin.Coin{}
+}
+
+func (m *FareQuote) GetStops() uint32 {
+	if m != nil {
+		return m.Stops
+	}
+	return 0
+}
+
+func (m *FareQuote) GetZones() uint32 {
+	if m != nil {
+		return m.Zones
+	}
+	return 0
+}
+
+// Trip is a journey of a passenger between a tap in and a tap out.
+type Trip struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	// pk of passenger
+	PassengerKey []byte `protobuf:"bytes,3,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	// pk of station where the passenger tapped in
+	EntryStationKey []byte                            `protobuf:"bytes,4,opt,name=entry_station_key,json=entryStationKey,proto3" json:"entry_station_key,omitempty"`
+	EnteredAt       github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=entered_at,json=enteredAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"entered_at,omitempty"`
+	// pk of station where the passenger tapped out, empty while the trip is in progress
+	ExitStationKey []byte                            `protobuf:"bytes,6,opt,name=exit_station_key,json=exitStationKey,proto3" json:"exit_station_key,omitempty"`
+	ExitedAt       github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=exited_at,json=exitedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"exited_at,omitempty"`
+	// fare charged at tap out
+	Fare *coin.Coin `protobuf:"bytes,8,opt,name=fare,proto3" json:"fare,omitempty"`
+}
+
+func (m *Trip) Reset()         { *m = Trip{} }
+func (m *Trip) String() string { return proto.CompactTextString(m) }
+func (*Trip) ProtoMessage()    {}
+func (*Trip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{24}
+}
+func (m *Trip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trip.Merge(m, src)
+}
+func (m *Trip) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trip) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trip proto.InternalMessageInfo
+
+func (m *Trip) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Trip) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *Trip) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *Trip) GetEntryStationKey() []byte {
+	if m != nil {
+		return m.EntryStationKey
+	}
+	return nil
+}
+
+func (m *Trip) GetEnteredAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.EnteredAt
+	}
+	return 0
+}
+
+func (m *Trip) GetExitStationKey() []byte {
+	if m != nil {
+		return m.ExitStationKey
+	}
+	return nil
+}
+
+func (m *Trip) GetExitedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExitedAt
+	}
+	return 0
+}
+
+func (m *Trip) GetFare() *coin.Coin {
+	if m != nil {
+		return m.Fare
+	}
+	return nil
+}
+
+// Journey is a passage of a passenger through the station gates. A journey
+// that is not ended within the maximum duration expires.
+type Journey struct {
+	Metadata        *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey      []byte                            `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	PassengerKey    []byte                            `protobuf:"bytes,3,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	EntryStationKey []byte                            `protobuf:"bytes,4,opt,name=entry_station_key,json=entryStationKey,proto3" json:"entry_station_key,omitempty"`
+	StartedAt       github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"started_at,omitempty"`
+	// pk of the station the passenger exited at, empty unless completed
+	ExitStationKey []byte                            `protobuf:"bytes,6,opt,name=exit_station_key,json=exitStationKey,proto3" json:"exit_station_key,omitempty"`
+	EndedAt        github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=ended_at,json=endedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"ended_at,omitempty"`
+	// fare charged when the journey ended
+	Fare   *coin.Coin    `protobuf:"bytes,8,opt,name=fare,proto3" json:"fare,omitempty"`
+	Status JourneyStatus `protobuf:"varint,9,opt,name=status,proto3,enum=metro.JourneyStatus" json:"status,omitempty"`
+	// ExpiryTaskID is the cron task that expires the journey while it is open.
+	ExpiryTaskID []byte `protobuf:"bytes,10,opt,name=expiry_task_id,json=expiryTaskId,proto3" json:"expiry_task_id,omitempty"`
+}
+
+func (m *Journey) Reset()         { *m = Journey{} }
+func (m *Journey) String() string { return proto.CompactTextString(m) }
+func (*Journey) ProtoMessage()    {}
+func (*Journey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{25}
+}
+func (m *Journey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Journey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Journey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Journey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Journey.Merge(m, src)
+}
+func (m *Journey) XXX_Size() int {
+	return m.Size()
+}
+func (m *Journey) XXX_DiscardUnknown() {
+	xxx_messageInfo_Journey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Journey proto.InternalMessageInfo
+
+func (m *Journey) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Journey) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *Journey) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *Journey) GetEntryStationKey() []byte {
+	if m != nil {
+		return m.EntryStationKey
+	}
+	return nil
+}
+
+func (m *Journey) GetStartedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *Journey) GetExitStationKey() []byte {
+	if m != nil {
+		return m.ExitStationKey
+	}
+	return nil
+}
+
+func (m *Journey) GetEndedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.EndedAt
+	}
+	return 0
+}
+
+func (m *Journey) GetFare() *coin.Coin {
+	if m != nil {
+		return m.Fare
+	}
+	return nil
+}
+
+func (m *Journey) GetStatus() JourneyStatus {
+	if m != nil {
+		return m.Status
+	}
+	return JourneyInvalid
+}
+
+func (m *Journey) GetExpiryTaskID() []byte {
+	if m != nil {
+		return m.ExpiryTaskID
+	}
+	return nil
+}
+
+// Pass is a period pass bought up front. The price is held in escrow and
+// released to the fare collector as the period elapses.
+type Pass struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey   []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	PassengerKey []byte          `protobuf:"bytes,3,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	// Holder is the passenger address that paid for the pass. Refunds are
+	// returned to it.
+	Holder     github_com_iov_one_weave.Address  `protobuf:"bytes,4,opt,name=holder,proto3,casttype=github.com/iov-one/weave.Address" json:"holder,omitempty"`
+	Kind       PassKind                          `protobuf:"varint,5,opt,name=kind,proto3,enum=metro.PassKind" json:"kind,omitempty"`
+	ValidFrom  github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=valid_from,json=validFrom,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"valid_from,omitempty"`
+	ValidUntil github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=valid_until,json=validUntil,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"valid_until,omitempty"`
+	Price      coin.Coin                         `protobuf:"bytes,8,opt,name=price,proto3" json:"price"`
+	// Released is the part of the price paid out to the fare collector so far.
+	Released coin.Coin `protobuf:"bytes,9,opt,name=released,proto3" json:"released"`
+	// CancelledAt is set when the holder cancels the pass.
+	CancelledAt github_com_iov_one_weave.UnixTime `protobuf:"varint,10,opt,name=cancelled_at,json=cancelledAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"cancelled_at,omitempty"`
+}
+
+func (m *Pass) Reset()         { *m = Pass{} }
+func (m *Pass) String() string { return proto.CompactTextString(m) }
+func (*Pass) ProtoMessage()    {}
+func (*Pass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{26}
+}
+func (m *Pass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pass.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pass.Merge(m, src)
+}
+func (m *Pass) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pass) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pass proto.InternalMessageInfo
+
+func (m *Pass) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Pass) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *Pass) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *Pass) GetHolder() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Holder
+	}
+	return nil
+}
+
+func (m *Pass) GetKind() PassKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PassInvalid
+}
+
+func (m *Pass) GetValidFrom() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return 0
+}
+
+func (m *Pass) GetValidUntil() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *Pass) GetPrice() coin.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return coin.Coin{}
+}
+
+func (m *Pass) GetReleased() coin.Coin {
+	if m != nil {
+		return m.Released
+	}
+	return coin.Coin{}
+}
+
+func (m *Pass) GetCancelledAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CancelledAt
+	}
+	return 0
+}
+
+// ScheduledStop is a planned call of a train at a station. Arrivals are
+// matched against the nearest planned stop to compute the delay.
+type ScheduledStop struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	TrainKey   []byte          `protobuf:"bytes,3,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+	StationKey []byte          `protobuf:"bytes,4,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	// pk of the line the train serves during this stop
+	LineKey   []byte                            `protobuf:"bytes,5,opt,name=line_key,json=lineKey,proto3" json:"line_key,omitempty"`
+	PlannedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=planned_at,json=plannedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"planned_at,omitempty"`
+}
+
+func (m *ScheduledStop) Reset()         { *m = ScheduledStop{} }
+func (m *ScheduledStop) String() string { return proto.CompactTextString(m) }
+func (*ScheduledStop) ProtoMessage()    {}
+func (*ScheduledStop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{27}
+}
+func (m *ScheduledStop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledStop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledStop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledStop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledStop.Merge(m, src)
+}
+func (m *ScheduledStop) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledStop) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledStop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledStop proto.InternalMessageInfo
+
+func (m *ScheduledStop) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ScheduledStop) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *ScheduledStop) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+func (m *ScheduledStop) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *ScheduledStop) GetLineKey() []byte {
+	if m != nil {
+		return m.LineKey
+	}
+	return nil
+}
+
+func (m *ScheduledStop) GetPlannedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.PlannedAt
+	}
+	return 0
+}
+
+// LineDelay is the rolling delay aggregate of a line, stored under the line
+// key.
+type LineDelay struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// delays of the most recent arrivals in seconds, oldest first
+	RecentDelays []int64 `protobuf:"varint,2,rep,packed,name=recent_delays,json=recentDelays,proto3" json:"recent_delays,omitempty"`
+	// average of the recent delays in seconds
+	AverageDelay int64                             `protobuf:"varint,3,opt,name=average_delay,json=averageDelay,proto3" json:"average_delay,omitempty"`
+	UpdatedAt    github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"updated_at,omitempty"`
+}
+
+func (m *LineDelay) Reset()         { *m = LineDelay{} }
+func (m *LineDelay) String() string { return proto.CompactTextString(m) }
+func (*LineDelay) ProtoMessage()    {}
+func (*LineDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{28}
+}
+func (m *LineDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LineDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LineDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LineDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineDelay.Merge(m, src)
+}
+func (m *LineDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *LineDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineDelay proto.InternalMessageInfo
+
+func (m *LineDelay) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *LineDelay) GetRecentDelays() []int64 {
+	if m != nil {
+		return m.RecentDelays
+	}
+	return nil
+}
+
+func (m *LineDelay) GetAverageDelay() int64 {
+	if m != nil {
+		return m.AverageDelay
+	}
+	return 0
+}
+
+func (m *LineDelay) GetUpdatedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// TrainPosition is the last known position of a train, stored under the
+// train key and updated on every arrival.
+type TrainPosition struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TrainKey []byte          `protobuf:"bytes,2,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+	// pk of the station the train arrived at last
+	StationKey []byte                            `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	ArrivedAt  github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=arrived_at,json=arrivedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"arrived_at,omitempty"`
+	// pk of the station the train arrived at before, empty for the first
+	// arrival
+	PreviousStationKey []byte `protobuf:"bytes,5,opt,name=previous_station_key,json=previousStationKey,proto3" json:"previous_station_key,omitempty"`
+	// pk of the line the train is travelling on, empty if it cannot be told
+	LineKey []byte `protobuf:"bytes,6,opt,name=line_key,json=lineKey,proto3" json:"line_key,omitempty"`
+	// direction of travel along the line implied by the last two stations
+	Direction Direction `protobuf:"varint,7,opt,name=direction,proto3,enum=metro.Direction" json:"direction,omitempty"`
+	// pk of the last arrival event
+	ArrivalKey []byte `protobuf:"bytes,8,opt,name=arrival_key,json=arrivalKey,proto3" json:"arrival_key,omitempty"`
+	// DepartedAt is set when the train leaves the station it arrived at last.
+	DepartedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,9,opt,name=departed_at,json=departedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"departed_at,omitempty"`
+}
+
+func (m *TrainPosition) Reset()         { *m = TrainPosition{} }
+func (m *TrainPosition) String() string { return proto.CompactTextString(m) }
+func (*TrainPosition) ProtoMessage()    {}
+func (*TrainPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{29}
+}
+func (m *TrainPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainPosition.Merge(m, src)
+}
+func (m *TrainPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainPosition proto.InternalMessageInfo
+
+func (m *TrainPosition) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TrainPosition) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+func (m *TrainPosition) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *TrainPosition) GetArrivedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ArrivedAt
+	}
+	return 0
+}
+
+func (m *TrainPosition) GetPreviousStationKey() []byte {
+	if m != nil {
+		return m.PreviousStationKey
+	}
+	return nil
+}
+
+func (m *TrainPosition) GetLineKey() []byte {
+	if m != nil {
+		return m.LineKey
+	}
+	return nil
+}
+
+func (m *TrainPosition) GetDirection() Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return DirectionInvalid
+}
+
+func (m *TrainPosition) GetArrivalKey() []byte {
+	if m != nil {
+		return m.ArrivalKey
+	}
+	return nil
+}
+
+func (m *TrainPosition) GetDepartedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DepartedAt
+	}
+	return 0
+}
+
+// Incident is a service disruption announced by an operator.
+type Incident struct {
+	Metadata   *weave.Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey []byte           `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	Severity   IncidentSeverity `protobuf:"varint,3,opt,name=severity,proto3,enum=metro.IncidentSeverity" json:"severity,omitempty"`
+	// pks of the affected stations
+	StationKeys   [][]byte                          `protobuf:"bytes,4,rep,name=station_keys,json=stationKeys,proto3" json:"station_keys,omitempty"`
+	StartedAt     github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"started_at,omitempty"`
+	ExpectedEndAt github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=expected_end_at,json=expectedEndAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"expected_end_at,omitempty"`
+	// announcement in every available language
+	Messages []LocalizedText `protobuf:"bytes,7,rep,name=messages,proto3" json:"messages"`
+	// address of the operator that opened the incident
+	OpenedBy github_com_iov_one_weave.Address `protobuf:"bytes,8,opt,name=opened_by,json=openedBy,proto3,casttype=github.com/iov-one/weave.Address" json:"opened_by,omitempty"`
+	// ResolvedAt is set when the service is back to normal.
+	ResolvedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,9,opt,name=resolved_at,json=resolvedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"resolved_at,omitempty"`
+}
+
+func (m *Incident) Reset()         { *m = Incident{} }
+func (m *Incident) String() string { return proto.CompactTextString(m) }
+func (*Incident) ProtoMessage()    {}
+func (*Incident) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{30}
+}
+func (m *Incident) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Incident) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Incident.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Incident) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Incident.Merge(m, src)
+}
+func (m *Incident) XXX_Size() int {
+	return m.Size()
+}
+func (m *Incident) XXX_DiscardUnknown() {
+	xxx_messageInfo_Incident.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Incident proto.InternalMessageInfo
+
+func (m *Incident) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Incident) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *Incident) GetSeverity() IncidentSeverity {
+	if m != nil {
+		return m.Severity
+	}
+	return SeverityInvalid
+}
+
+func (m *Incident) GetStationKeys() [][]byte {
+	if m != nil {
+		return m.StationKeys
+	}
+	return nil
+}
+
+func (m *Incident) GetStartedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *Incident) GetExpectedEndAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExpectedEndAt
+	}
+	return 0
+}
+
+func (m *Incident) GetMessages() []LocalizedText {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *Incident) GetOpenedBy() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.OpenedBy
+	}
+	return nil
+}
+
+func (m *Incident) GetResolvedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return 0
+}
+
+// LocalizedText is a text in a single language.
+type LocalizedText struct {
+	// ISO 639-1 language code, for example "en" or "tr"
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (m *LocalizedText) Reset()         { *m = LocalizedText{} }
+func (m *LocalizedText) String() string { return proto.CompactTextString(m) }
+func (*LocalizedText) ProtoMessage()    {}
+func (*LocalizedText) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{31}
+}
+func (m *LocalizedText) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalizedText) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalizedText.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalizedText) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalizedText.Merge(m, src)
+}
+func (m *LocalizedText) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalizedText) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalizedText.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalizedText proto.InternalMessageInfo
+
+func (m *LocalizedText) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *LocalizedText) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type TrainArriveStationEvent struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	// pk of station
+	StationKey []byte `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	// pk of train
+	TrainKey  []byte                            `protobuf:"bytes,4,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+	ArrivedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=arrived_at,json=arrivedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"arrived_at,omitempty"`
+	// pk of the scheduled stop the arrival was matched with, empty if the
+	// train had no stop planned at the station
+	ScheduledStopKey []byte `protobuf:"bytes,6,opt,name=scheduled_stop_key,json=scheduledStopKey,proto3" json:"scheduled_stop_key,omitempty"`
+	// seconds the train arrived after the planned time, negative when early
+	Delay int64 `protobuf:"varint,7,opt,name=delay,proto3" json:"delay,omitempty"`
+	// pks of the incidents active at the station at the time of the arrival
+	IncidentKeys [][]byte `protobuf:"bytes,8,rep,name=incident_keys,json=incidentKeys,proto3" json:"incident_keys,omitempty"`
+}
+
+func (m *TrainArriveStationEvent) Reset()         { *m = TrainArriveStationEvent{} }
+func (m *TrainArriveStationEvent) String() string { return proto.CompactTextString(m) }
+func (*TrainArriveStationEvent) ProtoMessage()    {}
+func (*TrainArriveStationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{32}
+}
+func (m *TrainArriveStationEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainArriveStationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainArriveStationEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainArriveStationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainArriveStationEvent.Merge(m, src)
+}
+func (m *TrainArriveStationEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainArriveStationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainArriveStationEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainArriveStationEvent proto.InternalMessageInfo
+
+func (m *TrainArriveStationEvent) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TrainArriveStationEvent) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *TrainArriveStationEvent) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *TrainArriveStationEvent) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+func (m *TrainArriveStationEvent) GetArrivedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ArrivedAt
+	}
+	return 0
+}
+
+func (m *TrainArriveStationEvent) GetScheduledStopKey() []byte {
+	if m != nil {
+		return m.ScheduledStopKey
+	}
+	return nil
+}
+
+func (m *TrainArriveStationEvent) GetDelay() int64 {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+func (m *TrainArriveStationEvent) GetIncidentKeys() [][]byte {
+	if m != nil {
+		return m.IncidentKeys
+	}
+	return nil
+}
+
+// TrainDepartStationEvent is a departure of a train from the station it
+// arrived at last.
+type TrainDepartStationEvent struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PrimaryKey []byte          `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	StationKey []byte          `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	TrainKey   []byte          `protobuf:"bytes,4,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+	// pk of the arrival event the departure closes
+	ArrivalKey []byte                            `protobuf:"bytes,5,opt,name=arrival_key,json=arrivalKey,proto3" json:"arrival_key,omitempty"`
+	DepartedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=departed_at,json=departedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"departed_at,omitempty"`
+	// seconds the train spent at the station
+	Dwell int64 `protobuf:"varint,7,opt,name=dwell,proto3" json:"dwell,omitempty"`
+}
+
+func (m *TrainDepartStationEvent) Reset()         { *m = TrainDepartStationEvent{} }
+func (m *TrainDepartStationEvent) String() string { return proto.CompactTextString(m) }
+func (*TrainDepartStationEvent) ProtoMessage()    {}
+func (*TrainDepartStationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{33}
+}
+func (m *TrainDepartStationEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainDepartStationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainDepartStationEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainDepartStationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainDepartStationEvent.Merge(m, src)
+}
+func (m *TrainDepartStationEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainDepartStationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainDepartStationEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainDepartStationEvent proto.InternalMessageInfo
+
+func (m *TrainDepartStationEvent) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TrainDepartStationEvent) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *TrainDepartStationEvent) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *TrainDepartStationEvent) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+func (m *TrainDepartStationEvent) GetArrivalKey() []byte {
+	if m != nil {
+		return m.ArrivalKey
+	}
+	return nil
+}
+
+func (m *TrainDepartStationEvent) GetDepartedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DepartedAt
+	}
+	return 0
+}
+
+func (m *TrainDepartStationEvent) GetDwell() int64 {
+	if m != nil {
+		return m.Dwell
+	}
+	return 0
+}
+
+type RegisterPassengerMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Name     string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *RegisterPassengerMsg) Reset()         { *m = RegisterPassengerMsg{} }
+func (m *RegisterPassengerMsg) String() string { return proto.CompactTextString(m) }
+func (*RegisterPassengerMsg) ProtoMessage()    {}
+func (*RegisterPassengerMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{34}
+}
+func (m *RegisterPassengerMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterPassengerMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterPassengerMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterPassengerMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterPassengerMsg.Merge(m, src)
+}
+func (m *RegisterPassengerMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterPassengerMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterPassengerMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterPassengerMsg proto.InternalMessageInfo
+
+func (m *RegisterPassengerMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RegisterPassengerMsg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type TrainArriveStationEventMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// id of station
+	StationKey []byte `protobuf:"bytes,2,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	TrainKey   []byte `protobuf:"bytes,3,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+}
+
+func (m *TrainArriveStationEventMsg) Reset()         { *m = TrainArriveStationEventMsg{} }
+func (m *TrainArriveStationEventMsg) String() string { return proto.CompactTextString(m) }
+func (*TrainArriveStationEventMsg) ProtoMessage()    {}
+func (*TrainArriveStationEventMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{35}
+}
+func (m *TrainArriveStationEventMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainArriveStationEventMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainArriveStationEventMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainArriveStationEventMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainArriveStationEventMsg.Merge(m, src)
+}
+func (m *TrainArriveStationEventMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainArriveStationEventMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainArriveStationEventMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainArriveStationEventMsg proto.InternalMessageInfo
+
+func (m *TrainArriveStationEventMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TrainArriveStationEventMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *TrainArriveStationEventMsg) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+// TrainDepartStationEventMsg reports a train leaving the station it arrived
+// at last.
+type TrainDepartStationEventMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StationKey []byte          `protobuf:"bytes,2,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	TrainKey   []byte          `protobuf:"bytes,3,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+}
+
+func (m *TrainDepartStationEventMsg) Reset()         { *m = TrainDepartStationEventMsg{} }
+func (m *TrainDepartStationEventMsg) String() string { return proto.CompactTextString(m) }
+func (*TrainDepartStationEventMsg) ProtoMessage()    {}
+func (*TrainDepartStationEventMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{36}
+}
+func (m *TrainDepartStationEventMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainDepartStationEventMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainDepartStationEventMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainDepartStationEventMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainDepartStationEventMsg.Merge(m, src)
+}
+func (m *TrainDepartStationEventMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainDepartStationEventMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainDepartStationEventMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainDepartStationEventMsg proto.InternalMessageInfo
+
+func (m *TrainDepartStationEventMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TrainDepartStationEventMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *TrainDepartStationEventMsg) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+type CreateLineMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Name        string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color       string          `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	StationKeys [][]byte        `protobuf:"bytes,4,rep,name=station_keys,json=stationKeys,proto3" json:"station_keys,omitempty"`
+}
+
+func (m *CreateLineMsg) Reset()         { *m = CreateLineMsg{} }
+func (m *CreateLineMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateLineMsg) ProtoMessage()    {}
+func (*CreateLineMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{37}
+}
+func (m *CreateLineMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateLineMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateLineMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateLineMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLineMsg.Merge(m, src)
+}
+func (m *CreateLineMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateLineMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLineMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLineMsg proto.InternalMessageInfo
+
+func (m *CreateLineMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateLineMsg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateLineMsg) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *CreateLineMsg) GetStationKeys() [][]byte {
+	if m != nil {
+		return m.StationKeys
+	}
+	return nil
+}
+
+// UpdateLineMsg replaces the name, color and station list of a line.
+type UpdateLineMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LineKey     []byte          `protobuf:"bytes,2,opt,name=line_key,json=lineKey,proto3" json:"line_key,omitempty"`
+	Name        string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Color       string          `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	StationKeys [][]byte        `protobuf:"bytes,5,rep,name=station_keys,json=stationKeys,proto3" json:"station_keys,omitempty"`
+}
+
+func (m *UpdateLineMsg) Reset()         { *m = UpdateLineMsg{} }
+func (m *UpdateLineMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateLineMsg) ProtoMessage()    {}
+func (*UpdateLineMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{38}
+}
+func (m *UpdateLineMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateLineMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateLineMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateLineMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLineMsg.Merge(m, src)
+}
+func (m *UpdateLineMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateLineMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLineMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLineMsg proto.InternalMessageInfo
+
+func (m *UpdateLineMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateLineMsg) GetLineKey() []byte {
+	if m != nil {
+		return m.LineKey
+	}
+	return nil
+}
+
+func (m *UpdateLineMsg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateLineMsg) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *UpdateLineMsg) GetStationKeys() [][]byte {
+	if m != nil {
+		return m.StationKeys
+	}
+	return nil
+}
+
+// TapInMsg is sent by a toll gate when a passenger enters a station.
+type TapInMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey []byte          `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	StationKey   []byte          `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+}
+
+func (m *TapInMsg) Reset()         { *m = TapInMsg{} }
+func (m *TapInMsg) String() string { return proto.CompactTextString(m) }
+func (*TapInMsg) ProtoMessage()    {}
+func (*TapInMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{39}
+}
+func (m *TapInMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TapInMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TapInMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TapInMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapInMsg.Merge(m, src)
+}
+func (m *TapInMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TapInMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapInMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapInMsg proto.InternalMessageInfo
+
+func (m *TapInMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TapInMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *TapInMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+// TapOutMsg is sent by a toll gate when a passenger leaves a station. It
+// closes the passenger's trip and charges the fare.
+type TapOutMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey []byte          `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	StationKey   []byte          `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+}
+
+func (m *TapOutMsg) Reset()         { *m = TapOutMsg{} }
+func (m *TapOutMsg) String() string { return proto.CompactTextString(m) }
+func (*TapOutMsg) ProtoMessage()    {}
+func (*TapOutMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{40}
+}
+func (m *TapOutMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TapOutMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TapOutMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TapOutMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapOutMsg.Merge(m, src)
+}
+func (m *TapOutMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TapOutMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapOutMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapOutMsg proto.InternalMessageInfo
+
+func (m *TapOutMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TapOutMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *TapOutMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+// StartJourneyMsg is sent by a gate when a passenger enters a station.
+type StartJourneyMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey []byte          `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	StationKey   []byte          `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+}
+
+func (m *StartJourneyMsg) Reset()         { *m = StartJourneyMsg{} }
+func (m *StartJourneyMsg) String() string { return proto.CompactTextString(m) }
+func (*StartJourneyMsg) ProtoMessage()    {}
+func (*StartJourneyMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{41}
+}
+func (m *StartJourneyMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartJourneyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartJourneyMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartJourneyMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartJourneyMsg.Merge(m, src)
+}
+func (m *StartJourneyMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartJourneyMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartJourneyMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartJourneyMsg proto.InternalMessageInfo
+
+func (m *StartJourneyMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *StartJourneyMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *StartJourneyMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+// EndJourneyMsg is sent by a gate when a passenger exits a station.
+type EndJourneyMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey []byte          `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	StationKey   []byte          `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+}
+
+func (m *EndJourneyMsg) Reset()         { *m = EndJourneyMsg{} }
+func (m *EndJourneyMsg) String() string { return proto.CompactTextString(m) }
+func (*EndJourneyMsg) ProtoMessage()    {}
+func (*EndJourneyMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{42}
+}
+func (m *EndJourneyMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndJourneyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndJourneyMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndJourneyMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndJourneyMsg.Merge(m, src)
+}
+func (m *EndJourneyMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndJourneyMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndJourneyMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndJourneyMsg proto.InternalMessageInfo
+
+func (m *EndJourneyMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *EndJourneyMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *EndJourneyMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+// ExpireJourneyMsg is executed by cron when a journey exceeds the maximum
+// duration. It cannot be submitted in a transaction.
+type ExpireJourneyMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	JourneyKey []byte          `protobuf:"bytes,2,opt,name=journey_key,json=journeyKey,proto3" json:"journey_key,omitempty"`
+}
+
+func (m *ExpireJourneyMsg) Reset()         { *m = ExpireJourneyMsg{} }
+func (m *ExpireJourneyMsg) String() string { return proto.CompactTextString(m) }
+func (*ExpireJourneyMsg) ProtoMessage()    {}
+func (*ExpireJourneyMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{43}
+}
+func (m *ExpireJourneyMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpireJourneyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpireJourneyMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpireJourneyMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpireJourneyMsg.Merge(m, src)
+}
+func (m *ExpireJourneyMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpireJourneyMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpireJourneyMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpireJourneyMsg proto.InternalMessageInfo
+
+func (m *ExpireJourneyMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ExpireJourneyMsg) GetJourneyKey() []byte {
+	if m != nil {
+		return m.JourneyKey
+	}
+	return nil
+}
+
+// PurchasePassMsg buys a pass for a passenger. The price of the pass kind is
+// taken from the fare table and moved to the escrow of the pass.
+type PurchasePassMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey []byte          `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	Kind         PassKind        `protobuf:"varint,3,opt,name=kind,proto3,enum=metro.PassKind" json:"kind,omitempty"`
+	// ValidFrom defaults to the block time if not set.
+	ValidFrom github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=valid_from,json=validFrom,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"valid_from,omitempty"`
+}
+
+func (m *PurchasePassMsg) Reset()         { *m = PurchasePassMsg{} }
+func (m *PurchasePassMsg) String() string { return proto.CompactTextString(m) }
+func (*PurchasePassMsg) ProtoMessage()    {}
+func (*PurchasePassMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{44}
+}
+func (m *PurchasePassMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchasePassMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchasePassMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchasePassMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchasePassMsg.Merge(m, src)
+}
+func (m *PurchasePassMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchasePassMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchasePassMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchasePassMsg proto.InternalMessageInfo
+
+func (m *PurchasePassMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PurchasePassMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *PurchasePassMsg) GetKind() PassKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PassInvalid
+}
+
+func (m *PurchasePassMsg) GetValidFrom() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return 0
+}
+
+// CancelPassMsg ends a pass early. The elapsed part of the price is released
+// to the fare collector and the rest is refunded to the holder.
+type CancelPassMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassKey  []byte          `protobuf:"bytes,2,opt,name=pass_key,json=passKey,proto3" json:"pass_key,omitempty"`
+}
+
+func (m *CancelPassMsg) Reset()         { *m = CancelPassMsg{} }
+func (m *CancelPassMsg) String() string { return proto.CompactTextString(m) }
+func (*CancelPassMsg) ProtoMessage()    {}
+func (*CancelPassMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{45}
+}
+func (m *CancelPassMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelPassMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelPassMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelPassMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPassMsg.Merge(m, src)
+}
+func (m *CancelPassMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelPassMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPassMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelPassMsg proto.InternalMessageInfo
+
+func (m *CancelPassMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CancelPassMsg) GetPassKey() []byte {
+	if m != nil {
+		return m.PassKey
+	}
+	return nil
+}
+
+// ReleasePassMsg pays out the elapsed part of the pass price to the fare
+// collector. It can be submitted by anyone.
+type ReleasePassMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassKey  []byte          `protobuf:"bytes,2,opt,name=pass_key,json=passKey,proto3" json:"pass_key,omitempty"`
+}
+
+func (m *ReleasePassMsg) Reset()         { *m = ReleasePassMsg{} }
+func (m *ReleasePassMsg) String() string { return proto.CompactTextString(m) }
+func (*ReleasePassMsg) ProtoMessage()    {}
+func (*ReleasePassMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{46}
+}
+func (m *ReleasePassMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleasePassMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleasePassMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleasePassMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePassMsg.Merge(m, src)
+}
+func (m *ReleasePassMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleasePassMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePassMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleasePassMsg proto.InternalMessageInfo
+
+func (m *ReleasePassMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReleasePassMsg) GetPassKey() []byte {
+	if m != nil {
+		return m.PassKey
+	}
+	return nil
+}
+
+// UpdateFareTableMsg replaces the fare table.
+type UpdateFareTableMsg struct {
+	Metadata  *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	FareTable *FareTable      `protobuf:"bytes,2,opt,name=fare_table,json=fareTable,proto3" json:"fare_table,omitempty"`
+}
+
+func (m *UpdateFareTableMsg) Reset()         { *m = UpdateFareTableMsg{} }
+func (m *UpdateFareTableMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateFareTableMsg) ProtoMessage()    {}
+func (*UpdateFareTableMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{47}
+}
+func (m *UpdateFareTableMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateFareTableMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateFareTableMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateFareTableMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateFareTableMsg.Merge(m, src)
+}
+func (m *UpdateFareTableMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateFareTableMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateFareTableMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateFareTableMsg proto.InternalMessageInfo
+
+func (m *UpdateFareTableMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateFareTableMsg) GetFareTable() *FareTable {
+	if m != nil {
+		return m.FareTable
+	}
+	return nil
+}
+
+// ScheduleFareChangeMsg announces a fare table that replaces the one in use at
+// given time.
+type ScheduleFareChangeMsg struct {
+	Metadata   *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	FareTable  *FareTable                        `protobuf:"bytes,2,opt,name=fare_table,json=fareTable,proto3" json:"fare_table,omitempty"`
+	ActivateAt github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=activate_at,json=activateAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"activate_at,omitempty"`
+}
+
+func (m *ScheduleFareChangeMsg) Reset()         { *m = ScheduleFareChangeMsg{} }
+func (m *ScheduleFareChangeMsg) String() string { return proto.CompactTextString(m) }
+func (*ScheduleFareChangeMsg) ProtoMessage()    {}
+func (*ScheduleFareChangeMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{48}
+}
+func (m *ScheduleFareChangeMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleFareChangeMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleFareChangeMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleFareChangeMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleFareChangeMsg.Merge(m, src)
+}
+func (m *ScheduleFareChangeMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleFareChangeMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleFareChangeMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleFareChangeMsg proto.InternalMessageInfo
+
+func (m *ScheduleFareChangeMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ScheduleFareChangeMsg) GetFareTable() *FareTable {
+	if m != nil {
+		return m.FareTable
+	}
+	return nil
+}
+
+func (m *ScheduleFareChangeMsg) GetActivateAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ActivateAt
+	}
+	return 0
+}
+
+// ActivateFareChangeMsg is executed by cron at the activation time of a fare
+// change. It cannot be submitted in a transaction.
+type ActivateFareChangeMsg struct {
+	Metadata      *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	FareChangeKey []byte          `protobuf:"bytes,2,opt,name=fare_change_key,json=fareChangeKey,proto3" json:"fare_change_key,omitempty"`
+}
+
+func (m *ActivateFareChangeMsg) Reset()         { *m = ActivateFareChangeMsg{} }
+func (m *ActivateFareChangeMsg) String() string { return proto.CompactTextString(m) }
+func (*ActivateFareChangeMsg) ProtoMessage()    {}
+func (*ActivateFareChangeMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{49}
+}
+func (m *ActivateFareChangeMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivateFareChangeMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivateFareChangeMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivateFareChangeMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateFareChangeMsg.Merge(m, src)
+}
+func (m *ActivateFareChangeMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivateFareChangeMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateFareChangeMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivateFareChangeMsg proto.InternalMessageInfo
+
+func (m *ActivateFareChangeMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ActivateFareChangeMsg) GetFareChangeKey() []byte {
+	if m != nil {
+		return m.FareChangeKey
+	}
+	return nil
+}
+
+// SetPassengerCategoryMsg assigns a concession category to a passenger.
+type SetPassengerCategoryMsg struct {
+	Metadata     *weave.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey []byte            `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	Category     PassengerCategory `protobuf:"varint,3,opt,name=category,proto3,enum=metro.PassengerCategory" json:"category,omitempty"`
+	// ExpiresAt is required for all but the standard category.
+	ExpiresAt github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"expires_at,omitempty"`
+}
+
+func (m *SetPassengerCategoryMsg) Reset()         { *m = SetPassengerCategoryMsg{} }
+func (m *SetPassengerCategoryMsg) String() string { return proto.CompactTextString(m) }
+func (*SetPassengerCategoryMsg) ProtoMessage()    {}
+func (*SetPassengerCategoryMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{50}
+}
+func (m *SetPassengerCategoryMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPassengerCategoryMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPassengerCategoryMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPassengerCategoryMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPassengerCategoryMsg.Merge(m, src)
+}
+func (m *SetPassengerCategoryMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPassengerCategoryMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPassengerCategoryMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPassengerCategoryMsg proto.InternalMessageInfo
+
+func (m *SetPassengerCategoryMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SetPassengerCategoryMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *SetPassengerCategoryMsg) GetCategory() PassengerCategory {
+	if m != nil {
+		return m.Category
+	}
+	return CategoryStandard
+}
+
+func (m *SetPassengerCategoryMsg) GetExpiresAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// PayFareMsg is sent by a passenger to pay for a trip between two stations
+// from their wallet.
+type PayFareMsg struct {
+	Metadata        *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey    []byte          `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	EntryStationKey []byte          `protobuf:"bytes,3,opt,name=entry_station_key,json=entryStationKey,proto3" json:"entry_station_key,omitempty"`
+	ExitStationKey  []byte          `protobuf:"bytes,4,opt,name=exit_station_key,json=exitStationKey,proto3" json:"exit_station_key,omitempty"`
+}
+
+func (m *PayFareMsg) Reset()         { *m = PayFareMsg{} }
+func (m *PayFareMsg) String() string { return proto.CompactTextString(m) }
+func (*PayFareMsg) ProtoMessage()    {}
+func (*PayFareMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{51}
+}
+func (m *PayFareMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayFareMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayFareMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayFareMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayFareMsg.Merge(m, src)
+}
+func (m *PayFareMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayFareMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayFareMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayFareMsg proto.InternalMessageInfo
+
+func (m *PayFareMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PayFareMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *PayFareMsg) GetEntryStationKey() []byte {
+	if m != nil {
+		return m.EntryStationKey
+	}
+	return nil
+}
+
+func (m *PayFareMsg) GetExitStationKey() []byte {
+	if m != nil {
+		return m.ExitStationKey
+	}
+	return nil
+}
+
+// UpdatePassengerMsg changes the name and the address of a passenger.
+type UpdatePassengerMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey []byte          `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+	Name         string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Address is optional. If set, the passenger is bound to the new address.
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+}
+
+func (m *UpdatePassengerMsg) Reset()         { *m = UpdatePassengerMsg{} }
+func (m *UpdatePassengerMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdatePassengerMsg) ProtoMessage()    {}
+func (*UpdatePassengerMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{52}
+}
+func (m *UpdatePassengerMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePassengerMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePassengerMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePassengerMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePassengerMsg.Merge(m, src)
+}
+func (m *UpdatePassengerMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePassengerMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePassengerMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePassengerMsg proto.InternalMessageInfo
+
+func (m *UpdatePassengerMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdatePassengerMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+func (m *UpdatePassengerMsg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdatePassengerMsg) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// DeregisterPassengerMsg closes the account of a passenger.
+type DeregisterPassengerMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PassengerKey []byte          `protobuf:"bytes,2,opt,name=passenger_key,json=passengerKey,proto3" json:"passenger_key,omitempty"`
+}
+
+func (m *DeregisterPassengerMsg) Reset()         { *m = DeregisterPassengerMsg{} }
+func (m *DeregisterPassengerMsg) String() string { return proto.CompactTextString(m) }
+func (*DeregisterPassengerMsg) ProtoMessage()    {}
+func (*DeregisterPassengerMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{53}
+}
+func (m *DeregisterPassengerMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterPassengerMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterPassengerMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterPassengerMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterPassengerMsg.Merge(m, src)
+}
+func (m *DeregisterPassengerMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterPassengerMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterPassengerMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterPassengerMsg proto.InternalMessageInfo
+
+func (m *DeregisterPassengerMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DeregisterPassengerMsg) GetPassengerKey() []byte {
+	if m != nil {
+		return m.PassengerKey
+	}
+	return nil
+}
+
+// CreateStationMsg opens a new station.
+type CreateStationMsg struct {
+	Metadata     *weave.Metadata                    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Station      string                             `protobuf:"bytes,2,opt,name=station,proto3" json:"station,omitempty"`
+	Escalator    int64                              `protobuf:"varint,3,opt,name=escalator,proto3" json:"escalator,omitempty"`
+	Elevator     int64                              `protobuf:"varint,4,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	IsPeronAda   bool                               `protobuf:"varint,5,opt,name=is_peron_ada,json=isPeronAda,proto3" json:"is_peron_ada,omitempty"`
+	TicketOffice int64                              `protobuf:"varint,6,opt,name=ticket_office,json=ticketOffice,proto3" json:"ticket_office,omitempty"`
+	TollGateEnt  int64                              `protobuf:"varint,7,opt,name=toll_gate_ent,json=tollGateEnt,proto3" json:"toll_gate_ent,omitempty"`
+	TollGateEx   int64                              `protobuf:"varint,8,opt,name=toll_gate_ex,json=tollGateEx,proto3" json:"toll_gate_ex,omitempty"`
+	EntranceExit int64                              `protobuf:"varint,9,opt,name=entrance_exit,json=entranceExit,proto3" json:"entrance_exit,omitempty"`
+	Gates        []github_com_iov_one_weave.Address `protobuf:"bytes,10,rep,name=gates,proto3,casttype=github.com/iov-one/weave.Address" json:"gates,omitempty"`
+}
+
+func (m *CreateStationMsg) Reset()         { *m = CreateStationMsg{} }
+func (m *CreateStationMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateStationMsg) ProtoMessage()    {}
+func (*CreateStationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{54}
+}
+func (m *CreateStationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateStationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateStationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateStationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStationMsg.Merge(m, src)
+}
+func (m *CreateStationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateStationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateStationMsg proto.InternalMessageInfo
+
+func (m *CreateStationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateStationMsg) GetStation() string {
+	if m != nil {
+		return m.Station
+	}
+	return ""
+}
+
+func (m *CreateStationMsg) GetEscalator() int64 {
+	if m != nil {
+		return m.Escalator
+	}
+	return 0
+}
+
+func (m *CreateStationMsg) GetElevator() int64 {
+	if m != nil {
+		return m.Elevator
+	}
+	return 0
+}
+
+func (m *CreateStationMsg) GetIsPeronAda() bool {
+	if m != nil {
+		return m.IsPeronAda
+	}
+	return false
+}
+
+func (m *CreateStationMsg) GetTicketOffice() int64 {
+	if m != nil {
+		return m.TicketOffice
+	}
+	return 0
+}
+
+func (m *CreateStationMsg) GetTollGateEnt() int64 {
+	if m != nil {
+		return m.TollGateEnt
+	}
+	return 0
+}
+
+func (m *CreateStationMsg) GetTollGateEx() int64 {
+	if m != nil {
+		return m.TollGateEx
+	}
+	return 0
+}
+
+func (m *CreateStationMsg) GetEntranceExit() int64 {
+	if m != nil {
+		return m.EntranceExit
+	}
+	return 0
+}
+
+func (m *CreateStationMsg) GetGates() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Gates
+	}
+	return nil
+}
+
+// UpdateStationMsg replaces the details of a station.
+type UpdateStationMsg struct {
+	Metadata     *weave.Metadata                    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StationKey   []byte                             `protobuf:"bytes,2,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	Station      string                             `protobuf:"bytes,3,opt,name=station,proto3" json:"station,omitempty"`
+	Escalator    int64                              `protobuf:"varint,4,opt,name=escalator,proto3" json:"escalator,omitempty"`
+	Elevator     int64                              `protobuf:"varint,5,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	IsPeronAda   bool                               `protobuf:"varint,6,opt,name=is_peron_ada,json=isPeronAda,proto3" json:"is_peron_ada,omitempty"`
+	TicketOffice int64                              `protobuf:"varint,7,opt,name=ticket_office,json=ticketOffice,proto3" json:"ticket_office,omitempty"`
+	TollGateEnt  int64                              `protobuf:"varint,8,opt,name=toll_gate_ent,json=tollGateEnt,proto3" json:"toll_gate_ent,omitempty"`
+	TollGateEx   int64                              `protobuf:"varint,9,opt,name=toll_gate_ex,json=tollGateEx,proto3" json:"toll_gate_ex,omitempty"`
+	EntranceExit int64                              `protobuf:"varint,10,opt,name=entrance_exit,json=entranceExit,proto3" json:"entrance_exit,omitempty"`
+	Gates        []github_com_iov_one_weave.Address `protobuf:"bytes,11,rep,name=gates,proto3,casttype=github.com/iov-one/weave.Address" json:"gates,omitempty"`
+}
+
+func (m *UpdateStationMsg) Reset()         { *m = UpdateStationMsg{} }
+func (m *UpdateStationMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateStationMsg) ProtoMessage()    {}
+func (*UpdateStationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{55}
+}
+func (m *UpdateStationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateStationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateStationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateStationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStationMsg.Merge(m, src)
+}
+func (m *UpdateStationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateStationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStationMsg proto.InternalMessageInfo
+
+func (m *UpdateStationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateStationMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *UpdateStationMsg) GetStation() string {
+	if m != nil {
+		return m.Station
+	}
+	return ""
+}
+
+func (m *UpdateStationMsg) GetEscalator() int64 {
+	if m != nil {
+		return m.Escalator
+	}
+	return 0
+}
+
+func (m *UpdateStationMsg) GetElevator() int64 {
+	if m != nil {
+		return m.Elevator
+	}
+	return 0
+}
+
+func (m *UpdateStationMsg) GetIsPeronAda() bool {
+	if m != nil {
+		return m.IsPeronAda
+	}
+	return false
+}
+
+func (m *UpdateStationMsg) GetTicketOffice() int64 {
+	if m != nil {
+		return m.TicketOffice
+	}
+	return 0
+}
+
+func (m *UpdateStationMsg) GetTollGateEnt() int64 {
+	if m != nil {
+		return m.TollGateEnt
+	}
+	return 0
+}
+
+func (m *UpdateStationMsg) GetTollGateEx() int64 {
+	if m != nil {
+		return m.TollGateEx
+	}
+	return 0
+}
+
+func (m *UpdateStationMsg) GetEntranceExit() int64 {
+	if m != nil {
+		return m.EntranceExit
+	}
+	return 0
+}
+
+func (m *UpdateStationMsg) GetGates() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Gates
+	}
+	return nil
+}
+
+// RetireStationMsg closes a station.
+type RetireStationMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StationKey []byte          `protobuf:"bytes,2,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+}
+
+func (m *RetireStationMsg) Reset()         { *m = RetireStationMsg{} }
+func (m *RetireStationMsg) String() string { return proto.CompactTextString(m) }
+func (*RetireStationMsg) ProtoMessage()    {}
+func (*RetireStationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{56}
+}
+func (m *RetireStationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetireStationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetireStationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetireStationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetireStationMsg.Merge(m, src)
+}
+func (m *RetireStationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetireStationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetireStationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetireStationMsg proto.InternalMessageInfo
+
+func (m *RetireStationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RetireStationMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+// RegisterTrainMsg adds a new train to the fleet.
+type RegisterTrainMsg struct {
+	Metadata  *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Address   github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Model     string                           `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Capacity  uint32                           `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CarCount  uint32                           `protobuf:"varint,5,opt,name=car_count,json=carCount,proto3" json:"car_count,omitempty"`
+	HomeDepot string                           `protobuf:"bytes,6,opt,name=home_depot,json=homeDepot,proto3" json:"home_depot,omitempty"`
+}
+
+func (m *RegisterTrainMsg) Reset()         { *m = RegisterTrainMsg{} }
+func (m *RegisterTrainMsg) String() string { return proto.CompactTextString(m) }
+func (*RegisterTrainMsg) ProtoMessage()    {}
+func (*RegisterTrainMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{57}
+}
+func (m *RegisterTrainMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterTrainMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterTrainMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterTrainMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterTrainMsg.Merge(m, src)
+}
+func (m *RegisterTrainMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterTrainMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterTrainMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterTrainMsg proto.InternalMessageInfo
+
+func (m *RegisterTrainMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RegisterTrainMsg) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *RegisterTrainMsg) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *RegisterTrainMsg) GetCapacity() uint32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *RegisterTrainMsg) GetCarCount() uint32 {
+	if m != nil {
+		return m.CarCount
+	}
+	return 0
+}
+
+func (m *RegisterTrainMsg) GetHomeDepot() string {
+	if m != nil {
+		return m.HomeDepot
+	}
+	return ""
+}
+
+// ReassignTrainMsg changes the signing address of a train, for example after
+// its on-board unit was replaced.
+type ReassignTrainMsg struct {
+	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TrainKey []byte                           `protobuf:"bytes,2,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+	Address  github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+}
+
+func (m *ReassignTrainMsg) Reset()         { *m = ReassignTrainMsg{} }
+func (m *ReassignTrainMsg) String() string { return proto.CompactTextString(m) }
+func (*ReassignTrainMsg) ProtoMessage()    {}
+func (*ReassignTrainMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{58}
+}
+func (m *ReassignTrainMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReassignTrainMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReassignTrainMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReassignTrainMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignTrainMsg.Merge(m, src)
+}
+func (m *ReassignTrainMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReassignTrainMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignTrainMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignTrainMsg proto.InternalMessageInfo
+
+func (m *ReassignTrainMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReassignTrainMsg) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+func (m *ReassignTrainMsg) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// DecommissionTrainMsg takes a train out of service.
+type DecommissionTrainMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TrainKey []byte          `protobuf:"bytes,2,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+}
+
+func (m *DecommissionTrainMsg) Reset()         { *m = DecommissionTrainMsg{} }
+func (m *DecommissionTrainMsg) String() string { return proto.CompactTextString(m) }
+func (*DecommissionTrainMsg) ProtoMessage()    {}
+func (*DecommissionTrainMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{59}
+}
+func (m *DecommissionTrainMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecommissionTrainMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecommissionTrainMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecommissionTrainMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionTrainMsg.Merge(m, src)
+}
+func (m *DecommissionTrainMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecommissionTrainMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionTrainMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionTrainMsg proto.InternalMessageInfo
+
+func (m *DecommissionTrainMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DecommissionTrainMsg) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+// ReportEquipmentStatusMsg sets the status of a station equipment unit. Units
+// that were not reported before are created.
+type ReportEquipmentStatusMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StationKey []byte          `protobuf:"bytes,2,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	Type       EquipmentType   `protobuf:"varint,3,opt,name=type,proto3,enum=metro.EquipmentType" json:"type,omitempty"`
+	Identifier string          `protobuf:"bytes,4,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Status     EquipmentStatus `protobuf:"varint,5,opt,name=status,proto3,enum=metro.EquipmentStatus" json:"status,omitempty"`
+}
+
+func (m *ReportEquipmentStatusMsg) Reset()         { *m = ReportEquipmentStatusMsg{} }
+func (m *ReportEquipmentStatusMsg) String() string { return proto.CompactTextString(m) }
+func (*ReportEquipmentStatusMsg) ProtoMessage()    {}
+func (*ReportEquipmentStatusMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{60}
+}
+func (m *ReportEquipmentStatusMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportEquipmentStatusMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportEquipmentStatusMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportEquipmentStatusMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportEquipmentStatusMsg.Merge(m, src)
+}
+func (m *ReportEquipmentStatusMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportEquipmentStatusMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportEquipmentStatusMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportEquipmentStatusMsg proto.InternalMessageInfo
+
+func (m *ReportEquipmentStatusMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReportEquipmentStatusMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *ReportEquipmentStatusMsg) GetType() EquipmentType {
+	if m != nil {
+		return m.Type
+	}
+	return EquipmentInvalid
+}
+
+func (m *ReportEquipmentStatusMsg) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *ReportEquipmentStatusMsg) GetStatus() EquipmentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusInvalid
+}
+
+// SetConnectionMsg creates or changes the connection between two stations.
+// Zero travel time removes the connection.
+type SetConnectionMsg struct {
+	Metadata       *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	FromStationKey []byte          `protobuf:"bytes,2,opt,name=from_station_key,json=fromStationKey,proto3" json:"from_station_key,omitempty"`
+	ToStationKey   []byte          `protobuf:"bytes,3,opt,name=to_station_key,json=toStationKey,proto3" json:"to_station_key,omitempty"`
+	TravelMinutes  uint32          `protobuf:"varint,4,opt,name=travel_minutes,json=travelMinutes,proto3" json:"travel_minutes,omitempty"`
+}
+
+func (m *SetConnectionMsg) Reset()         { *m = SetConnectionMsg{} }
+func (m *SetConnectionMsg) String() string { return proto.CompactTextString(m) }
+func (*SetConnectionMsg) ProtoMessage()    {}
+func (*SetConnectionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{61}
+}
+func (m *SetConnectionMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetConnectionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetConnectionMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetConnectionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetConnectionMsg.Merge(m, src)
+}
+func (m *SetConnectionMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetConnectionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetConnectionMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetConnectionMsg proto.InternalMessageInfo
+
+func (m *SetConnectionMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SetConnectionMsg) GetFromStationKey() []byte {
+	if m != nil {
+		return m.FromStationKey
+	}
+	return nil
+}
+
+func (m *SetConnectionMsg) GetToStationKey() []byte {
+	if m != nil {
+		return m.ToStationKey
+	}
+	return nil
+}
+
+func (m *SetConnectionMsg) GetTravelMinutes() uint32 {
+	if m != nil {
+		return m.TravelMinutes
+	}
+	return 0
+}
+
+// PublishTimetableMsg publishes a timetable for a line, direction and service
+// pattern that has no active timetable yet.
+type PublishTimetableMsg struct {
+	Metadata  *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LineKey   []byte          `protobuf:"bytes,2,opt,name=line_key,json=lineKey,proto3" json:"line_key,omitempty"`
+	Service   ServicePattern  `protobuf:"varint,3,opt,name=service,proto3,enum=metro.ServicePattern" json:"service,omitempty"`
+	Direction Direction       `protobuf:"varint,4,opt,name=direction,proto3,enum=metro.Direction" json:"direction,omitempty"`
+	Stops     []TimetableStop `protobuf:"bytes,5,rep,name=stops,proto3" json:"stops"`
+}
+
+func (m *PublishTimetableMsg) Reset()         { *m = PublishTimetableMsg{} }
+func (m *PublishTimetableMsg) String() string { return proto.CompactTextString(m) }
+func (*PublishTimetableMsg) ProtoMessage()    {}
+func (*PublishTimetableMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{62}
+}
+func (m *PublishTimetableMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishTimetableMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishTimetableMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PublishTimetableMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishTimetableMsg.Merge(m, src)
+}
+func (m *PublishTimetableMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishTimetableMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishTimetableMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishTimetableMsg proto.InternalMessageInfo
+
+func (m *PublishTimetableMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PublishTimetableMsg) GetLineKey() []byte {
+	if m != nil {
+		return m.LineKey
+	}
+	return nil
+}
+
+func (m *PublishTimetableMsg) GetService() ServicePattern {
+	if m != nil {
+		return m.Service
+	}
+	return ServiceInvalid
+}
+
+func (m *PublishTimetableMsg) GetDirection() Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return DirectionInvalid
+}
+
+func (m *PublishTimetableMsg) GetStops() []TimetableStop {
+	if m != nil {
+		return m.Stops
+	}
+	return nil
+}
+
+// SupersedeTimetableMsg replaces the schedule of an active timetable. The old
+// timetable is kept and points to the new one.
+type SupersedeTimetableMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TimetableKey []byte          `protobuf:"bytes,2,opt,name=timetable_key,json=timetableKey,proto3" json:"timetable_key,omitempty"`
+	Stops        []TimetableStop `protobuf:"bytes,3,rep,name=stops,proto3" json:"stops"`
+}
+
+func (m *SupersedeTimetableMsg) Reset()         { *m = SupersedeTimetableMsg{} }
+func (m *SupersedeTimetableMsg) String() string { return proto.CompactTextString(m) }
+func (*SupersedeTimetableMsg) ProtoMessage()    {}
+func (*SupersedeTimetableMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{63}
+}
+func (m *SupersedeTimetableMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupersedeTimetableMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupersedeTimetableMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupersedeTimetableMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupersedeTimetableMsg.Merge(m, src)
+}
+func (m *SupersedeTimetableMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupersedeTimetableMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupersedeTimetableMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupersedeTimetableMsg proto.InternalMessageInfo
+
+func (m *SupersedeTimetableMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SupersedeTimetableMsg) GetTimetableKey() []byte {
+	if m != nil {
+		return m.TimetableKey
+	}
+	return nil
+}
+
+func (m *SupersedeTimetableMsg) GetStops() []TimetableStop {
+	if m != nil {
+		return m.Stops
+	}
+	return nil
+}
+
+// ScheduleStopMsg plans a call of a train at a station.
+type ScheduleStopMsg struct {
+	Metadata   *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TrainKey   []byte                            `protobuf:"bytes,2,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+	StationKey []byte                            `protobuf:"bytes,3,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	LineKey    []byte                            `protobuf:"bytes,4,opt,name=line_key,json=lineKey,proto3" json:"line_key,omitempty"`
+	PlannedAt  github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=planned_at,json=plannedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"planned_at,omitempty"`
+}
+
+func (m *ScheduleStopMsg) Reset()         { *m = ScheduleStopMsg{} }
+func (m *ScheduleStopMsg) String() string { return proto.CompactTextString(m) }
+func (*ScheduleStopMsg) ProtoMessage()    {}
+func (*ScheduleStopMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{64}
+}
+func (m *ScheduleStopMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleStopMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleStopMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleStopMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleStopMsg.Merge(m, src)
+}
+func (m *ScheduleStopMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleStopMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleStopMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleStopMsg proto.InternalMessageInfo
+
+func (m *ScheduleStopMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ScheduleStopMsg) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+func (m *ScheduleStopMsg) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *ScheduleStopMsg) GetLineKey() []byte {
+	if m != nil {
+		return m.LineKey
+	}
+	return nil
+}
+
+func (m *ScheduleStopMsg) GetPlannedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.PlannedAt
+	}
+	return 0
+}
+
+// OpenIncidentMsg announces a service incident.
+type OpenIncidentMsg struct {
+	Metadata      *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Severity      IncidentSeverity                  `protobuf:"varint,2,opt,name=severity,proto3,enum=metro.IncidentSeverity" json:"severity,omitempty"`
+	StationKeys   [][]byte                          `protobuf:"bytes,3,rep,name=station_keys,json=stationKeys,proto3" json:"station_keys,omitempty"`
+	StartedAt     github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"started_at,omitempty"`
+	ExpectedEndAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=expected_end_at,json=expectedEndAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"expected_end_at,omitempty"`
+	Messages      []LocalizedText                   `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages"`
+}
+
+func (m *OpenIncidentMsg) Reset()         { *m = OpenIncidentMsg{} }
+func (m *OpenIncidentMsg) String() string { return proto.CompactTextString(m) }
+func (*OpenIncidentMsg) ProtoMessage()    {}
+func (*OpenIncidentMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{65}
+}
+func (m *OpenIncidentMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenIncidentMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenIncidentMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpenIncidentMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenIncidentMsg.Merge(m, src)
+}
+func (m *OpenIncidentMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenIncidentMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenIncidentMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenIncidentMsg proto.InternalMessageInfo
+
+func (m *OpenIncidentMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *OpenIncidentMsg) GetSeverity() IncidentSeverity {
+	if m != nil {
+		return m.Severity
+	}
+	return SeverityInvalid
+}
+
+func (m *OpenIncidentMsg) GetStationKeys() [][]byte {
+	if m != nil {
+		return m.StationKeys
+	}
+	return nil
+}
+
+func (m *OpenIncidentMsg) GetStartedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *OpenIncidentMsg) GetExpectedEndAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExpectedEndAt
+	}
+	return 0
+}
+
+func (m *OpenIncidentMsg) GetMessages() []LocalizedText {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// UpdateIncidentMsg replaces the details of an incident that is not resolved
+// yet.
+type UpdateIncidentMsg struct {
+	Metadata      *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	IncidentKey   []byte                            `protobuf:"bytes,2,opt,name=incident_key,json=incidentKey,proto3" json:"incident_key,omitempty"`
+	Severity      IncidentSeverity                  `protobuf:"varint,3,opt,name=severity,proto3,enum=metro.IncidentSeverity" json:"severity,omitempty"`
+	StationKeys   [][]byte                          `protobuf:"bytes,4,rep,name=station_keys,json=stationKeys,proto3" json:"station_keys,omitempty"`
+	ExpectedEndAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=expected_end_at,json=expectedEndAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"expected_end_at,omitempty"`
+	Messages      []LocalizedText                   `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages"`
+}
+
+func (m *UpdateIncidentMsg) Reset()         { *m = UpdateIncidentMsg{} }
+func (m *UpdateIncidentMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateIncidentMsg) ProtoMessage()    {}
+func (*UpdateIncidentMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{66}
+}
+func (m *UpdateIncidentMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateIncidentMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateIncidentMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateIncidentMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateIncidentMsg.Merge(m, src)
+}
+func (m *UpdateIncidentMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateIncidentMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateIncidentMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateIncidentMsg proto.InternalMessageInfo
+
+func (m *UpdateIncidentMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateIncidentMsg) GetIncidentKey() []byte {
+	if m != nil {
+		return m.IncidentKey
+	}
+	return nil
+}
+
+func (m *UpdateIncidentMsg) GetSeverity() IncidentSeverity {
+	if m != nil {
+		return m.Severity
+	}
+	return SeverityInvalid
+}
+
+func (m *UpdateIncidentMsg) GetStationKeys() [][]byte {
+	if m != nil {
+		return m.StationKeys
+	}
+	return nil
+}
+
+func (m *UpdateIncidentMsg) GetExpectedEndAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExpectedEndAt
+	}
+	return 0
+}
+
+func (m *UpdateIncidentMsg) GetMessages() []LocalizedText {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// ResolveIncidentMsg announces the service is back to normal.
+type ResolveIncidentMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	IncidentKey []byte          `protobuf:"bytes,2,opt,name=incident_key,json=incidentKey,proto3" json:"incident_key,omitempty"`
+}
+
+func (m *ResolveIncidentMsg) Reset()         { *m = ResolveIncidentMsg{} }
+func (m *ResolveIncidentMsg) String() string { return proto.CompactTextString(m) }
+func (*ResolveIncidentMsg) ProtoMessage()    {}
+func (*ResolveIncidentMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{67}
+}
+func (m *ResolveIncidentMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveIncidentMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveIncidentMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolveIncidentMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveIncidentMsg.Merge(m, src)
+}
+func (m *ResolveIncidentMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveIncidentMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveIncidentMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveIncidentMsg proto.InternalMessageInfo
+
+func (m *ResolveIncidentMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ResolveIncidentMsg) GetIncidentKey() []byte {
+	if m != nil {
+		return m.IncidentKey
+	}
+	return nil
+}
+
+// MarkTrainSilentMsg is executed by the cron watchdog when a train did not
+// report an arrival in time. It cannot be submitted in a transaction.
+type MarkTrainSilentMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TrainKey []byte          `protobuf:"bytes,2,opt,name=train_key,json=trainKey,proto3" json:"train_key,omitempty"`
+}
+
+func (m *MarkTrainSilentMsg) Reset()         { *m = MarkTrainSilentMsg{} }
+func (m *MarkTrainSilentMsg) String() string { return proto.CompactTextString(m) }
+func (*MarkTrainSilentMsg) ProtoMessage()    {}
+func (*MarkTrainSilentMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{68}
+}
+func (m *MarkTrainSilentMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkTrainSilentMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkTrainSilentMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkTrainSilentMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkTrainSilentMsg.Merge(m, src)
+}
+func (m *MarkTrainSilentMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkTrainSilentMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkTrainSilentMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkTrainSilentMsg proto.InternalMessageInfo
+
+func (m *MarkTrainSilentMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *MarkTrainSilentMsg) GetTrainKey() []byte {
+	if m != nil {
+		return m.TrainKey
+	}
+	return nil
+}
+
+// ActiveIncidentsRequest is the data of the /incidents/active query.
+type ActiveIncidentsRequest struct {
+	StationKey []byte                            `protobuf:"bytes,1,opt,name=station_key,json=stationKey,proto3" json:"station_key,omitempty"`
+	At         github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=at,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"at,omitempty"`
+}
+
+func (m *ActiveIncidentsRequest) Reset()         { *m = ActiveIncidentsRequest{} }
+func (m *ActiveIncidentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ActiveIncidentsRequest) ProtoMessage()    {}
+func (*ActiveIncidentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_966ccfa1a9e1c00b, []int{69}
+}
+func (m *ActiveIncidentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveIncidentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveIncidentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveIncidentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveIncidentsRequest.Merge(m, src)
+}
+func (m *ActiveIncidentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveIncidentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveIncidentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveIncidentsRequest proto.InternalMessageInfo
+
+func (m *ActiveIncidentsRequest) GetStationKey() []byte {
+	if m != nil {
+		return m.StationKey
+	}
+	return nil
+}
+
+func (m *ActiveIncidentsRequest) GetAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.At
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("metro.TrainChangeKind", TrainChangeKind_name, TrainChangeKind_value)
+	proto.RegisterEnum("metro.EquipmentType", EquipmentType_name, EquipmentType_value)
+	proto.RegisterEnum("metro.EquipmentStatus", EquipmentStatus_name, EquipmentStatus_value)
+	proto.RegisterEnum("metro.ServicePattern", ServicePattern_name, ServicePattern_value)
+	proto.RegisterEnum("metro.Direction", Direction_name, Direction_value)
+	proto.RegisterEnum("metro.PassengerCategory", PassengerCategory_name, PassengerCategory_value)
+	proto.RegisterEnum("metro.JourneyStatus", JourneyStatus_name, JourneyStatus_value)
+	proto.RegisterEnum("metro.PassKind", PassKind_name, PassKind_value)
+	proto.RegisterEnum("metro.IncidentSeverity", IncidentSeverity_name, IncidentSeverity_value)
+	proto.RegisterType((*Station)(nil), "metro.Station")
+	proto.RegisterType((*Train)(nil), "metro.Train")
+	proto.RegisterType((*TrainChange)(nil), "metro.TrainChange")
+	proto.RegisterType((*Passenger)(nil), "metro.Passenger")
+	proto.RegisterType((*Line)(nil), "metro.Line")
+	proto.RegisterType((*Configuration)(nil), "metro.Configuration")
+	proto.RegisterType((*Equipment)(nil), "metro.Equipment")
+	proto.RegisterType((*Connection)(nil), "metro.Connection")
+	proto.RegisterType((*RouteRequest)(nil), "metro.RouteRequest")
+	proto.RegisterType((*Route)(nil), "metro.Route")
+	proto.RegisterType((*RouteHop)(nil), "metro.RouteHop")
+	proto.RegisterType((*Timetable)(nil), "metro.Timetable")
+	proto.RegisterType((*TimetableStop)(nil), "metro.TimetableStop")
+	proto.RegisterType((*DeparturesRequest)(nil), "metro.DeparturesRequest")
+	proto.RegisterType((*Departures)(nil), "metro.Departures")
+	proto.RegisterType((*Departure)(nil), "metro.Departure")
+	proto.RegisterType((*FareTable)(nil), "metro.FareTable")
+	proto.RegisterType((*FareChange)(nil), "metro.FareChange")
+	proto.RegisterType((*StationZone)(nil), "metro.StationZone")
+	proto.RegisterType((*TimeMultiplier)(nil), "metro.TimeMultiplier")
+	proto.RegisterType((*Discount)(nil), "metro.Discount")
+	proto.RegisterType((*FareQuoteRequest)(nil), "metro.FareQuoteRequest")
+	proto.RegisterType((*ValidPassRequest)(nil), "metro.ValidPassRequest")
+	proto.RegisterType((*FareQuote)(nil), "metro.FareQuote")
+	proto.RegisterType((*Trip)(nil), "metro.Trip")
+	proto.RegisterType((*Journey)(nil), "metro.Journey")
+	proto.RegisterType((*Pass)(nil), "metro.Pass")
+	proto.RegisterType((*ScheduledStop)(nil), "metro.ScheduledStop")
+	proto.RegisterType((*LineDelay)(nil), "metro.LineDelay")
+	proto.RegisterType((*TrainPosition)(nil), "metro.TrainPosition")
+	proto.RegisterType((*Incident)(nil), "metro.Incident")
+	proto.RegisterType((*LocalizedText)(nil), "metro.LocalizedText")
+	proto.RegisterType((*TrainArriveStationEvent)(nil), "metro.TrainArriveStationEvent")
+	proto.RegisterType((*TrainDepartStationEvent)(nil), "metro.TrainDepartStationEvent")
+	proto.RegisterType((*RegisterPassengerMsg)(nil), "metro.RegisterPassengerMsg")
+	proto.RegisterType((*TrainArriveStationEventMsg)(nil), "metro.TrainArriveStationEventMsg")
+	proto.RegisterType((*TrainDepartStationEventMsg)(nil), "metro.TrainDepartStationEventMsg")
+	proto.RegisterType((*CreateLineMsg)(nil), "metro.CreateLineMsg")
+	proto.RegisterType((*UpdateLineMsg)(nil), "metro.UpdateLineMsg")
+	proto.RegisterType((*TapInMsg)(nil), "metro.TapInMsg")
+	proto.RegisterType((*TapOutMsg)(nil), "metro.TapOutMsg")
+	proto.RegisterType((*StartJourneyMsg)(nil), "metro.StartJourneyMsg")
+	proto.RegisterType((*EndJourneyMsg)(nil), "metro.EndJourneyMsg")
+	proto.RegisterType((*ExpireJourneyMsg)(nil), "metro.ExpireJourneyMsg")
+	proto.RegisterType((*PurchasePassMsg)(nil), "metro.PurchasePassMsg")
+	proto.RegisterType((*CancelPassMsg)(nil), "metro.CancelPassMsg")
+	proto.RegisterType((*ReleasePassMsg)(nil), "metro.ReleasePassMsg")
+	proto.RegisterType((*UpdateFareTableMsg)(nil), "metro.UpdateFareTableMsg")
+	proto.RegisterType((*ScheduleFareChangeMsg)(nil), "metro.ScheduleFareChangeMsg")
+	proto.RegisterType((*ActivateFareChangeMsg)(nil), "metro.ActivateFareChangeMsg")
+	proto.RegisterType((*SetPassengerCategoryMsg)(nil), "metro.SetPassengerCategoryMsg")
+	proto.RegisterType((*PayFareMsg)(nil), "metro.PayFareMsg")
+	proto.RegisterType((*UpdatePassengerMsg)(nil), "metro.UpdatePassengerMsg")
+	proto.RegisterType((*DeregisterPassengerMsg)(nil), "metro.DeregisterPassengerMsg")
+	proto.RegisterType((*CreateStationMsg)(nil), "metro.CreateStationMsg")
+	proto.RegisterType((*UpdateStationMsg)(nil), "metro.UpdateStationMsg")
+	proto.RegisterType((*RetireStationMsg)(nil), "metro.RetireStationMsg")
+	proto.RegisterType((*RegisterTrainMsg)(nil), "metro.RegisterTrainMsg")
+	proto.RegisterType((*ReassignTrainMsg)(nil), "metro.ReassignTrainMsg")
+	proto.RegisterType((*DecommissionTrainMsg)(nil), "metro.DecommissionTrainMsg")
+	proto.RegisterType((*ReportEquipmentStatusMsg)(nil), "metro.ReportEquipmentStatusMsg")
+	proto.RegisterType((*SetConnectionMsg)(nil), "metro.SetConnectionMsg")
+	proto.RegisterType((*PublishTimetableMsg)(nil), "metro.PublishTimetableMsg")
+	proto.RegisterType((*SupersedeTimetableMsg)(nil), "metro.SupersedeTimetableMsg")
+	proto.RegisterType((*ScheduleStopMsg)(nil), "metro.ScheduleStopMsg")
+	proto.RegisterType((*OpenIncidentMsg)(nil), "metro.OpenIncidentMsg")
+	proto.RegisterType((*UpdateIncidentMsg)(nil), "metro.UpdateIncidentMsg")
+	proto.RegisterType((*ResolveIncidentMsg)(nil), "metro.ResolveIncidentMsg")
+	proto.RegisterType((*MarkTrainSilentMsg)(nil), "metro.MarkTrainSilentMsg")
+	proto.RegisterType((*ActiveIncidentsRequest)(nil), "metro.ActiveIncidentsRequest")
+}
+
+func init() { proto.RegisterFile("x/metro/codec.proto", fileDescriptor_966ccfa1a9e1c00b) }
+
+var fileDescriptor_966ccfa1a9e1c00b = []byte{
+	// 4493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x23, 0x49,
+	0x5e, 0x9f, 0xf6, 0x47, 0x62, 0xff, 0x6d, 0x27, 0x4e, 0x4d, 0x66, 0xc6, 0x9b, 0xd9, 0x4b, 0x7c,
+	0xbd, 0x1f, 0x9a, 0xdb, 0x8f, 0x64, 0x2f, 0x7b, 0xbb, 0x77, 0x5a, 0x56, 0x1c, 0x1d, 0xbb, 0x67,
+	0xc6, 0x3b, 0x89, 0x9d, 0x6b, 0x3b, 0x33, 0x3b, 0x27, 0x81, 0xaf, 0xc6, 0x5d, 0x93, 0xf4, 0xa5,
+	0xdd, 0xed, 0xeb, 0x2e, 0x67, 0x92, 0x13, 0x0f, 0xf0, 0x82, 0x96, 0x3c, 0x01, 0x4f, 0xb0, 0x28,
+	0x02, 0x89, 0xe3, 0x24, 0x90, 0x78, 0x00, 0x9e, 0x79, 0xe1, 0x01, 0xed, 0x03, 0x42, 0x87, 0x10,
+	0x12, 0x0f, 0xa7, 0xe8, 0x94, 0x3d, 0x09, 0x09, 0x09, 0x81, 0x00, 0x21, 0xdd, 0x49, 0x20, 0x54,
+	0x1f, 0xdd, 0x6e, 0x3b, 0x9f, 0xed, 0xc9, 0xcc, 0x0d, 0x88, 0x37, 0x57, 0xf5, 0xff, 0x57, 0xf5,
+	0xaf, 0xfa, 0x7f, 0xd4, 0xbf, 0xfe, 0x55, 0x65, 0xb8, 0xba, 0xbb, 0xd4, 0x25, 0xd4, 0x73, 0x97,
+	0x3a, 0xae, 0x49, 0x3a, 0x8b, 0x3d, 0xcf, 0xa5, 0x2e, 0x4a, 0xf3, 0xaa, 0xb9, 0x5c, 0xa4, 0x6e,
+	0xae, 0xd8, 0x71, 0x2d, 0x27, 0x4a, 0x35, 0x37, 0xbb, 0xe9, 0x6e, 0xba, 0xfc, 0xe7, 0x12, 0xfb,
+	0x25, 0x6a, 0xd5, 0xc3, 0x24, 0x4c, 0x36, 0x29, 0xa6, 0x96, 0xeb, 0xa0, 0x37, 0x21, 0xd3, 0x25,
+	0x14, 0x9b, 0x98, 0xe2, 0x92, 0x52, 0x56, 0x6e, 0xe5, 0x96, 0xa7, 0x17, 0x9f, 0x10, 0xbc, 0x43,
+	0x16, 0xd7, 0x64, 0xb5, 0x11, 0x12, 0xa0, 0x79, 0x48, 0xf4, 0xb6, 0x4b, 0x89, 0xb2, 0x72, 0x2b,
+	0xbf, 0x32, 0x75, 0x74, 0xb8, 0x00, 0xeb, 0x9e, 0xd5, 0xc5, 0xde, 0xde, 0x3d, 0xb2, 0x67, 0x24,
+	0x7a, 0xdb, 0xa8, 0x04, 0x93, 0xbe, 0x68, 0xb7, 0x94, 0x2c, 0x2b, 0xb7, 0xb2, 0x46, 0x50, 0x44,
+	0x2f, 0x43, 0x96, 0xf8, 0x1d, 0x6c, 0x63, 0xea, 0x7a, 0xa5, 0x54, 0x59, 0xb9, 0x95, 0x34, 0x06,
+	0x15, 0x68, 0x0e, 0x32, 0xc4, 0x26, 0x3b, 0xfc, 0x63, 0x9a, 0x7f, 0x0c, 0xcb, 0xa8, 0x0c, 0x79,
+	0xcb, 0x6f, 0xf7, 0x88, 0xe7, 0x3a, 0x6d, 0x6c, 0xe2, 0xd2, 0x44, 0x59, 0xb9, 0x95, 0x31, 0xc0,
+	0xf2, 0xd7, 0x59, 0x95, 0x66, 0x62, 0xf4, 0x0a, 0x14, 0xa8, 0xd5, 0xd9, 0x26, 0xb4, 0xed, 0x3e,
+	0x7e, 0x6c, 0x75, 0x48, 0x69, 0x92, 0x37, 0x91, 0x17, 0x95, 0x0d, 0x5e, 0x87, 0x54, 0x28, 0x50,
+	0xd7, 0xb6, 0xdb, 0x9b, 0x98, 0x92, 0x36, 0x71, 0x68, 0x29, 0xc3, 0x89, 0x72, 0xac, 0xf2, 0x0e,
+	0xa6, 0x44, 0x77, 0x28, 0xeb, 0x2a, 0x42, 0xb3, 0x5b, 0xca, 0x72, 0x12, 0x08, 0x49, 0x76, 0x59,
+	0x57, 0xc4, 0xa1, 0x1e, 0x76, 0x3a, 0x8c, 0xc0, 0xa2, 0x25, 0x10, 0x5d, 0x05, 0x95, 0xfa, 0xae,
+	0x45, 0xd1, 0x07, 0x90, 0x66, 0x2d, 0xf8, 0xa5, 0x5c, 0x39, 0x79, 0x2b, 0xbf, 0xf2, 0xea, 0x4f,
+	0x0f, 0x17, 0xca, 0x9b, 0x16, 0xdd, 0xea, 0x3f, 0x5a, 0xec, 0xb8, 0xdd, 0x25, 0xcb, 0xdd, 0x79,
+	0xdb, 0x75, 0xc8, 0x92, 0x98, 0x65, 0xcd, 0x34, 0x3d, 0xe2, 0xfb, 0x86, 0x80, 0xa0, 0x2a, 0x80,
+	0x47, 0xa8, 0xe5, 0x11, 0xb3, 0x8d, 0x69, 0x29, 0xcf, 0x5a, 0x5f, 0x79, 0xed, 0xa7, 0x87, 0x0b,
+	0x5f, 0x3c, 0xb5, 0x81, 0x0d, 0xc7, 0xda, 0x6d, 0x59, 0x5d, 0x62, 0x64, 0x25, 0x50, 0xa3, 0x4c,
+	0xc0, 0xe9, 0x96, 0x87, 0xad, 0x4b, 0x16, 0xef, 0xcf, 0xc3, 0x24, 0x16, 0xec, 0x72, 0xf1, 0x5e,
+	0x74, 0x68, 0x01, 0x08, 0xcd, 0x42, 0xba, 0xeb, 0x9a, 0xc4, 0xe6, 0x0a, 0x90, 0x35, 0x44, 0x81,
+	0x09, 0xbf, 0x83, 0x7b, 0xb8, 0x63, 0xd1, 0x3d, 0x2e, 0xfc, 0x82, 0x11, 0x96, 0xd1, 0x4d, 0xc8,
+	0x76, 0xb0, 0xd7, 0xee, 0xb8, 0x7d, 0x87, 0x96, 0x26, 0x82, 0x8f, 0x5e, 0x85, 0x95, 0xd1, 0x17,
+	0x00, 0xb6, 0xdc, 0x2e, 0x69, 0x9b, 0xa4, 0xe7, 0x52, 0x2e, 0xf4, 0xac, 0x91, 0x65, 0x35, 0x55,
+	0x56, 0x81, 0x0c, 0x98, 0x31, 0x49, 0xc7, 0xed, 0x76, 0x2d, 0xdf, 0xb7, 0x5c, 0x47, 0xcc, 0x68,
+	0x26, 0xce, 0x8c, 0x16, 0x87, 0xf1, 0x1a, 0x45, 0x77, 0x21, 0xef, 0x5b, 0x36, 0x71, 0x68, 0xdb,
+	0xb7, 0x9c, 0x0e, 0x29, 0x65, 0xe3, 0x34, 0x97, 0x13, 0xd0, 0x26, 0x43, 0xa2, 0x0f, 0xa1, 0xf8,
+	0x04, 0xd3, 0xce, 0x96, 0xe9, 0x6e, 0xb6, 0x29, 0xf6, 0xb7, 0xdb, 0x96, 0xc9, 0x95, 0x29, 0xbf,
+	0x82, 0x8e, 0x0e, 0x17, 0xa6, 0x1e, 0xc8, 0x6f, 0x2d, 0xec, 0x6f, 0xd7, 0xaa, 0xc6, 0xd4, 0x93,
+	0x68, 0xd9, 0x54, 0x3f, 0x49, 0x42, 0x8e, 0x0b, 0xb8, 0xb2, 0x85, 0x9d, 0x4d, 0x72, 0xb9, 0x62,
+	0xfe, 0x12, 0x64, 0x29, 0x6b, 0xbb, 0xbd, 0x4d, 0xf6, 0xa4, 0xa0, 0xf3, 0x47, 0x87, 0x0b, 0x19,
+	0xde, 0x21, 0x23, 0xca, 0x50, 0xf9, 0x0b, 0xbd, 0x01, 0xa9, 0x6d, 0xcb, 0x31, 0xb9, 0x40, 0xa7,
+	0x96, 0xaf, 0x2f, 0x72, 0xa7, 0xb4, 0x18, 0xe1, 0xec, 0x9e, 0xe5, 0x98, 0x06, 0xa7, 0x89, 0x6a,
+	0x4f, 0x7a, 0x1c, 0xed, 0x69, 0x40, 0xb1, 0xe7, 0x91, 0x1d, 0xcb, 0xed, 0xfb, 0xed, 0xa0, 0xa1,
+	0x89, 0x18, 0x0d, 0x4d, 0x07, 0x68, 0x59, 0xc1, 0x6c, 0xad, 0xc3, 0x99, 0xe4, 0x9a, 0x31, 0x19,
+	0xcb, 0xd6, 0x24, 0x50, 0xa3, 0xea, 0xdf, 0x27, 0x21, 0xbb, 0x8e, 0x7d, 0x9f, 0x38, 0x9b, 0xc4,
+	0x7b, 0xb1, 0xec, 0xed, 0x23, 0x28, 0x78, 0x64, 0xd3, 0xf2, 0x29, 0x91, 0xfe, 0x24, 0x15, 0x67,
+	0x8c, 0xf9, 0x01, 0x56, 0xa3, 0x08, 0x41, 0xca, 0xc1, 0x5d, 0xc2, 0x45, 0x97, 0x35, 0xf8, 0x6f,
+	0xf4, 0x15, 0x66, 0xb9, 0x94, 0x6c, 0xba, 0xde, 0x1e, 0x97, 0xc4, 0xd4, 0x72, 0x49, 0x6a, 0x40,
+	0x38, 0x21, 0x15, 0xf9, 0xdd, 0x08, 0x29, 0xd1, 0x06, 0x5c, 0x0d, 0x7e, 0xb7, 0xc9, 0x6e, 0xcf,
+	0xf2, 0x88, 0x1f, 0x7b, 0xfe, 0x67, 0x82, 0x16, 0x74, 0xd1, 0x80, 0x46, 0x51, 0x1d, 0xa6, 0x4d,
+	0x32, 0x3c, 0xdc, 0x58, 0xc6, 0x3e, 0x15, 0x45, 0x6b, 0x54, 0xfd, 0x53, 0x05, 0x52, 0xab, 0x96,
+	0x73, 0xc9, 0xb6, 0x15, 0x4c, 0x63, 0x32, 0x32, 0x8d, 0xb3, 0x90, 0xee, 0xb8, 0xb6, 0x5c, 0x17,
+	0xb3, 0x86, 0x28, 0xa0, 0x65, 0xc8, 0xcb, 0xc5, 0x93, 0xd9, 0x21, 0xb3, 0x19, 0xb6, 0x98, 0x4c,
+	0x1f, 0x1d, 0x2e, 0xe4, 0xe4, 0xda, 0x7d, 0x8f, 0xec, 0xf9, 0x46, 0xce, 0x1f, 0x14, 0xd4, 0xbf,
+	0x9a, 0x80, 0x42, 0xc5, 0x75, 0x1e, 0x5b, 0x9b, 0x7d, 0x6f, 0x8c, 0xe5, 0xfd, 0x03, 0x48, 0xbb,
+	0x4f, 0x1c, 0xe2, 0x95, 0x12, 0x31, 0xb4, 0x4d, 0x40, 0x18, 0x16, 0x9b, 0x5d, 0xcb, 0x89, 0xa5,
+	0xa9, 0x02, 0x82, 0xee, 0xc1, 0xd4, 0x63, 0xec, 0x91, 0x76, 0xc7, 0xb5, 0x6d, 0xd2, 0x09, 0x22,
+	0x84, 0x8b, 0x36, 0x52, 0x60, 0xd8, 0x4a, 0x00, 0x45, 0x15, 0x00, 0xde, 0x98, 0xe0, 0x26, 0x8e,
+	0xa7, 0xc9, 0x32, 0x9c, 0xc6, 0x39, 0x5a, 0x83, 0xe9, 0x50, 0x47, 0x2d, 0xdf, 0xef, 0x13, 0x2f,
+	0x96, 0xab, 0x99, 0x0a, 0xc0, 0x35, 0x8e, 0x45, 0x35, 0x28, 0x3c, 0xb6, 0x09, 0xa1, 0xed, 0x2e,
+	0x76, 0xf0, 0x26, 0xf1, 0x4a, 0x93, 0x31, 0x1a, 0xcb, 0x73, 0xe8, 0x9a, 0x40, 0xa2, 0xdb, 0x90,
+	0xeb, 0x62, 0xcb, 0xa1, 0xd8, 0x72, 0x88, 0xe7, 0x97, 0x32, 0x31, 0x42, 0x8c, 0x28, 0x10, 0xad,
+	0x40, 0xd6, 0xed, 0x11, 0x8f, 0x85, 0x58, 0x7e, 0x29, 0x1b, 0xa3, 0x95, 0x01, 0x0c, 0x2d, 0xc3,
+	0x35, 0xb1, 0x50, 0xf0, 0x85, 0xad, 0x43, 0xda, 0x5d, 0xcb, 0xe9, 0xb3, 0xc0, 0x07, 0xf8, 0x4a,
+	0x7d, 0x95, 0x7f, 0x6c, 0x8a, 0x6f, 0x6b, 0xe2, 0x13, 0x5a, 0x84, 0xab, 0x5d, 0xbc, 0xdb, 0xfe,
+	0xb6, 0xdb, 0xf7, 0x1c, 0xb2, 0x17, 0x22, 0x72, 0x1c, 0x31, 0xd3, 0xc5, 0xbb, 0x1f, 0x89, 0x2f,
+	0x01, 0xfd, 0x6b, 0x90, 0x61, 0xf4, 0x4c, 0x34, 0x3c, 0x1c, 0xca, 0x2d, 0xc3, 0x22, 0x0b, 0x73,
+	0x17, 0x2b, 0xae, 0xe5, 0x18, 0x93, 0x5d, 0xbc, 0x7b, 0x1b, 0x7b, 0x84, 0x0b, 0xac, 0xef, 0x79,
+	0xc4, 0xe9, 0x84, 0x02, 0x2b, 0xc4, 0x12, 0x98, 0x04, 0x0b, 0x81, 0xa9, 0xff, 0x9a, 0x80, 0xac,
+	0xfe, 0x9d, 0xbe, 0xd5, 0xeb, 0x12, 0x87, 0xc6, 0x33, 0xa2, 0x25, 0xc8, 0x45, 0xec, 0x36, 0xea,
+	0x0a, 0x06, 0x66, 0x6b, 0xc0, 0xc0, 0x6a, 0xd1, 0x2d, 0x48, 0xd1, 0xbd, 0x9e, 0x70, 0x09, 0x53,
+	0xcb, 0xb3, 0xd2, 0x83, 0x86, 0xbd, 0xb7, 0xf6, 0x7a, 0xc4, 0xe0, 0x14, 0x68, 0x1e, 0xc0, 0x32,
+	0x89, 0x43, 0xad, 0xc7, 0x16, 0x09, 0xbc, 0x45, 0xa4, 0x06, 0x2d, 0xc2, 0x04, 0x6b, 0xb7, 0x2f,
+	0x16, 0xd8, 0xc1, 0x7a, 0x1c, 0xb6, 0xd5, 0xe4, 0x5f, 0x0d, 0x49, 0xc5, 0x16, 0xc0, 0x7e, 0xcf,
+	0xc4, 0x54, 0x78, 0xcb, 0x89, 0x58, 0x0b, 0xa0, 0x04, 0x6a, 0x14, 0xe9, 0x90, 0xf3, 0x48, 0xcf,
+	0xf5, 0x58, 0x33, 0x8f, 0xf6, 0x62, 0xa9, 0x36, 0x04, 0xc0, 0x95, 0x3d, 0xf5, 0x3f, 0x15, 0x80,
+	0x8a, 0xeb, 0x38, 0xa4, 0x73, 0xf9, 0xfb, 0x92, 0x0f, 0xa1, 0xf8, 0xd8, 0x73, 0xbb, 0xed, 0xa8,
+	0x60, 0x92, 0x83, 0x60, 0xeb, 0xb6, 0xe7, 0x76, 0x23, 0xc2, 0x99, 0x7a, 0x3c, 0x54, 0x46, 0xef,
+	0xc3, 0x14, 0x75, 0x87, 0xb0, 0xc2, 0x3d, 0x15, 0x8f, 0x0e, 0x17, 0xf2, 0x2d, 0x37, 0x82, 0xcc,
+	0xd3, 0x48, 0x09, 0xbd, 0x06, 0x53, 0xd4, 0xc3, 0x3b, 0xc4, 0x0e, 0xb5, 0x5c, 0x84, 0xb7, 0x05,
+	0x51, 0x2b, 0x35, 0x5c, 0xfd, 0x9e, 0x02, 0x79, 0xc3, 0xed, 0x53, 0x62, 0x90, 0xef, 0xf4, 0x89,
+	0x4f, 0x4f, 0xe4, 0x56, 0x79, 0x0a, 0x6e, 0x13, 0x17, 0xe2, 0x76, 0x1e, 0x00, 0x77, 0x3a, 0xc4,
+	0xf7, 0xad, 0x47, 0xb6, 0x50, 0xc6, 0x8c, 0x11, 0xa9, 0x51, 0x1f, 0x40, 0x9a, 0x73, 0x89, 0xbe,
+	0x04, 0xa9, 0x2d, 0xb7, 0xe7, 0x97, 0x94, 0x72, 0x92, 0x4b, 0x45, 0xe8, 0x18, 0xff, 0x76, 0xd7,
+	0xed, 0xad, 0xa4, 0x3e, 0x3b, 0x5c, 0xb8, 0x62, 0x70, 0x12, 0xbe, 0x33, 0x73, 0x29, 0x1e, 0x4c,
+	0x40, 0x82, 0x4f, 0x40, 0x9e, 0x57, 0x06, 0xe3, 0xdf, 0x80, 0x4c, 0x00, 0x1e, 0x35, 0x1e, 0xe5,
+	0x5c, 0xe3, 0x29, 0xc1, 0xe4, 0x70, 0xdb, 0x41, 0x51, 0xfd, 0x9b, 0x24, 0x64, 0x99, 0xaa, 0x52,
+	0xfc, 0xc8, 0xbe, 0xe4, 0x45, 0xfc, 0x75, 0xc8, 0xd8, 0x96, 0x43, 0x22, 0x6a, 0x94, 0x3b, 0x3a,
+	0x5c, 0x98, 0x64, 0xd1, 0x02, 0x23, 0x99, 0xb4, 0xc5, 0x0f, 0xb4, 0x04, 0x93, 0x3e, 0xf1, 0x76,
+	0xd8, 0x96, 0x54, 0x04, 0xc8, 0xd7, 0xe4, 0x64, 0x35, 0x45, 0xed, 0x3a, 0xa6, 0x94, 0x78, 0x8e,
+	0x11, 0x50, 0xa1, 0x45, 0xc8, 0x9a, 0x96, 0x27, 0x2c, 0x40, 0xda, 0x70, 0x51, 0x42, 0xaa, 0x41,
+	0xbd, 0x31, 0x20, 0x41, 0xef, 0x40, 0xda, 0xa7, 0x4c, 0x16, 0x13, 0x5c, 0x16, 0x81, 0xef, 0x08,
+	0x87, 0xdd, 0xa4, 0xa1, 0x40, 0x04, 0x21, 0xdb, 0xc0, 0xf4, 0xfa, 0x8f, 0x6c, 0xcb, 0xdf, 0x1a,
+	0x23, 0xea, 0xcd, 0x85, 0x50, 0x8d, 0x32, 0xd9, 0xfa, 0xfd, 0x1e, 0xf1, 0x7c, 0x62, 0x0a, 0xc3,
+	0x67, 0xd1, 0x56, 0xde, 0xc8, 0x0f, 0x2a, 0x57, 0xf6, 0x58, 0x04, 0x1a, 0x21, 0xc2, 0x34, 0xde,
+	0x86, 0x29, 0xd2, 0x96, 0x46, 0xd5, 0x6f, 0x41, 0x61, 0x68, 0x60, 0xf1, 0x95, 0x65, 0x1e, 0xc0,
+	0x24, 0x3d, 0xec, 0xd1, 0xbe, 0xc7, 0xf5, 0x25, 0x79, 0xab, 0x60, 0x44, 0x6a, 0xd4, 0xdf, 0x54,
+	0x60, 0xa6, 0x1a, 0x16, 0x03, 0x73, 0x8c, 0xdd, 0xcd, 0x7b, 0x90, 0xc0, 0xb4, 0x94, 0x88, 0x33,
+	0xd2, 0x04, 0xa6, 0x2c, 0x0c, 0xb4, 0xad, 0xae, 0x45, 0xb9, 0x4a, 0x15, 0x0c, 0x51, 0x50, 0xab,
+	0x00, 0x03, 0x96, 0xd0, 0xfb, 0x43, 0x23, 0x10, 0x16, 0x18, 0x6a, 0x48, 0xf0, 0x41, 0x4a, 0x3c,
+	0x3a, 0xb2, 0x3f, 0x52, 0x20, 0x1b, 0x7e, 0x1f, 0xd2, 0x5f, 0xe5, 0x0c, 0xfd, 0x1d, 0x52, 0xc7,
+	0xc4, 0xf9, 0xea, 0x58, 0x0d, 0xb8, 0xe3, 0x01, 0x7d, 0x32, 0xd6, 0x7a, 0x22, 0x81, 0x1a, 0xe5,
+	0x86, 0xcb, 0xd6, 0xf4, 0x56, 0x7c, 0xc3, 0x7d, 0x1b, 0xb2, 0x8f, 0xb0, 0x4f, 0x44, 0xb4, 0x90,
+	0x18, 0x8d, 0x16, 0xe4, 0xbc, 0x64, 0x18, 0x09, 0x0f, 0x1a, 0xde, 0x86, 0x2c, 0xb3, 0x0a, 0x41,
+	0x9e, 0x3c, 0x8d, 0x9c, 0x91, 0x04, 0xe4, 0xdf, 0x75, 0x1d, 0xd9, 0x7a, 0xea, 0x34, 0x72, 0x46,
+	0xc2, 0xc9, 0x17, 0x21, 0xcd, 0x7e, 0x8b, 0xc8, 0x3d, 0xb7, 0x8c, 0x02, 0xdb, 0x17, 0x8a, 0xf2,
+	0x4d, 0xd7, 0x09, 0x04, 0x25, 0xc8, 0xd0, 0x6d, 0x28, 0x52, 0xab, 0x4b, 0xda, 0xdd, 0xbe, 0x4d,
+	0xad, 0x9e, 0x6d, 0x11, 0x2f, 0xb0, 0xeb, 0x6b, 0x11, 0xbb, 0x5e, 0x0b, 0xbf, 0x4a, 0xf4, 0x34,
+	0x1d, 0xaa, 0xf5, 0xd1, 0xbb, 0x4c, 0x6a, 0x3e, 0x4f, 0x99, 0xf8, 0xa5, 0xc9, 0x21, 0x27, 0x5d,
+	0x95, 0xf5, 0x12, 0x3a, 0xa0, 0x43, 0x6f, 0x42, 0xee, 0x09, 0x21, 0xdb, 0xf6, 0x5e, 0xbb, 0x87,
+	0x7d, 0xbf, 0x94, 0x19, 0x1d, 0x9d, 0x01, 0xe2, 0x33, 0xdb, 0xd8, 0xa1, 0xb7, 0x21, 0xdf, 0x75,
+	0x1d, 0xba, 0x15, 0x50, 0x67, 0x8f, 0x51, 0xe7, 0xe4, 0x77, 0x46, 0xae, 0xee, 0x27, 0x01, 0xd8,
+	0x8c, 0x3c, 0x8b, 0x5c, 0xc5, 0x92, 0x8c, 0xf6, 0xb9, 0x57, 0x90, 0x32, 0x0c, 0x74, 0x34, 0x54,
+	0x22, 0x11, 0xd9, 0xf3, 0x9f, 0x2c, 0x7e, 0xc6, 0x1d, 0x6a, 0xed, 0x60, 0x4a, 0x62, 0xef, 0x88,
+	0x21, 0x40, 0x6a, 0x14, 0xdd, 0x81, 0xbc, 0xdf, 0xd9, 0x22, 0x66, 0xdf, 0x16, 0xde, 0x2f, 0xce,
+	0x46, 0x23, 0x17, 0x22, 0x57, 0xf6, 0xd0, 0x2b, 0x30, 0x19, 0xe4, 0x7f, 0xc4, 0x16, 0x03, 0x8e,
+	0x0e, 0x17, 0x26, 0x64, 0xde, 0x67, 0x82, 0x7d, 0xaa, 0x99, 0xcc, 0x6d, 0x07, 0x7d, 0x8f, 0xe1,
+	0xb6, 0x43, 0xa8, 0x46, 0x55, 0x03, 0x72, 0x11, 0x0d, 0x8c, 0xef, 0xdc, 0x10, 0xa4, 0x98, 0xba,
+	0xca, 0xd5, 0x96, 0xff, 0x56, 0x6d, 0x98, 0x1a, 0x56, 0x4d, 0xf4, 0x45, 0xbe, 0x79, 0xf5, 0xa8,
+	0x5c, 0xf8, 0x79, 0xbb, 0x05, 0xbe, 0x57, 0xf5, 0xa8, 0x58, 0xf7, 0x59, 0xf6, 0x8e, 0x38, 0x66,
+	0x40, 0x20, 0x9a, 0xcb, 0x12, 0xc7, 0x94, 0x9f, 0x4b, 0x30, 0xd9, 0x23, 0x5e, 0x87, 0x38, 0x81,
+	0x3f, 0x0c, 0x8a, 0xea, 0x37, 0x21, 0x13, 0xe8, 0xf1, 0x50, 0x06, 0x42, 0xb9, 0x70, 0x06, 0x22,
+	0xd2, 0x76, 0x62, 0xb8, 0xed, 0xff, 0x56, 0xa0, 0xc8, 0xd4, 0xe6, 0x1b, 0x7d, 0x77, 0x10, 0x8f,
+	0x7d, 0x1d, 0x66, 0x88, 0x43, 0xbd, 0xbd, 0x13, 0x02, 0xb2, 0xab, 0x47, 0x87, 0x0b, 0xd3, 0x3a,
+	0xfb, 0x18, 0x99, 0xae, 0x69, 0x32, 0x5c, 0xc1, 0x02, 0x3a, 0x96, 0x2c, 0x3e, 0x21, 0x28, 0xe3,
+	0x01, 0x1d, 0x4b, 0x1a, 0x47, 0x03, 0x3a, 0x32, 0x54, 0x1e, 0x1a, 0x63, 0xf2, 0xc2, 0x63, 0x14,
+	0x8b, 0x50, 0x2a, 0xe6, 0x22, 0xa4, 0xfe, 0xba, 0x02, 0xc5, 0xfb, 0xd8, 0xb6, 0x4c, 0xd6, 0x76,
+	0x30, 0x01, 0x91, 0x3c, 0x94, 0x32, 0x4e, 0x1e, 0x6a, 0xbc, 0x05, 0x51, 0xfd, 0x45, 0xc8, 0x86,
+	0xb2, 0x40, 0xaf, 0x42, 0x8a, 0xfb, 0x5d, 0xe5, 0x14, 0xbf, 0xcb, 0xbf, 0xb2, 0x35, 0x54, 0x04,
+	0x44, 0x42, 0xae, 0xa2, 0xc0, 0x6a, 0x85, 0x27, 0x96, 0x2b, 0x2b, 0x2f, 0xa8, 0x7f, 0x9e, 0x84,
+	0x54, 0xcb, 0xb3, 0x7a, 0x97, 0xeb, 0x90, 0xde, 0x83, 0x42, 0x2f, 0x10, 0x4b, 0x24, 0x40, 0xe4,
+	0xd1, 0x77, 0x28, 0x2f, 0x1e, 0x7d, 0xf7, 0x22, 0xa5, 0x93, 0x75, 0x2c, 0x15, 0x43, 0xc7, 0xaa,
+	0xcc, 0x9c, 0xc2, 0xcc, 0x57, 0x3a, 0xd6, 0xda, 0x2b, 0x81, 0x1a, 0x3d, 0x51, 0x53, 0x27, 0x2e,
+	0xac, 0xa9, 0x2b, 0x90, 0x65, 0x35, 0x63, 0xb8, 0xa8, 0x8c, 0xc0, 0x69, 0x14, 0xcd, 0x4b, 0x39,
+	0x1f, 0x5f, 0x81, 0x78, 0xbd, 0xfa, 0x69, 0x0a, 0x26, 0x65, 0x8a, 0xe0, 0xff, 0x8c, 0xe0, 0xb8,
+	0x5b, 0x1c, 0x47, 0x70, 0x12, 0xf8, 0xd4, 0x82, 0xfb, 0x05, 0xc8, 0x10, 0xc7, 0x1c, 0x43, 0x6e,
+	0x93, 0x1c, 0x76, 0xbe, 0xd8, 0xd0, 0x5b, 0x61, 0x6a, 0x22, 0x3b, 0x94, 0xe6, 0x90, 0xa2, 0x1c,
+	0x49, 0x4c, 0xbc, 0x0f, 0x53, 0x3c, 0x33, 0xbc, 0x37, 0x72, 0x34, 0xc2, 0x85, 0xc1, 0x53, 0xbe,
+	0x7b, 0x72, 0x81, 0xcc, 0x93, 0x41, 0xc9, 0x54, 0x7f, 0x37, 0x05, 0x29, 0x1e, 0xa1, 0xbc, 0x08,
+	0x9a, 0xf1, 0x21, 0x4c, 0x6c, 0xb9, 0xb6, 0x49, 0xe2, 0x65, 0x33, 0x25, 0x06, 0xbd, 0x22, 0x4f,
+	0x56, 0xc4, 0x2e, 0x70, 0x3a, 0xe2, 0xf1, 0x23, 0x47, 0x2a, 0x55, 0x80, 0x1d, 0xe6, 0xac, 0xdb,
+	0x2c, 0x07, 0x10, 0x33, 0x81, 0xc3, 0x81, 0x2c, 0x97, 0xc0, 0x42, 0x22, 0xd1, 0x4a, 0xdf, 0xa1,
+	0x96, 0x1d, 0x4f, 0x01, 0x44, 0xff, 0x1b, 0x0c, 0x88, 0x5e, 0x87, 0x74, 0xcf, 0xb3, 0x3a, 0x27,
+	0x28, 0x41, 0x10, 0xe8, 0xf2, 0xcf, 0xe8, 0x2d, 0xc8, 0x78, 0xc4, 0x26, 0xd8, 0x27, 0x66, 0x29,
+	0x7b, 0x0a, 0x69, 0x48, 0xc1, 0x42, 0x9f, 0x0e, 0x76, 0x3a, 0xc4, 0xb6, 0x85, 0x7e, 0x42, 0xac,
+	0xd0, 0x27, 0x84, 0x6a, 0x54, 0xfd, 0x7e, 0x02, 0x0a, 0xcd, 0x20, 0xf2, 0xe2, 0x3b, 0xc8, 0x9f,
+	0xd5, 0xb1, 0xd9, 0x48, 0xd4, 0x95, 0x3a, 0x37, 0xea, 0x8a, 0xee, 0xd8, 0xd2, 0x67, 0xec, 0xd8,
+	0xaa, 0x00, 0x3d, 0x1b, 0x3b, 0xce, 0x38, 0x19, 0x3d, 0x09, 0xd4, 0xa8, 0xfa, 0x99, 0x02, 0x59,
+	0xd6, 0x74, 0x95, 0xd8, 0x38, 0xa6, 0x97, 0x7d, 0x85, 0x1d, 0x39, 0xb1, 0x50, 0xaa, 0x6d, 0x32,
+	0xb0, 0xd8, 0x65, 0x27, 0x8d, 0xbc, 0xa8, 0xe4, 0x0d, 0xf2, 0xb4, 0x10, 0xde, 0x21, 0x1e, 0xde,
+	0x24, 0x82, 0x4a, 0x6c, 0x15, 0x8d, 0xbc, 0xac, 0x14, 0xdd, 0x0e, 0x27, 0x27, 0x53, 0xe3, 0x25,
+	0x27, 0xd5, 0x9f, 0x24, 0xa1, 0xc0, 0x05, 0xb0, 0xee, 0xfa, 0x56, 0xfc, 0xc4, 0xe2, 0x90, 0x4c,
+	0x13, 0x71, 0x64, 0x9a, 0x3c, 0x57, 0xa6, 0x55, 0x00, 0xec, 0x79, 0xd6, 0xce, 0x38, 0x03, 0x94,
+	0x40, 0x7e, 0x22, 0x3d, 0x1b, 0x9e, 0x8a, 0x46, 0xfb, 0x17, 0x5a, 0x72, 0xfd, 0xe8, 0x70, 0x01,
+	0xad, 0xcb, 0xef, 0x11, 0x3e, 0x50, 0xef, 0x58, 0xdd, 0x90, 0x8e, 0x4d, 0x5c, 0x34, 0x2b, 0x30,
+	0x79, 0x7e, 0x56, 0x60, 0x09, 0x72, 0x9c, 0x5d, 0x6c, 0xf3, 0xa6, 0x33, 0x83, 0x89, 0xd1, 0x44,
+	0x35, 0x9f, 0x18, 0x1c, 0xfe, 0x66, 0xfe, 0x48, 0x64, 0x03, 0xc6, 0x48, 0x19, 0x41, 0x80, 0xd4,
+	0xa8, 0xfa, 0xdb, 0x29, 0xc8, 0xd4, 0x9c, 0x0e, 0x4f, 0x90, 0x5f, 0xae, 0xa9, 0xbf, 0x0b, 0x19,
+	0x9f, 0xec, 0x10, 0xcf, 0xa2, 0x42, 0xd0, 0x53, 0xcb, 0x37, 0xe4, 0x0c, 0x04, 0xfd, 0x35, 0xe5,
+	0x67, 0x23, 0x24, 0x3c, 0x76, 0xa0, 0x97, 0x3a, 0xff, 0x40, 0xef, 0x92, 0x82, 0x83, 0x35, 0x98,
+	0x26, 0xbb, 0x3d, 0xd2, 0x61, 0xcd, 0x10, 0x27, 0xbe, 0x6b, 0x28, 0x04, 0x68, 0xdd, 0x61, 0xcd,
+	0xbd, 0xcf, 0xa6, 0xd2, 0xf7, 0xf1, 0x26, 0x09, 0xf2, 0x0b, 0xc1, 0x6a, 0xbe, 0xea, 0x76, 0xb0,
+	0x6d, 0x7d, 0x97, 0x98, 0x2d, 0xb2, 0x1b, 0x24, 0x19, 0x42, 0x5a, 0xa4, 0xf1, 0x23, 0x27, 0x27,
+	0x92, 0x2d, 0xbc, 0xe0, 0x9a, 0x98, 0x11, 0xb0, 0x15, 0xae, 0x1a, 0x1e, 0xf1, 0x5d, 0x7b, 0x67,
+	0x1c, 0xd5, 0x08, 0x90, 0x1a, 0x55, 0xbf, 0x0e, 0x85, 0x21, 0x5e, 0xd9, 0x25, 0x14, 0x1b, 0x3b,
+	0x9b, 0x7d, 0xbc, 0x29, 0xb6, 0x18, 0x59, 0x23, 0x2c, 0xb3, 0x2d, 0x2f, 0x25, 0xbb, 0x62, 0x03,
+	0x93, 0x35, 0xf8, 0x6f, 0xf5, 0x77, 0x92, 0x70, 0x83, 0x3b, 0x01, 0xae, 0xc3, 0x44, 0x0a, 0x50,
+	0xdf, 0xb9, 0x74, 0x55, 0x8b, 0xed, 0x56, 0x86, 0x5c, 0x56, 0xea, 0x4c, 0x97, 0x35, 0xec, 0x81,
+	0xd2, 0x63, 0x7a, 0xa0, 0x15, 0x40, 0x83, 0x4c, 0x08, 0xcf, 0xa7, 0x0d, 0x3c, 0xc8, 0xec, 0xd1,
+	0xe1, 0x42, 0x71, 0x68, 0xcd, 0x65, 0x1c, 0x14, 0xfd, 0x91, 0x1a, 0xb6, 0x43, 0x13, 0x2b, 0x81,
+	0xb8, 0xba, 0x25, 0x0a, 0x2c, 0xf0, 0xb2, 0xa4, 0x3d, 0x09, 0x93, 0x11, 0xa7, 0x9d, 0x3c, 0xf0,
+	0x0a, 0x0c, 0x8d, 0xdb, 0x4c, 0xde, 0x8a, 0x94, 0xd4, 0xbf, 0x4d, 0x48, 0xd9, 0x88, 0x8c, 0xe7,
+	0xff, 0x4e, 0xd9, 0x8c, 0x78, 0xcd, 0x74, 0x5c, 0xaf, 0x39, 0x31, 0xa6, 0xd7, 0xe4, 0xa2, 0x78,
+	0x42, 0x6c, 0x3b, 0x14, 0x05, 0x2b, 0xa8, 0x0f, 0x60, 0xd6, 0x90, 0xb7, 0x23, 0xc2, 0x90, 0x77,
+	0xcd, 0xdf, 0x8c, 0x37, 0x9f, 0xc1, 0xe5, 0x87, 0xc4, 0xe0, 0xf2, 0x83, 0xfa, 0x87, 0x0a, 0xcc,
+	0x9d, 0x62, 0x48, 0xb1, 0xdb, 0x8f, 0x7d, 0xf4, 0x7a, 0xf1, 0x90, 0x6d, 0xc0, 0xe7, 0x71, 0xa5,
+	0x7a, 0xa1, 0xf8, 0xfc, 0x54, 0x81, 0x42, 0xc5, 0x23, 0x98, 0x12, 0xb6, 0x70, 0x5f, 0x86, 0x88,
+	0x06, 0xf7, 0x53, 0x92, 0x67, 0xdd, 0x4f, 0xb9, 0xc0, 0x72, 0xa6, 0xfe, 0xa5, 0x02, 0x85, 0x8d,
+	0x9e, 0x39, 0x2e, 0x73, 0xd1, 0x08, 0x25, 0x71, 0x46, 0x84, 0xf2, 0x6c, 0x2f, 0xd9, 0xfc, 0x9e,
+	0x02, 0x99, 0x16, 0xee, 0xd5, 0x9c, 0xd8, 0xfc, 0x1f, 0xdb, 0x48, 0x26, 0x2e, 0xb4, 0x91, 0x8c,
+	0xeb, 0x66, 0xd4, 0xdf, 0x57, 0x20, 0xdb, 0xc2, 0xbd, 0x46, 0x9f, 0xbe, 0xb0, 0x2c, 0x7e, 0x5f,
+	0x81, 0xe9, 0x26, 0xc5, 0x1e, 0x0d, 0xae, 0x7b, 0xbc, 0xa8, 0x8c, 0x7e, 0x4f, 0x81, 0x82, 0xee,
+	0x98, 0x2f, 0x3a, 0x9b, 0xdf, 0x82, 0xa2, 0xb8, 0x0a, 0x37, 0x2e, 0xa3, 0x0b, 0x90, 0x0b, 0xee,
+	0xe4, 0x84, 0x6c, 0x1a, 0x20, 0xab, 0x58, 0x0f, 0x3f, 0x52, 0x60, 0x7a, 0xbd, 0xef, 0x75, 0xb6,
+	0xb0, 0x4f, 0x18, 0xe7, 0xcf, 0x6b, 0x2a, 0x82, 0x44, 0x48, 0xf2, 0xe2, 0x89, 0x90, 0xd4, 0x78,
+	0x89, 0x10, 0xf5, 0x01, 0x14, 0x2a, 0x3c, 0x5f, 0x30, 0xd6, 0xf8, 0x5e, 0x82, 0x0c, 0x63, 0x3c,
+	0x32, 0x7d, 0x93, 0xac, 0xcc, 0xe6, 0xee, 0x63, 0x98, 0x32, 0x44, 0x3e, 0xe3, 0xb2, 0x5b, 0xf6,
+	0x00, 0x09, 0x87, 0x1a, 0x1e, 0x76, 0x8d, 0xb1, 0x1a, 0x45, 0x8f, 0xd0, 0x12, 0xe7, 0x1e, 0xa1,
+	0xa9, 0x7f, 0xa1, 0xc0, 0xb5, 0x20, 0xa6, 0x1b, 0x9c, 0xeb, 0x3d, 0xf3, 0x7e, 0x47, 0x8f, 0xee,
+	0x92, 0x63, 0x1e, 0xdd, 0xa9, 0x36, 0x5c, 0xd3, 0x64, 0xe9, 0x29, 0xd8, 0x7f, 0x1d, 0xa6, 0x39,
+	0xfb, 0xe2, 0x26, 0x70, 0x44, 0x36, 0xe2, 0x3e, 0x22, 0xaf, 0x65, 0x12, 0xfa, 0x89, 0x02, 0x37,
+	0x9a, 0x84, 0x1e, 0x3b, 0xab, 0x79, 0x5e, 0xf6, 0x33, 0xde, 0xf1, 0x11, 0x3b, 0x4e, 0x18, 0xdc,
+	0xcd, 0x8d, 0x67, 0x50, 0x24, 0xb8, 0x93, 0xab, 0xfe, 0x8b, 0x02, 0xb0, 0x8e, 0xf7, 0xd8, 0x2c,
+	0x3f, 0xaf, 0xe1, 0x9e, 0x98, 0x8f, 0x4f, 0x3e, 0xe5, 0x61, 0x5d, 0xea, 0xa2, 0x99, 0x74, 0xf5,
+	0xaf, 0x95, 0xc0, 0x20, 0xc7, 0x0f, 0x93, 0xc7, 0x1c, 0xf9, 0x49, 0x51, 0x4f, 0xe4, 0xe4, 0x2e,
+	0x35, 0xc6, 0xc9, 0x9d, 0xfa, 0xcb, 0x70, 0xbd, 0x1a, 0x5e, 0x8b, 0x7e, 0xde, 0x23, 0x52, 0x7f,
+	0x35, 0x09, 0x45, 0x11, 0xcb, 0xca, 0x19, 0x8e, 0xdd, 0x71, 0xe4, 0x41, 0x52, 0xe2, 0x8c, 0x07,
+	0x49, 0xc9, 0xb3, 0x1e, 0x24, 0xa5, 0xce, 0x79, 0x90, 0x94, 0x3e, 0xff, 0x41, 0xd2, 0xc4, 0x45,
+	0x1e, 0x24, 0x4d, 0x9e, 0xff, 0x20, 0x29, 0x73, 0xfe, 0x83, 0xa4, 0xec, 0x59, 0x0f, 0x92, 0x20,
+	0xf6, 0x83, 0x24, 0xf5, 0xcf, 0x92, 0x50, 0x14, 0x0a, 0x3d, 0xae, 0x0c, 0x62, 0xef, 0x76, 0xfe,
+	0xff, 0x15, 0xd9, 0x53, 0xbe, 0x22, 0x53, 0x7b, 0x50, 0x34, 0xf8, 0x63, 0xb0, 0xe7, 0x25, 0x33,
+	0xf5, 0x9f, 0x15, 0xd6, 0xa5, 0xf0, 0x13, 0x7c, 0x57, 0x1a, 0xbb, 0xcb, 0x88, 0xab, 0x4a, 0x3c,
+	0xd5, 0xe3, 0xb2, 0xe4, 0x69, 0x8f, 0xcb, 0x52, 0x67, 0x3d, 0x2e, 0x4b, 0x9f, 0xf9, 0xb8, 0x6c,
+	0x62, 0xe4, 0x71, 0x99, 0xfa, 0xc7, 0x7c, 0xbc, 0xd8, 0xf7, 0xad, 0x4d, 0x67, 0xbc, 0xf1, 0xc6,
+	0x38, 0x5a, 0x78, 0xca, 0x77, 0x40, 0xaa, 0x03, 0xb3, 0xd5, 0xc8, 0x4b, 0xb6, 0x67, 0xcd, 0xaf,
+	0xfa, 0x6f, 0x0a, 0x94, 0x0c, 0x7e, 0xb3, 0x7b, 0xe4, 0xe6, 0xf9, 0xb3, 0xf7, 0x1d, 0x3f, 0xb3,
+	0xcb, 0xf4, 0xea, 0x0f, 0x15, 0x28, 0x36, 0x09, 0x1d, 0x5c, 0x61, 0x8f, 0x3d, 0xd8, 0x93, 0xee,
+	0x7d, 0x27, 0x9e, 0xe2, 0xde, 0x77, 0x72, 0xcc, 0x5b, 0xea, 0xa9, 0x93, 0x6e, 0xa9, 0xff, 0x97,
+	0x02, 0x57, 0xd7, 0xc5, 0xf5, 0xdf, 0xf0, 0x16, 0xee, 0x33, 0x4b, 0xe0, 0x44, 0x2e, 0x4e, 0x27,
+	0xe3, 0x5f, 0x9c, 0x4e, 0xc5, 0xb8, 0x38, 0x9d, 0xbe, 0xe0, 0xc5, 0x69, 0xf5, 0x4f, 0xd8, 0xa6,
+	0x27, 0xb8, 0x8e, 0x3c, 0xfe, 0x0c, 0xbc, 0xc7, 0x56, 0x19, 0x09, 0x3e, 0x76, 0x39, 0x3f, 0xf8,
+	0x20, 0x84, 0x14, 0x29, 0x0d, 0xf8, 0x4d, 0x5e, 0x94, 0xdf, 0x4f, 0x12, 0x30, 0x1d, 0x6c, 0xd2,
+	0xd8, 0xd7, 0x67, 0xe9, 0x9f, 0x62, 0xe7, 0xc1, 0xa3, 0x7a, 0x90, 0xba, 0xf0, 0x71, 0x76, 0x7a,
+	0xcc, 0xe3, 0xec, 0xff, 0x48, 0xc0, 0x74, 0xa3, 0x47, 0x9c, 0xe0, 0xc8, 0x20, 0xf6, 0x54, 0x44,
+	0x8f, 0xfb, 0x12, 0xe3, 0x1e, 0xf7, 0x25, 0x63, 0x1f, 0xf7, 0xa5, 0x2e, 0xef, 0xb8, 0x2f, 0x7d,
+	0x49, 0xc7, 0x7d, 0x13, 0x17, 0x3f, 0xee, 0x53, 0x7f, 0x9c, 0x80, 0x19, 0x11, 0x39, 0x8e, 0x3d,
+	0xf1, 0xcb, 0x90, 0x8f, 0x1e, 0x00, 0x49, 0x35, 0xe4, 0x73, 0x18, 0x39, 0xff, 0x31, 0x72, 0x91,
+	0xe3, 0x9f, 0xe7, 0x77, 0x36, 0xfb, 0x82, 0x4c, 0x73, 0x1f, 0x90, 0x21, 0x0e, 0x36, 0x9f, 0xe7,
+	0x34, 0xab, 0xbf, 0x04, 0x68, 0x0d, 0x7b, 0xdb, 0xad, 0xf0, 0x89, 0x5f, 0xfc, 0x6e, 0x6f, 0x1e,
+	0xf3, 0x30, 0x91, 0x18, 0xe2, 0x57, 0x14, 0xb8, 0xce, 0xb3, 0x34, 0xe1, 0xb0, 0x9e, 0xf7, 0x83,
+	0x8c, 0x37, 0xfe, 0x51, 0x81, 0xe9, 0x91, 0xa7, 0xec, 0xe8, 0x1d, 0x98, 0x6d, 0x19, 0x5a, 0xad,
+	0xde, 0xae, 0xdc, 0xd5, 0xea, 0x77, 0xf4, 0x76, 0xad, 0x7e, 0x5f, 0x5b, 0xad, 0x55, 0x8b, 0x57,
+	0xe6, 0xae, 0xef, 0x1f, 0x94, 0x51, 0x84, 0xbc, 0xe6, 0xf0, 0xc4, 0x22, 0x7a, 0x07, 0x6e, 0x0c,
+	0x21, 0x0c, 0xfd, 0x4e, 0xad, 0xd9, 0xd2, 0x0d, 0xbd, 0x5a, 0x54, 0xe6, 0xae, 0xee, 0x1f, 0x94,
+	0x45, 0x1f, 0x46, 0xf8, 0xf6, 0xf8, 0x04, 0x84, 0xd6, 0x6c, 0xd6, 0xee, 0xd4, 0xf5, 0x6a, 0x31,
+	0x31, 0x84, 0x10, 0xe1, 0x27, 0x31, 0xd1, 0xd7, 0xe0, 0xe6, 0x10, 0xa2, 0xaa, 0x57, 0x1a, 0x6b,
+	0x6b, 0xb5, 0x66, 0xb3, 0xd6, 0x60, 0xa8, 0xe4, 0xdc, 0x8d, 0xfd, 0x83, 0xf2, 0x55, 0x79, 0x7c,
+	0x15, 0xfd, 0x4b, 0x83, 0xb9, 0xd4, 0x27, 0x7f, 0x30, 0x7f, 0xe5, 0x8d, 0x1f, 0x27, 0xa0, 0x30,
+	0x14, 0x23, 0xa1, 0x77, 0xe0, 0xba, 0xfe, 0x8d, 0x8d, 0xda, 0xfa, 0x9a, 0x5e, 0x6f, 0xb5, 0x5b,
+	0x0f, 0xd7, 0xa3, 0x23, 0x9d, 0xdd, 0x3f, 0x28, 0x17, 0x43, 0xf2, 0x60, 0x9c, 0x5f, 0x81, 0xd2,
+	0x08, 0x42, 0x6f, 0x56, 0xb4, 0x55, 0xad, 0xd5, 0x30, 0x8a, 0x8a, 0x98, 0x9d, 0x10, 0xa3, 0x87,
+	0x7b, 0xb6, 0x65, 0xb8, 0x31, 0x8a, 0x5a, 0xd5, 0xef, 0x73, 0x50, 0x62, 0xee, 0xda, 0xfe, 0x41,
+	0x79, 0x66, 0x00, 0x0a, 0xf6, 0x72, 0x1f, 0xc0, 0xcb, 0x23, 0x98, 0x56, 0x63, 0x75, 0xb5, 0x7d,
+	0x47, 0x6b, 0xe9, 0x6d, 0xbd, 0xde, 0x2a, 0x26, 0xe7, 0x4a, 0xfb, 0x07, 0xe5, 0xd9, 0xc1, 0x80,
+	0x22, 0x9b, 0xb3, 0xaf, 0xc1, 0xcd, 0xd3, 0xb1, 0x1f, 0x17, 0x53, 0x62, 0xa6, 0x8e, 0x43, 0x77,
+	0xd1, 0xcf, 0x1d, 0xef, 0xb5, 0x56, 0xb9, 0xa7, 0xb7, 0xda, 0x8d, 0xdb, 0xb7, 0x6b, 0x15, 0xbd,
+	0x98, 0x9e, 0x7b, 0x69, 0xff, 0xa0, 0x7c, 0x6d, 0x00, 0x8d, 0xec, 0x1b, 0xe5, 0x34, 0xff, 0xbb,
+	0x02, 0xd3, 0x23, 0xe1, 0x23, 0x5a, 0x8a, 0x4e, 0x5b, 0xb3, 0xa5, 0xb5, 0x36, 0x9a, 0x91, 0xa9,
+	0x9e, 0xd9, 0x3f, 0x28, 0x17, 0x04, 0x65, 0x30, 0xcf, 0x5f, 0x85, 0x97, 0x8f, 0x01, 0x1a, 0xeb,
+	0xba, 0xa1, 0xb5, 0x6a, 0x8d, 0xba, 0xb6, 0x5a, 0x54, 0xc4, 0xb4, 0x09, 0x50, 0x83, 0xbf, 0xd5,
+	0xb5, 0x5c, 0x07, 0xdb, 0x27, 0x02, 0xd7, 0xb4, 0x5a, 0xbd, 0xa5, 0xd7, 0xb5, 0x7a, 0x45, 0x2f,
+	0x26, 0xa2, 0xc0, 0x35, 0x6c, 0x39, 0x94, 0x38, 0x6c, 0x37, 0x8a, 0xbe, 0x0a, 0x5f, 0x38, 0xde,
+	0xe3, 0x06, 0x1b, 0x78, 0xbb, 0x61, 0x54, 0x75, 0xa3, 0x98, 0x14, 0x2a, 0x21, 0xbb, 0xec, 0xd3,
+	0xc6, 0xe3, 0x86, 0x67, 0x12, 0x4f, 0x8e, 0xfa, 0x87, 0x0a, 0x4c, 0x0d, 0xc7, 0x6d, 0x68, 0x09,
+	0x6e, 0x34, 0x75, 0xe3, 0x7e, 0xad, 0xa2, 0xb7, 0xd7, 0xb5, 0x56, 0x4b, 0x37, 0xea, 0x91, 0x31,
+	0xa3, 0xfd, 0x83, 0x72, 0x00, 0x08, 0x06, 0x7d, 0x02, 0xe0, 0x81, 0xae, 0xdf, 0xab, 0x6a, 0x0f,
+	0x8b, 0xca, 0x10, 0xe0, 0x01, 0x21, 0xdb, 0x26, 0xde, 0x43, 0x5f, 0x86, 0xd2, 0x28, 0xa0, 0xa9,
+	0xb5, 0x36, 0x0c, 0x86, 0x90, 0x46, 0x24, 0x11, 0x4d, 0x4c, 0xfb, 0x1e, 0x83, 0x9c, 0xd0, 0xc7,
+	0xdd, 0xc6, 0x6a, 0x8d, 0x21, 0x92, 0x43, 0x7d, 0xdc, 0x75, 0x6d, 0xcb, 0xc4, 0x7b, 0x72, 0x78,
+	0x9f, 0xb2, 0xa7, 0x55, 0x61, 0x60, 0xf9, 0x26, 0xcc, 0x54, 0x6b, 0x86, 0x5e, 0x61, 0xc2, 0x18,
+	0x35, 0x99, 0x90, 0x2a, 0x18, 0xd5, 0xdb, 0x80, 0x06, 0xc4, 0x8d, 0x8d, 0xd6, 0x4a, 0x63, 0xa3,
+	0x5e, 0x0d, 0x04, 0x18, 0x52, 0x37, 0xfa, 0xf4, 0x91, 0xdb, 0x77, 0xcc, 0xd1, 0xb6, 0x05, 0x75,
+	0xe2, 0x58, 0xdb, 0x9c, 0x58, 0x32, 0xf7, 0x4f, 0x09, 0x98, 0x39, 0x96, 0xe6, 0x45, 0xef, 0xc1,
+	0xcd, 0x75, 0xad, 0xd9, 0xd4, 0xeb, 0x77, 0x74, 0xa3, 0x5d, 0xd1, 0x5a, 0xfa, 0x9d, 0x86, 0xf1,
+	0x90, 0x49, 0xb6, 0x5e, 0xd5, 0x8c, 0x90, 0xdd, 0x80, 0xbc, 0x49, 0xb1, 0x63, 0x62, 0xcf, 0x44,
+	0xef, 0xc2, 0xdc, 0x89, 0xb0, 0x8d, 0x2a, 0xb3, 0x3a, 0xe9, 0xcc, 0x06, 0xa8, 0x3e, 0xbf, 0x77,
+	0xf5, 0x65, 0x78, 0xe9, 0x24, 0x90, 0x5e, 0xaf, 0x71, 0x13, 0xe7, 0xf3, 0x1a, 0x62, 0x88, 0x63,
+	0xb9, 0xde, 0x29, 0xec, 0x55, 0x6b, 0x4d, 0x6d, 0x65, 0x95, 0x7b, 0xb3, 0x21, 0xf6, 0xaa, 0x96,
+	0xcf, 0x22, 0xe0, 0xd3, 0xd8, 0x6b, 0xe9, 0x5a, 0xe5, 0xae, 0x6e, 0x14, 0x53, 0xc3, 0xec, 0xb5,
+	0x08, 0xee, 0x6c, 0x11, 0x0f, 0x55, 0xe1, 0x95, 0x33, 0xfa, 0x6a, 0xdf, 0xd7, 0x5b, 0xba, 0xa1,
+	0xd5, 0x8b, 0xe9, 0xb9, 0x9b, 0xfb, 0x07, 0xe5, 0x1b, 0xa3, 0x7d, 0xde, 0x27, 0x94, 0x78, 0xd8,
+	0x91, 0x93, 0xfd, 0x77, 0x0a, 0x14, 0x86, 0xee, 0x33, 0xa3, 0x45, 0xb8, 0xfe, 0x51, 0x63, 0xc3,
+	0xa8, 0xeb, 0x0f, 0x8f, 0x9b, 0x36, 0x1f, 0xb9, 0x24, 0x0f, 0x14, 0xe2, 0x16, 0x5c, 0x1d, 0xa1,
+	0x6f, 0xac, 0xeb, 0xf5, 0xa2, 0x32, 0x37, 0xbd, 0x7f, 0x50, 0xce, 0x49, 0x62, 0x16, 0xca, 0xa2,
+	0x65, 0x28, 0x8d, 0x50, 0x56, 0x1a, 0x6b, 0xeb, 0xab, 0x7a, 0x4b, 0x0f, 0x55, 0x42, 0x92, 0x57,
+	0xdc, 0x6e, 0xcf, 0x26, 0x94, 0x98, 0x27, 0x70, 0xa3, 0x7f, 0xbc, 0x5e, 0x33, 0xf8, 0x94, 0x46,
+	0xb9, 0x11, 0x07, 0x89, 0x81, 0x0a, 0xfd, 0x9a, 0x02, 0x99, 0xe0, 0xb4, 0x0d, 0xbd, 0x0e, 0x33,
+	0x6c, 0xba, 0xda, 0xf7, 0x6a, 0xf5, 0x6a, 0x64, 0x2c, 0x9c, 0x3d, 0x46, 0x14, 0x0c, 0xe4, 0x55,
+	0x28, 0x0e, 0xe8, 0x98, 0xa5, 0xae, 0x32, 0x43, 0x9d, 0xda, 0x3f, 0x28, 0x03, 0x23, 0x7b, 0xc0,
+	0xdf, 0x92, 0x0d, 0xb7, 0xb6, 0xd6, 0xa8, 0xb7, 0xee, 0xae, 0x32, 0xeb, 0x0c, 0x5b, 0x5b, 0x13,
+	0x8f, 0xc8, 0x24, 0x23, 0xbf, 0x95, 0x80, 0xe2, 0x68, 0x08, 0x87, 0x96, 0xe1, 0xa5, 0x5a, 0xbd,
+	0x52, 0xab, 0x72, 0xd7, 0xa4, 0xdf, 0xd7, 0x8d, 0x5a, 0xeb, 0x61, 0x84, 0x31, 0x69, 0xe8, 0x82,
+	0x38, 0x60, 0xee, 0x2d, 0xb8, 0x7e, 0x12, 0xe6, 0x76, 0xa3, 0xa8, 0xcc, 0x15, 0xf7, 0x0f, 0xca,
+	0xf9, 0x01, 0xe0, 0xb1, 0x8b, 0x16, 0xe1, 0xc6, 0x71, 0xea, 0xb5, 0x5a, 0x9d, 0xab, 0xaf, 0xf0,
+	0xcf, 0x92, 0x7c, 0xcd, 0x72, 0x5c, 0xef, 0x14, 0x7a, 0xed, 0xa3, 0x06, 0xf3, 0x93, 0xc3, 0xf4,
+	0xf8, 0xdb, 0xae, 0x87, 0xde, 0x87, 0x9b, 0xc7, 0xe9, 0x9b, 0x1b, 0xcd, 0x75, 0xbd, 0x5e, 0xd5,
+	0xab, 0xc5, 0x94, 0xf4, 0xca, 0x12, 0xd3, 0xec, 0xfb, 0x3d, 0x7e, 0xe7, 0x5e, 0x4c, 0xca, 0x4a,
+	0xe9, 0xb3, 0xa3, 0x79, 0xe5, 0x07, 0x47, 0xf3, 0xca, 0x8f, 0x8e, 0xe6, 0x95, 0xdf, 0xf8, 0x7c,
+	0xfe, 0xca, 0x0f, 0x3e, 0x9f, 0xbf, 0xf2, 0x0f, 0x9f, 0xcf, 0x5f, 0x79, 0x34, 0xc1, 0xff, 0xeb,
+	0xeb, 0xdd, 0xff, 0x19, 0x00, 0xa2, 0x0c, 0x7d, 0x0f, 0x3e, 0x4c, 0x00, 0x00,
+}
+
+func (m *Station) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Station) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.Station) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Station)))
+		i += copy(dAtA[i:], m.Station)
+	}
+	if m.Escalator != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Escalator))
+	}
+	if m.Elevator != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Elevator))
+	}
+	if m.IsPeronAda {
+		dAtA[i] = 0x30
+		i++
+		if m.IsPeronAda {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.TicketOffice != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TicketOffice))
+	}
+	if m.TollGateEnt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TollGateEnt))
+	}
+	if m.TollGateEx != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TollGateEx))
+	}
+	if m.EntranceExit != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EntranceExit))
+	}
+	if len(m.Gates) > 0 {
+		for _, b := range m.Gates {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.RetiredAt != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RetiredAt))
+	}
+	return i, nil
+}
+
+func (m *Train) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Train) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Model) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Model)))
+		i += copy(dAtA[i:], m.Model)
+	}
+	if m.Capacity != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Capacity))
+	}
+	if m.CarCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CarCount))
+	}
+	if len(m.HomeDepot) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.HomeDepot)))
+		i += copy(dAtA[i:], m.HomeDepot)
+	}
+	if m.DecommissionedAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DecommissionedAt))
+	}
+	if m.SilentSince != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SilentSince))
+	}
+	if len(m.WatchdogTaskID) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.WatchdogTaskID)))
+		i += copy(dAtA[i:], m.WatchdogTaskID)
+	}
+	return i, nil
+}
+
+func (m *TrainChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainChange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	if m.Kind != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Kind))
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.PreviousAddress) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PreviousAddress)))
+		i += copy(dAtA[i:], m.PreviousAddress)
+	}
+	if m.ChangedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ChangedAt))
+	}
+	return i, nil
+}
+
+func (m *Passenger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Passenger) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.RegisteredAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RegisteredAt))
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Category != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Category))
+	}
+	if m.CategoryExpiresAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CategoryExpiresAt))
+	}
+	if m.DeregisteredAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DeregisteredAt))
+	}
+	return i, nil
+}
+
+func (m *Line) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Line) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Color) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Color)))
+		i += copy(dAtA[i:], m.Color)
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *Configuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Admin) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Admin)))
+		i += copy(dAtA[i:], m.Admin)
+	}
+	if len(m.FareCollector) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.FareCollector)))
+		i += copy(dAtA[i:], m.FareCollector)
+	}
+	if len(m.FareAdmin) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.FareAdmin)))
+		i += copy(dAtA[i:], m.FareAdmin)
+	}
+	if len(m.CategoryIssuer) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CategoryIssuer)))
+		i += copy(dAtA[i:], m.CategoryIssuer)
+	}
+	if len(m.FleetManager) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.FleetManager)))
+		i += copy(dAtA[i:], m.FleetManager)
+	}
+	if len(m.Maintainers) > 0 {
+		for _, b := range m.Maintainers {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Operators) > 0 {
+		for _, b := range m.Operators {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.TrainSilenceMinutes != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TrainSilenceMinutes))
+	}
+	if m.MaxJourneyMinutes != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxJourneyMinutes))
+	}
+	if m.MaxFare != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxFare.Size()))
+		n7, err := m.MaxFare.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.CurrencyIssuer) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CurrencyIssuer)))
+		i += copy(dAtA[i:], m.CurrencyIssuer)
+	}
+	return i, nil
+}
+
+func (m *Equipment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Equipment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Identifier) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Identifier)))
+		i += copy(dAtA[i:], m.Identifier)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Status))
+	}
+	if m.UpdatedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdatedAt))
+	}
+	if len(m.ReportedBy) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ReportedBy)))
+		i += copy(dAtA[i:], m.ReportedBy)
+	}
+	return i, nil
+}
+
+func (m *Connection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Connection) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.FromStationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.FromStationKey)))
+		i += copy(dAtA[i:], m.FromStationKey)
+	}
+	if len(m.ToStationKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ToStationKey)))
+		i += copy(dAtA[i:], m.ToStationKey)
+	}
+	if m.TravelMinutes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TravelMinutes))
+	}
+	return i, nil
+}
+
+func (m *RouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromStationKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.FromStationKey)))
+		i += copy(dAtA[i:], m.FromStationKey)
+	}
+	if len(m.ToStationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ToStationKey)))
+		i += copy(dAtA[i:], m.ToStationKey)
+	}
+	if m.Accessible {
+		dAtA[i] = 0x18
+		i++
+		if m.Accessible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Route) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Route) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, msg := range m.Hops {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.TotalMinutes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalMinutes))
+	}
+	return i, nil
+}
+
+func (m *RouteHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteHop) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if m.Minutes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Minutes))
+	}
+	return i, nil
+}
+
+func (m *Timetable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Timetable) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n10, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.LineKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LineKey)))
+		i += copy(dAtA[i:], m.LineKey)
+	}
+	if m.Service != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Service))
+	}
+	if m.Direction != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Direction))
+	}
+	if len(m.Stops) > 0 {
+		for _, msg := range m.Stops {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.PublishedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PublishedAt))
+	}
+	if len(m.SupersededBy) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SupersededBy)))
+		i += copy(dAtA[i:], m.SupersededBy)
+	}
+	if m.SupersededAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SupersededAt))
+	}
+	return i, nil
+}
+
+func (m *TimetableStop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimetableStop) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if len(m.Departures) > 0 {
+		dAtA12 := make([]byte, len(m.Departures)*10)
+		var j11 int
+		for _, num := range m.Departures {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(j11))
+		i += copy(dAtA[i:], dAtA12[:j11])
+	}
+	return i, nil
+}
+
+func (m *DeparturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeparturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if m.At != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.At))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *Departures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Departures) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Departures) > 0 {
+		for _, msg := range m.Departures {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Departure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Departure) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.LineKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LineKey)))
+		i += copy(dAtA[i:], m.LineKey)
+	}
+	if m.Direction != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Direction))
+	}
+	if m.DepartsAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DepartsAt))
+	}
+	return i, nil
+}
+
+func (m *FareTable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FareTable) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n13, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.BaseFare.Size()))
+	n14, err := m.BaseFare.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n14
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.StopFare.Size()))
+	n15, err := m.StopFare.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.ZoneFare.Size()))
+	n16, err := m.ZoneFare.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	if len(m.Zones) > 0 {
+		for _, msg := range m.Zones {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.TimeMultipliers) > 0 {
+		for _, msg := range m.TimeMultipliers {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Discounts) > 0 {
+		for _, msg := range m.Discounts {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.WeeklyPass != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.WeeklyPass.Size()))
+		n17, err := m.WeeklyPass.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.MonthlyPass != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MonthlyPass.Size()))
+		n18, err := m.MonthlyPass.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+
+func (m *FareChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FareChange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n19, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if m.FareTable != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FareTable.Size()))
+		n20, err := m.FareTable.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.ActivateAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ActivateAt))
+	}
+	if len(m.ScheduledBy) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ScheduledBy)))
+		i += copy(dAtA[i:], m.ScheduledBy)
+	}
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	if m.ActivatedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ActivatedAt))
+	}
+	return i, nil
+}
+
+func (m *StationZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StationZone) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if m.Zone != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Zone))
+	}
+	return i, nil
+}
+
+func (m *TimeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StartMinute != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StartMinute))
+	}
+	if m.EndMinute != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EndMinute))
+	}
+	if m.Percent != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Percent))
+	}
+	return i, nil
+}
+
+func (m *Discount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Discount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Category != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Category))
+	}
+	if m.Percent != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Percent))
+	}
+	return i, nil
+}
+
+func (m *FareQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FareQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntryStationKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EntryStationKey)))
+		i += copy(dAtA[i:], m.EntryStationKey)
+	}
+	if len(m.ExitStationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExitStationKey)))
+		i += copy(dAtA[i:], m.ExitStationKey)
+	}
+	if m.Category != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Category))
+	}
+	if m.At != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.At))
+	}
+	return i, nil
+}
+
+func (m *ValidPassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidPassRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.At != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.At))
+	}
+	return i, nil
+}
+
+func (m *FareQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FareQuote) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Fare.Size()))
+	n21, err := m.Fare.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
+	if m.Stops != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Stops))
+	}
+	if m.Zones != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Zones))
+	}
+	return i, nil
+}
+
+func (m *Trip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trip) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n22, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.EntryStationKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EntryStationKey)))
+		i += copy(dAtA[i:], m.EntryStationKey)
+	}
+	if m.EnteredAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EnteredAt))
+	}
+	if len(m.ExitStationKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExitStationKey)))
+		i += copy(dAtA[i:], m.ExitStationKey)
+	}
+	if m.ExitedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExitedAt))
+	}
+	if m.Fare != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Fare.Size()))
+		n23, err := m.Fare.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+
+func (m *Journey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Journey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n24, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.EntryStationKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EntryStationKey)))
+		i += copy(dAtA[i:], m.EntryStationKey)
+	}
+	if m.StartedAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StartedAt))
+	}
+	if len(m.ExitStationKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExitStationKey)))
+		i += copy(dAtA[i:], m.ExitStationKey)
+	}
+	if m.EndedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EndedAt))
+	}
+	if m.Fare != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Fare.Size()))
+		n25, err := m.Fare.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Status))
+	}
+	if len(m.ExpiryTaskID) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExpiryTaskID)))
+		i += copy(dAtA[i:], m.ExpiryTaskID)
+	}
+	return i, nil
+}
+
+func (m *Pass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pass) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n26, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.Holder) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Holder)))
+		i += copy(dAtA[i:], m.Holder)
+	}
+	if m.Kind != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Kind))
+	}
+	if m.ValidFrom != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidUntil))
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Price.Size()))
+	n27, err := m.Price.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n27
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Released.Size()))
+	n28, err := m.Released.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	if m.CancelledAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CancelledAt))
+	}
+	return i, nil
+}
+
+func (m *ScheduledStop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledStop) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n29, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if len(m.LineKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LineKey)))
+		i += copy(dAtA[i:], m.LineKey)
+	}
+	if m.PlannedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PlannedAt))
+	}
+	return i, nil
+}
+
+func (m *LineDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LineDelay) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n30, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if len(m.RecentDelays) > 0 {
+		dAtA32 := make([]byte, len(m.RecentDelays)*10)
+		var j31 int
+		for _, num1 := range m.RecentDelays {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(j31))
+		i += copy(dAtA[i:], dAtA32[:j31])
+	}
+	if m.AverageDelay != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AverageDelay))
+	}
+	if m.UpdatedAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdatedAt))
+	}
+	return i, nil
+}
+
+func (m *TrainPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainPosition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n33, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if m.ArrivedAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArrivedAt))
+	}
+	if len(m.PreviousStationKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PreviousStationKey)))
+		i += copy(dAtA[i:], m.PreviousStationKey)
+	}
+	if len(m.LineKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LineKey)))
+		i += copy(dAtA[i:], m.LineKey)
+	}
+	if m.Direction != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Direction))
+	}
+	if len(m.ArrivalKey) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArrivalKey)))
+		i += copy(dAtA[i:], m.ArrivalKey)
+	}
+	if m.DepartedAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DepartedAt))
+	}
+	return i, nil
+}
+
+func (m *Incident) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Incident) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n34, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if m.Severity != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Severity))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.StartedAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StartedAt))
+	}
+	if m.ExpectedEndAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExpectedEndAt))
+	}
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OpenedBy) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.OpenedBy)))
+		i += copy(dAtA[i:], m.OpenedBy)
+	}
+	if m.ResolvedAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ResolvedAt))
+	}
+	return i, nil
+}
+
+func (m *LocalizedText) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalizedText) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Language) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Language)))
+		i += copy(dAtA[i:], m.Language)
+	}
+	if len(m.Text) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
+	}
+	return i, nil
+}
+
+func (m *TrainArriveStationEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainArriveStationEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n35, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	if m.ArrivedAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArrivedAt))
+	}
+	if len(m.ScheduledStopKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ScheduledStopKey)))
+		i += copy(dAtA[i:], m.ScheduledStopKey)
+	}
+	if m.Delay != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Delay))
+	}
+	if len(m.IncidentKeys) > 0 {
+		for _, b := range m.IncidentKeys {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *TrainDepartStationEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainDepartStationEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n36, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	if len(m.ArrivalKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArrivalKey)))
+		i += copy(dAtA[i:], m.ArrivalKey)
+	}
+	if m.DepartedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DepartedAt))
+	}
+	if m.Dwell != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Dwell))
+	}
+	return i, nil
+}
+
+func (m *RegisterPassengerMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterPassengerMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n37, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *TrainArriveStationEventMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainArriveStationEventMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n38, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	return i, nil
+}
+
+func (m *TrainDepartStationEventMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainDepartStationEventMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n39, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	return i, nil
+}
+
+func (m *CreateLineMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateLineMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n40, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Color) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Color)))
+		i += copy(dAtA[i:], m.Color)
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *UpdateLineMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateLineMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n41, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if len(m.LineKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LineKey)))
+		i += copy(dAtA[i:], m.LineKey)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Color) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Color)))
+		i += copy(dAtA[i:], m.Color)
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *TapInMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TapInMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n42, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	return i, nil
+}
+
+func (m *TapOutMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TapOutMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n43, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	return i, nil
+}
+
+func (m *StartJourneyMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartJourneyMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n44, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	return i, nil
+}
+
+func (m *EndJourneyMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndJourneyMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n45, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	return i, nil
+}
+
+func (m *ExpireJourneyMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpireJourneyMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n46, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
+	if len(m.JourneyKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.JourneyKey)))
+		i += copy(dAtA[i:], m.JourneyKey)
+	}
+	return i, nil
+}
+
+func (m *PurchasePassMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchasePassMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n47, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if m.Kind != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Kind))
+	}
+	if m.ValidFrom != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidFrom))
+	}
+	return i, nil
+}
+
+func (m *CancelPassMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelPassMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n48, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	if len(m.PassKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassKey)))
+		i += copy(dAtA[i:], m.PassKey)
+	}
+	return i, nil
+}
+
+func (m *ReleasePassMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleasePassMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n49, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	if len(m.PassKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassKey)))
+		i += copy(dAtA[i:], m.PassKey)
+	}
+	return i, nil
+}
+
+func (m *UpdateFareTableMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateFareTableMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n50, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	if m.FareTable != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FareTable.Size()))
+		n51, err := m.FareTable.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	return i, nil
+}
+
+func (m *ScheduleFareChangeMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleFareChangeMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n52, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	if m.FareTable != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FareTable.Size()))
+		n53, err := m.FareTable.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if m.ActivateAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ActivateAt))
+	}
+	return i, nil
+}
+
+func (m *ActivateFareChangeMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateFareChangeMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n54, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	if len(m.FareChangeKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.FareChangeKey)))
+		i += copy(dAtA[i:], m.FareChangeKey)
+	}
+	return i, nil
+}
+
+func (m *SetPassengerCategoryMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPassengerCategoryMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n55, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if m.Category != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Category))
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExpiresAt))
+	}
+	return i, nil
+}
+
+func (m *PayFareMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayFareMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n56, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.EntryStationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EntryStationKey)))
+		i += copy(dAtA[i:], m.EntryStationKey)
+	}
+	if len(m.ExitStationKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExitStationKey)))
+		i += copy(dAtA[i:], m.ExitStationKey)
+	}
+	return i, nil
+}
+
+func (m *UpdatePassengerMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePassengerMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n57, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *DeregisterPassengerMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterPassengerMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n58, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
+	if len(m.PassengerKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PassengerKey)))
+		i += copy(dAtA[i:], m.PassengerKey)
+	}
+	return i, nil
+}
+
+func (m *CreateStationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateStationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n59, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
+	}
+	if len(m.Station) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Station)))
+		i += copy(dAtA[i:], m.Station)
+	}
+	if m.Escalator != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Escalator))
+	}
+	if m.Elevator != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Elevator))
+	}
+	if m.IsPeronAda {
+		dAtA[i] = 0x28
+		i++
+		if m.IsPeronAda {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.TicketOffice != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TicketOffice))
+	}
+	if m.TollGateEnt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TollGateEnt))
+	}
+	if m.TollGateEx != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TollGateEx))
+	}
+	if m.EntranceExit != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EntranceExit))
+	}
+	if len(m.Gates) > 0 {
+		for _, b := range m.Gates {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *UpdateStationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateStationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n60, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if len(m.Station) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Station)))
+		i += copy(dAtA[i:], m.Station)
+	}
+	if m.Escalator != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Escalator))
+	}
+	if m.Elevator != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Elevator))
+	}
+	if m.IsPeronAda {
+		dAtA[i] = 0x30
+		i++
+		if m.IsPeronAda {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.TicketOffice != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TicketOffice))
+	}
+	if m.TollGateEnt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TollGateEnt))
+	}
+	if m.TollGateEx != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TollGateEx))
+	}
+	if m.EntranceExit != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EntranceExit))
+	}
+	if len(m.Gates) > 0 {
+		for _, b := range m.Gates {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *RetireStationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetireStationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n61, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	return i, nil
+}
+
+func (m *RegisterTrainMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterTrainMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n62, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Model) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Model)))
+		i += copy(dAtA[i:], m.Model)
+	}
+	if m.Capacity != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Capacity))
+	}
+	if m.CarCount != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CarCount))
+	}
+	if len(m.HomeDepot) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.HomeDepot)))
+		i += copy(dAtA[i:], m.HomeDepot)
+	}
+	return i, nil
+}
+
+func (m *ReassignTrainMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReassignTrainMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n63, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *DecommissionTrainMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecommissionTrainMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n64, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	return i, nil
+}
+
+func (m *ReportEquipmentStatusMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportEquipmentStatusMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n65, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Identifier) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Identifier)))
+		i += copy(dAtA[i:], m.Identifier)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Status))
+	}
+	return i, nil
+}
+
+func (m *SetConnectionMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetConnectionMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n66, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	if len(m.FromStationKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.FromStationKey)))
+		i += copy(dAtA[i:], m.FromStationKey)
+	}
+	if len(m.ToStationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ToStationKey)))
+		i += copy(dAtA[i:], m.ToStationKey)
+	}
+	if m.TravelMinutes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TravelMinutes))
+	}
+	return i, nil
+}
+
+func (m *PublishTimetableMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishTimetableMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n67, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	if len(m.LineKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LineKey)))
+		i += copy(dAtA[i:], m.LineKey)
+	}
+	if m.Service != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Service))
+	}
+	if m.Direction != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Direction))
+	}
+	if len(m.Stops) > 0 {
+		for _, msg := range m.Stops {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SupersedeTimetableMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupersedeTimetableMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n68, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if len(m.TimetableKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TimetableKey)))
+		i += copy(dAtA[i:], m.TimetableKey)
+	}
+	if len(m.Stops) > 0 {
+		for _, msg := range m.Stops {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ScheduleStopMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleStopMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n69, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if len(m.LineKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LineKey)))
+		i += copy(dAtA[i:], m.LineKey)
+	}
+	if m.PlannedAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PlannedAt))
+	}
+	return i, nil
+}
+
+func (m *OpenIncidentMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenIncidentMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n70, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	if m.Severity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Severity))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.StartedAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StartedAt))
+	}
+	if m.ExpectedEndAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExpectedEndAt))
+	}
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *UpdateIncidentMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateIncidentMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n71, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	if len(m.IncidentKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.IncidentKey)))
+		i += copy(dAtA[i:], m.IncidentKey)
+	}
+	if m.Severity != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Severity))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.ExpectedEndAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExpectedEndAt))
+	}
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ResolveIncidentMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveIncidentMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n72, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	if len(m.IncidentKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.IncidentKey)))
+		i += copy(dAtA[i:], m.IncidentKey)
+	}
+	return i, nil
+}
+
+func (m *MarkTrainSilentMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkTrainSilentMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n73, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	if len(m.TrainKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TrainKey)))
+		i += copy(dAtA[i:], m.TrainKey)
+	}
+	return i, nil
+}
+
+func (m *ActiveIncidentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveIncidentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.StationKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StationKey)))
+		i += copy(dAtA[i:], m.StationKey)
+	}
+	if m.At != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.At))
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Station) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Station)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Escalator != 0 {
+		n += 1 + sovCodec(uint64(m.Escalator))
+	}
+	if m.Elevator != 0 {
+		n += 1 + sovCodec(uint64(m.Elevator))
+	}
+	if m.IsPeronAda {
+		n += 2
+	}
+	if m.TicketOffice != 0 {
+		n += 1 + sovCodec(uint64(m.TicketOffice))
+	}
+	if m.TollGateEnt != 0 {
+		n += 1 + sovCodec(uint64(m.TollGateEnt))
+	}
+	if m.TollGateEx != 0 {
+		n += 1 + sovCodec(uint64(m.TollGateEx))
+	}
+	if m.EntranceExit != 0 {
+		n += 1 + sovCodec(uint64(m.EntranceExit))
+	}
+	if len(m.Gates) > 0 {
+		for _, b := range m.Gates {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.RetiredAt != 0 {
+		n += 1 + sovCodec(uint64(m.RetiredAt))
+	}
+	return n
+}
+
+func (m *Train) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovCodec(uint64(m.Capacity))
+	}
+	if m.CarCount != 0 {
+		n += 1 + sovCodec(uint64(m.CarCount))
+	}
+	l = len(m.HomeDepot)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DecommissionedAt != 0 {
+		n += 1 + sovCodec(uint64(m.DecommissionedAt))
+	}
+	if m.SilentSince != 0 {
+		n += 1 + sovCodec(uint64(m.SilentSince))
+	}
+	l = len(m.WatchdogTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TrainChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovCodec(uint64(m.Kind))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PreviousAddress)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ChangedAt != 0 {
+		n += 1 + sovCodec(uint64(m.ChangedAt))
+	}
+	return n
+}
+
+func (m *Passenger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.RegisteredAt != 0 {
+		n += 1 + sovCodec(uint64(m.RegisteredAt))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Category != 0 {
+		n += 1 + sovCodec(uint64(m.Category))
+	}
+	if m.CategoryExpiresAt != 0 {
+		n += 1 + sovCodec(uint64(m.CategoryExpiresAt))
+	}
+	if m.DeregisteredAt != 0 {
+		n += 1 + sovCodec(uint64(m.DeregisteredAt))
+	}
+	return n
+}
+
+func (m *Line) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.FareCollector)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.FareAdmin)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CategoryIssuer)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.FleetManager)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Maintainers) > 0 {
+		for _, b := range m.Maintainers {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Operators) > 0 {
+		for _, b := range m.Operators {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.TrainSilenceMinutes != 0 {
+		n += 1 + sovCodec(uint64(m.TrainSilenceMinutes))
+	}
+	if m.MaxJourneyMinutes != 0 {
+		n += 1 + sovCodec(uint64(m.MaxJourneyMinutes))
+	}
+	if m.MaxFare != nil {
+		l = m.MaxFare.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CurrencyIssuer)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Equipment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovCodec(uint64(m.Type))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCodec(uint64(m.Status))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.UpdatedAt))
+	}
+	l = len(m.ReportedBy)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Connection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.FromStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ToStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.TravelMinutes != 0 {
+		n += 1 + sovCodec(uint64(m.TravelMinutes))
+	}
+	return n
+}
+
+func (m *RouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ToStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Accessible {
+		n += 2
+	}
+	return n
+}
+
+func (m *Route) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.TotalMinutes != 0 {
+		n += 1 + sovCodec(uint64(m.TotalMinutes))
+	}
+	return n
+}
+
+func (m *RouteHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Minutes != 0 {
+		n += 1 + sovCodec(uint64(m.Minutes))
+	}
+	return n
+}
+
+func (m *Timetable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.LineKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Service != 0 {
+		n += 1 + sovCodec(uint64(m.Service))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovCodec(uint64(m.Direction))
+	}
+	if len(m.Stops) > 0 {
+		for _, e := range m.Stops {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.PublishedAt != 0 {
+		n += 1 + sovCodec(uint64(m.PublishedAt))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SupersededAt != 0 {
+		n += 1 + sovCodec(uint64(m.SupersededAt))
+	}
+	return n
+}
+
+func (m *TimetableStop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Departures) > 0 {
+		l = 0
+		for _, e := range m.Departures {
+			l += sovCodec(uint64(e))
+		}
+		n += 1 + sovCodec(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DeparturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.At != 0 {
+		n += 1 + sovCodec(uint64(m.At))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCodec(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *Departures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Departures) > 0 {
+		for _, e := range m.Departures {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Departure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LineKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovCodec(uint64(m.Direction))
+	}
+	if m.DepartsAt != 0 {
+		n += 1 + sovCodec(uint64(m.DepartsAt))
+	}
+	return n
+}
+
+func (m *FareTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.BaseFare.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.StopFare.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.ZoneFare.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.TimeMultipliers) > 0 {
+		for _, e := range m.TimeMultipliers {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Discounts) > 0 {
+		for _, e := range m.Discounts {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.WeeklyPass != nil {
+		l = m.WeeklyPass.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MonthlyPass != nil {
+		l = m.MonthlyPass.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *FareChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.FareTable != nil {
+		l = m.FareTable.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ActivateAt != 0 {
+		n += 1 + sovCodec(uint64(m.ActivateAt))
+	}
+	l = len(m.ScheduledBy)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ActivatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.ActivatedAt))
+	}
+	return n
+}
+
+func (m *StationZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Zone != 0 {
+		n += 1 + sovCodec(uint64(m.Zone))
+	}
+	return n
+}
+
+func (m *TimeMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartMinute != 0 {
+		n += 1 + sovCodec(uint64(m.StartMinute))
+	}
+	if m.EndMinute != 0 {
+		n += 1 + sovCodec(uint64(m.EndMinute))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovCodec(uint64(m.Percent))
+	}
+	return n
+}
+
+func (m *Discount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Category != 0 {
+		n += 1 + sovCodec(uint64(m.Category))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovCodec(uint64(m.Percent))
+	}
+	return n
+}
+
+func (m *FareQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntryStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ExitStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Category != 0 {
+		n += 1 + sovCodec(uint64(m.Category))
+	}
+	if m.At != 0 {
+		n += 1 + sovCodec(uint64(m.At))
+	}
+	return n
+}
+
+func (m *ValidPassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.At != 0 {
+		n += 1 + sovCodec(uint64(m.At))
+	}
+	return n
+}
+
+func (m *FareQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fare.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Stops != 0 {
+		n += 1 + sovCodec(uint64(m.Stops))
+	}
+	if m.Zones != 0 {
+		n += 1 + sovCodec(uint64(m.Zones))
+	}
+	return n
+}
+
+func (m *Trip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EntryStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.EnteredAt != 0 {
+		n += 1 + sovCodec(uint64(m.EnteredAt))
+	}
+	l = len(m.ExitStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ExitedAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExitedAt))
+	}
+	if m.Fare != nil {
+		l = m.Fare.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Journey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EntryStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovCodec(uint64(m.StartedAt))
+	}
+	l = len(m.ExitStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.EndedAt != 0 {
+		n += 1 + sovCodec(uint64(m.EndedAt))
+	}
+	if m.Fare != nil {
+		l = m.Fare.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCodec(uint64(m.Status))
+	}
+	l = len(m.ExpiryTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Pass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovCodec(uint64(m.Kind))
+	}
+	if m.ValidFrom != 0 {
+		n += 1 + sovCodec(uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovCodec(uint64(m.ValidUntil))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.Released.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.CancelledAt != 0 {
+		n += 1 + sovCodec(uint64(m.CancelledAt))
+	}
+	return n
+}
+
+func (m *ScheduledStop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.LineKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PlannedAt != 0 {
+		n += 1 + sovCodec(uint64(m.PlannedAt))
+	}
+	return n
+}
+
+func (m *LineDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.RecentDelays) > 0 {
+		l = 0
+		for _, e := range m.RecentDelays {
+			l += sovCodec(uint64(e))
+		}
+		n += 1 + sovCodec(uint64(l)) + l
+	}
+	if m.AverageDelay != 0 {
+		n += 1 + sovCodec(uint64(m.AverageDelay))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *TrainPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ArrivedAt != 0 {
+		n += 1 + sovCodec(uint64(m.ArrivedAt))
+	}
+	l = len(m.PreviousStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.LineKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovCodec(uint64(m.Direction))
+	}
+	l = len(m.ArrivalKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DepartedAt != 0 {
+		n += 1 + sovCodec(uint64(m.DepartedAt))
+	}
+	return n
+}
+
+func (m *Incident) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovCodec(uint64(m.Severity))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovCodec(uint64(m.StartedAt))
+	}
+	if m.ExpectedEndAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExpectedEndAt))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.OpenedBy)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ResolvedAt != 0 {
+		n += 1 + sovCodec(uint64(m.ResolvedAt))
+	}
+	return n
+}
+
+func (m *LocalizedText) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Language)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TrainArriveStationEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ArrivedAt != 0 {
+		n += 1 + sovCodec(uint64(m.ArrivedAt))
+	}
+	l = len(m.ScheduledStopKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Delay != 0 {
+		n += 1 + sovCodec(uint64(m.Delay))
+	}
+	if len(m.IncidentKeys) > 0 {
+		for _, b := range m.IncidentKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TrainDepartStationEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArrivalKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DepartedAt != 0 {
+		n += 1 + sovCodec(uint64(m.DepartedAt))
+	}
+	if m.Dwell != 0 {
+		n += 1 + sovCodec(uint64(m.Dwell))
+	}
+	return n
+}
+
+func (m *RegisterPassengerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TrainArriveStationEventMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TrainDepartStationEventMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateLineMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateLineMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.LineKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TapInMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TapOutMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *StartJourneyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *EndJourneyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ExpireJourneyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.JourneyKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *PurchasePassMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovCodec(uint64(m.Kind))
+	}
+	if m.ValidFrom != 0 {
+		n += 1 + sovCodec(uint64(m.ValidFrom))
+	}
+	return n
+}
+
+func (m *CancelPassMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleasePassMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateFareTableMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.FareTable != nil {
+		l = m.FareTable.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleFareChangeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.FareTable != nil {
+		l = m.FareTable.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ActivateAt != 0 {
+		n += 1 + sovCodec(uint64(m.ActivateAt))
+	}
+	return n
+}
+
+func (m *ActivateFareChangeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.FareChangeKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SetPassengerCategoryMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Category != 0 {
+		n += 1 + sovCodec(uint64(m.Category))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *PayFareMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EntryStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ExitStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdatePassengerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *DeregisterPassengerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PassengerKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateStationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Station)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Escalator != 0 {
+		n += 1 + sovCodec(uint64(m.Escalator))
+	}
+	if m.Elevator != 0 {
+		n += 1 + sovCodec(uint64(m.Elevator))
+	}
+	if m.IsPeronAda {
+		n += 2
+	}
+	if m.TicketOffice != 0 {
+		n += 1 + sovCodec(uint64(m.TicketOffice))
+	}
+	if m.TollGateEnt != 0 {
+		n += 1 + sovCodec(uint64(m.TollGateEnt))
+	}
+	if m.TollGateEx != 0 {
+		n += 1 + sovCodec(uint64(m.TollGateEx))
+	}
+	if m.EntranceExit != 0 {
+		n += 1 + sovCodec(uint64(m.EntranceExit))
+	}
+	if len(m.Gates) > 0 {
+		for _, b := range m.Gates {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateStationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Station)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Escalator != 0 {
+		n += 1 + sovCodec(uint64(m.Escalator))
+	}
+	if m.Elevator != 0 {
+		n += 1 + sovCodec(uint64(m.Elevator))
+	}
+	if m.IsPeronAda {
+		n += 2
+	}
+	if m.TicketOffice != 0 {
+		n += 1 + sovCodec(uint64(m.TicketOffice))
+	}
+	if m.TollGateEnt != 0 {
+		n += 1 + sovCodec(uint64(m.TollGateEnt))
+	}
+	if m.TollGateEx != 0 {
+		n += 1 + sovCodec(uint64(m.TollGateEx))
+	}
+	if m.EntranceExit != 0 {
+		n += 1 + sovCodec(uint64(m.EntranceExit))
+	}
+	if len(m.Gates) > 0 {
+		for _, b := range m.Gates {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RetireStationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterTrainMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovCodec(uint64(m.Capacity))
+	}
+	if m.CarCount != 0 {
+		n += 1 + sovCodec(uint64(m.CarCount))
+	}
+	l = len(m.HomeDepot)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ReassignTrainMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *DecommissionTrainMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ReportEquipmentStatusMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovCodec(uint64(m.Type))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCodec(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *SetConnectionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.FromStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ToStationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.TravelMinutes != 0 {
+		n += 1 + sovCodec(uint64(m.TravelMinutes))
+	}
+	return n
+}
+
+func (m *PublishTimetableMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.LineKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Service != 0 {
+		n += 1 + sovCodec(uint64(m.Service))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovCodec(uint64(m.Direction))
+	}
+	if len(m.Stops) > 0 {
+		for _, e := range m.Stops {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupersedeTimetableMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TimetableKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Stops) > 0 {
+		for _, e := range m.Stops {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduleStopMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.LineKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PlannedAt != 0 {
+		n += 1 + sovCodec(uint64(m.PlannedAt))
+	}
+	return n
+}
+
+func (m *OpenIncidentMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovCodec(uint64(m.Severity))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovCodec(uint64(m.StartedAt))
+	}
+	if m.ExpectedEndAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExpectedEndAt))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateIncidentMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.IncidentKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovCodec(uint64(m.Severity))
+	}
+	if len(m.StationKeys) > 0 {
+		for _, b := range m.StationKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ExpectedEndAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExpectedEndAt))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResolveIncidentMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.IncidentKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *MarkTrainSilentMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TrainKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveIncidentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StationKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.At != 0 {
+		n += 1 + sovCodec(uint64(m.At))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Station) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Station: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Station: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Station", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Station = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escalator", wireType)
+			}
+			m.Escalator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Escalator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elevator", wireType)
+			}
+			m.Elevator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Elevator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPeronAda", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPeronAda = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketOffice", wireType)
+			}
+			m.TicketOffice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TicketOffice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TollGateEnt", wireType)
+			}
+			m.TollGateEnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TollGateEnt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TollGateEx", wireType)
+			}
+			m.TollGateEx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TollGateEx |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntranceExit", wireType)
+			}
+			m.EntranceExit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntranceExit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gates = append(m.Gates, make([]byte, postIndex-iNdEx))
+			copy(m.Gates[len(m.Gates)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAt", wireType)
+			}
+			m.RetiredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetiredAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Train) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Train: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Train: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarCount", wireType)
+			}
+			m.CarCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CarCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeDepot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HomeDepot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecommissionedAt", wireType)
+			}
+			m.DecommissionedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecommissionedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SilentSince", wireType)
+			}
+			m.SilentSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SilentSince |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchdogTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WatchdogTaskID = append(m.WatchdogTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.WatchdogTaskID == nil {
+				m.WatchdogTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= TrainChangeKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAddress = append(m.PreviousAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousAddress == nil {
+				m.PreviousAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
+			}
+			m.ChangedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Passenger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Passenger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Passenger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			m.RegisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= PassengerCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryExpiresAt", wireType)
+			}
+			m.CategoryExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryExpiresAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregisteredAt", wireType)
+			}
+			m.DeregisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeregisteredAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Line) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Line: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Line: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKeys = append(m.StationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.StationKeys[len(m.StationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FareCollector", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FareCollector = append(m.FareCollector[:0], dAtA[iNdEx:postIndex]...)
+			if m.FareCollector == nil {
+				m.FareCollector = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FareAdmin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FareAdmin = append(m.FareAdmin[:0], dAtA[iNdEx:postIndex]...)
+			if m.FareAdmin == nil {
+				m.FareAdmin = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryIssuer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryIssuer = append(m.CategoryIssuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.CategoryIssuer == nil {
+				m.CategoryIssuer = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FleetManager", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FleetManager = append(m.FleetManager[:0], dAtA[iNdEx:postIndex]...)
+			if m.FleetManager == nil {
+				m.FleetManager = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintainers = append(m.Maintainers, make([]byte, postIndex-iNdEx))
+			copy(m.Maintainers[len(m.Maintainers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, make([]byte, postIndex-iNdEx))
+			copy(m.Operators[len(m.Operators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainSilenceMinutes", wireType)
+			}
+			m.TrainSilenceMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrainSilenceMinutes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJourneyMinutes", wireType)
+			}
+			m.MaxJourneyMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxJourneyMinutes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxFare == nil {
+				m.MaxFare = &coin.Coin{}
+			}
+			if err := m.MaxFare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyIssuer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyIssuer = append(m.CurrencyIssuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrencyIssuer == nil {
+				m.CurrencyIssuer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Equipment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Equipment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Equipment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= EquipmentType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EquipmentStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportedBy = append(m.ReportedBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReportedBy == nil {
+				m.ReportedBy = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Connection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Connection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Connection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStationKey = append(m.FromStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromStationKey == nil {
+				m.FromStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStationKey = append(m.ToStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToStationKey == nil {
+				m.ToStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TravelMinutes", wireType)
+			}
+			m.TravelMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TravelMinutes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStationKey = append(m.FromStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromStationKey == nil {
+				m.FromStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStationKey = append(m.ToStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToStationKey == nil {
+				m.ToStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accessible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accessible = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Route) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, RouteHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinutes", wireType)
+			}
+			m.TotalMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMinutes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minutes", wireType)
+			}
+			m.Minutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minutes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Timetable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timetable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timetable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LineKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LineKey = append(m.LineKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LineKey == nil {
+				m.LineKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			m.Service = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Service |= ServicePattern(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stops = append(m.Stops, TimetableStop{})
+			if err := m.Stops[len(m.Stops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishedAt", wireType)
+			}
+			m.PublishedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = append(m.SupersededBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.SupersededBy == nil {
+				m.SupersededBy = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededAt", wireType)
+			}
+			m.SupersededAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupersededAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimetableStop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimetableStop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimetableStop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Departures = append(m.Departures, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCodec
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCodec
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Departures) == 0 {
+					m.Departures = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCodec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Departures = append(m.Departures, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Departures", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeparturesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeparturesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeparturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			m.At = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.At |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Departures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Departures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Departures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Departures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Departures = append(m.Departures, Departure{})
+			if err := m.Departures[len(m.Departures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Departure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Departure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Departure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LineKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LineKey = append(m.LineKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LineKey == nil {
+				m.LineKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartsAt", wireType)
+			}
+			m.DepartsAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepartsAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FareTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FareTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FareTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopFare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopFare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneFare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ZoneFare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, StationZone{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeMultipliers = append(m.TimeMultipliers, TimeMultiplier{})
+			if err := m.TimeMultipliers[len(m.TimeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discounts = append(m.Discounts, Discount{})
+			if err := m.Discounts[len(m.Discounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyPass", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeeklyPass == nil {
+				m.WeeklyPass = &coin.Coin{}
+			}
+			if err := m.WeeklyPass.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyPass", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MonthlyPass == nil {
+				m.MonthlyPass = &coin.Coin{}
+			}
+			if err := m.MonthlyPass.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FareChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FareChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FareChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FareTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FareTable == nil {
+				m.FareTable = &FareTable{}
+			}
+			if err := m.FareTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivateAt", wireType)
+			}
+			m.ActivateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivateAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledBy = append(m.ScheduledBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.ScheduledBy == nil {
+				m.ScheduledBy = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
+			}
+			m.ActivatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StationZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StationZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StationZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			m.Zone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Zone |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMinute", wireType)
+			}
+			m.StartMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartMinute |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndMinute", wireType)
+			}
+			m.EndMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndMinute |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Discount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Discount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Discount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= PassengerCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FareQuoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FareQuoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FareQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryStationKey = append(m.EntryStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EntryStationKey == nil {
+				m.EntryStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitStationKey = append(m.ExitStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExitStationKey == nil {
+				m.ExitStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= PassengerCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			m.At = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.At |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidPassRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidPassRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidPassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			m.At = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.At |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FareQuote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FareQuote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FareQuote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stops", wireType)
+			}
+			m.Stops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			m.Zones = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Zones |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trip) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryStationKey = append(m.EntryStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EntryStationKey == nil {
+				m.EntryStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnteredAt", wireType)
+			}
+			m.EnteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnteredAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitStationKey = append(m.ExitStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExitStationKey == nil {
+				m.ExitStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitedAt", wireType)
+			}
+			m.ExitedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fare == nil {
+				m.Fare = &coin.Coin{}
+			}
+			if err := m.Fare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Journey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Journey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Journey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryStationKey = append(m.EntryStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EntryStationKey == nil {
+				m.EntryStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitStationKey = append(m.ExitStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExitStationKey == nil {
+				m.ExitStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedAt", wireType)
+			}
+			m.EndedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fare == nil {
+				m.Fare = &coin.Coin{}
+			}
+			if err := m.Fare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= JourneyStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryTaskID = append(m.ExpiryTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExpiryTaskID == nil {
+				m.ExpiryTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = append(m.Holder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Holder == nil {
+				m.Holder = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PassKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			m.ValidFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidFrom |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Released.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAt", wireType)
+			}
+			m.CancelledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledStop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledStop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledStop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LineKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LineKey = append(m.LineKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LineKey == nil {
+				m.LineKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlannedAt", wireType)
+			}
+			m.PlannedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlannedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LineDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LineDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LineDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RecentDelays = append(m.RecentDelays, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCodec
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCodec
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RecentDelays) == 0 {
+					m.RecentDelays = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCodec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RecentDelays = append(m.RecentDelays, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentDelays", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDelay", wireType)
+			}
+			m.AverageDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrivedAt", wireType)
+			}
+			m.ArrivedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArrivedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousStationKey = append(m.PreviousStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousStationKey == nil {
+				m.PreviousStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LineKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LineKey = append(m.LineKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LineKey == nil {
+				m.LineKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrivalKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArrivalKey = append(m.ArrivalKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArrivalKey == nil {
+				m.ArrivalKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartedAt", wireType)
+			}
+			m.DepartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepartedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Incident) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Incident: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Incident: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= IncidentSeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKeys = append(m.StationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.StationKeys[len(m.StationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedEndAt", wireType)
+			}
+			m.ExpectedEndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedEndAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, LocalizedText{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenedBy = append(m.OpenedBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.OpenedBy == nil {
+				m.OpenedBy = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			m.ResolvedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalizedText) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalizedText: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalizedText: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Language = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainArriveStationEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainArriveStationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrivedAt", wireType)
+			}
+			m.ArrivedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArrivedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledStopKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledStopKey = append(m.ScheduledStopKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ScheduledStopKey == nil {
+				m.ScheduledStopKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			m.Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncidentKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncidentKeys = append(m.IncidentKeys, make([]byte, postIndex-iNdEx))
+			copy(m.IncidentKeys[len(m.IncidentKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainDepartStationEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainDepartStationEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainDepartStationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrivalKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArrivalKey = append(m.ArrivalKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArrivalKey == nil {
+				m.ArrivalKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartedAt", wireType)
+			}
+			m.DepartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepartedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dwell", wireType)
+			}
+			m.Dwell = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dwell |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterPassengerMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterPassengerMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterPassengerMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainArriveStationEventMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainArriveStationEventMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainArriveStationEventMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainDepartStationEventMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainDepartStationEventMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainDepartStationEventMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateLineMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateLineMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateLineMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKeys = append(m.StationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.StationKeys[len(m.StationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateLineMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateLineMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateLineMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LineKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LineKey = append(m.LineKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LineKey == nil {
+				m.LineKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKeys = append(m.StationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.StationKeys[len(m.StationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TapInMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TapInMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TapInMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TapOutMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TapOutMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TapOutMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartJourneyMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartJourneyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartJourneyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndJourneyMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndJourneyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndJourneyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpireJourneyMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpireJourneyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpireJourneyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JourneyKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JourneyKey = append(m.JourneyKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.JourneyKey == nil {
+				m.JourneyKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchasePassMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchasePassMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchasePassMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PassKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			m.ValidFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidFrom |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelPassMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelPassMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelPassMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassKey = append(m.PassKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassKey == nil {
+				m.PassKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleasePassMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleasePassMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleasePassMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassKey = append(m.PassKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassKey == nil {
+				m.PassKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateFareTableMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateFareTableMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateFareTableMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FareTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FareTable == nil {
+				m.FareTable = &FareTable{}
+			}
+			if err := m.FareTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleFareChangeMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleFareChangeMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleFareChangeMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FareTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FareTable == nil {
+				m.FareTable = &FareTable{}
+			}
+			if err := m.FareTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivateAt", wireType)
+			}
+			m.ActivateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivateAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateFareChangeMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateFareChangeMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateFareChangeMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FareChangeKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FareChangeKey = append(m.FareChangeKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.FareChangeKey == nil {
+				m.FareChangeKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPassengerCategoryMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPassengerCategoryMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPassengerCategoryMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= PassengerCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayFareMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayFareMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayFareMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryStationKey = append(m.EntryStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EntryStationKey == nil {
+				m.EntryStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitStationKey = append(m.ExitStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExitStationKey == nil {
+				m.ExitStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePassengerMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePassengerMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePassengerMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterPassengerMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterPassengerMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterPassengerMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassengerKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassengerKey = append(m.PassengerKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PassengerKey == nil {
+				m.PassengerKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateStationMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateStationMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateStationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Station", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Station = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escalator", wireType)
+			}
+			m.Escalator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Escalator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elevator", wireType)
+			}
+			m.Elevator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Elevator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPeronAda", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPeronAda = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketOffice", wireType)
+			}
+			m.TicketOffice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TicketOffice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TollGateEnt", wireType)
+			}
+			m.TollGateEnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TollGateEnt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TollGateEx", wireType)
+			}
+			m.TollGateEx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TollGateEx |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntranceExit", wireType)
+			}
+			m.EntranceExit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntranceExit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gates = append(m.Gates, make([]byte, postIndex-iNdEx))
+			copy(m.Gates[len(m.Gates)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStationMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStationMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Station", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Station = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escalator", wireType)
+			}
+			m.Escalator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Escalator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elevator", wireType)
+			}
+			m.Elevator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Elevator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPeronAda", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPeronAda = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketOffice", wireType)
+			}
+			m.TicketOffice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TicketOffice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TollGateEnt", wireType)
+			}
+			m.TollGateEnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TollGateEnt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TollGateEx", wireType)
+			}
+			m.TollGateEx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TollGateEx |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntranceExit", wireType)
+			}
+			m.EntranceExit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntranceExit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gates = append(m.Gates, make([]byte, postIndex-iNdEx))
+			copy(m.Gates[len(m.Gates)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetireStationMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetireStationMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetireStationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterTrainMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterTrainMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterTrainMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarCount", wireType)
+			}
+			m.CarCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CarCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeDepot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HomeDepot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReassignTrainMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReassignTrainMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReassignTrainMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecommissionTrainMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecommissionTrainMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecommissionTrainMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportEquipmentStatusMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportEquipmentStatusMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportEquipmentStatusMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= EquipmentType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EquipmentStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetConnectionMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetConnectionMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetConnectionMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStationKey = append(m.FromStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromStationKey == nil {
+				m.FromStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStationKey = append(m.ToStationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToStationKey == nil {
+				m.ToStationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TravelMinutes", wireType)
+			}
+			m.TravelMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TravelMinutes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Station) Unmarshal(dAtA []byte) error {
+func (m *PublishTimetableMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1016,10 +23722,10 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Station: wiretype end group for non-group")
+			return fmt.Errorf("proto: PublishTimetableMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Station: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PublishTimetableMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1060,7 +23766,7 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LineKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1087,16 +23793,16 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrimaryKey == nil {
-				m.PrimaryKey = []byte{}
+			m.LineKey = append(m.LineKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LineKey == nil {
+				m.LineKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Station", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var stringLen uint64
+			m.Service = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1106,29 +23812,16 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Service |= ServicePattern(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Station = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Escalator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-			m.Escalator = 0
+			m.Direction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1138,16 +23831,16 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Escalator |= int64(b&0x7F) << shift
+				m.Direction |= Direction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elevator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stops", wireType)
 			}
-			m.Elevator = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1157,55 +23850,84 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Elevator |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPeronAda", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.IsPeronAda = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TicketOffice", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TicketOffice = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TicketOffice |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Stops = append(m.Stops, TimetableStop{})
+			if err := m.Stops[len(m.Stops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TollGateEnt", wireType)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TollGateEnt = 0
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupersedeTimetableMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupersedeTimetableMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupersedeTimetableMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1215,16 +23937,33 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TollGateEnt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TollGateEx", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.TollGateEx = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimetableKey", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1234,16 +23973,31 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TollGateEx |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntranceExit", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.EntranceExit = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimetableKey = append(m.TimetableKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TimetableKey == nil {
+				m.TimetableKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stops", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1253,11 +24007,26 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EntranceExit |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stops = append(m.Stops, TimetableStop{})
+			if err := m.Stops[len(m.Stops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1282,7 +24051,7 @@ func (m *Station) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Train) Unmarshal(dAtA []byte) error {
+func (m *ScheduleStopMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1305,10 +24074,10 @@ func (m *Train) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Train: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleStopMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Train: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleStopMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1349,7 +24118,41 @@ func (m *Train) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1376,14 +24179,14 @@ func (m *Train) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrimaryKey == nil {
-				m.PrimaryKey = []byte{}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LineKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1410,11 +24213,30 @@ func (m *Train) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.LineKey = append(m.LineKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LineKey == nil {
+				m.LineKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlannedAt", wireType)
+			}
+			m.PlannedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlannedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1439,7 +24261,7 @@ func (m *Train) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Passenger) Unmarshal(dAtA []byte) error {
+func (m *OpenIncidentMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1462,10 +24284,10 @@ func (m *Passenger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Passenger: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenIncidentMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Passenger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenIncidentMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1505,10 +24327,10 @@ func (m *Passenger) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
 			}
-			var byteLen int
+			m.Severity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1518,29 +24340,14 @@ func (m *Passenger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Severity |= IncidentSeverity(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrimaryKey == nil {
-				m.PrimaryKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1567,16 +24374,14 @@ func (m *Passenger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.StationKeys = append(m.StationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.StationKeys[len(m.StationKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			m.RegisteredAt = 0
+			m.StartedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1586,16 +24391,35 @@ func (m *Passenger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegisteredAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				m.StartedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedEndAt", wireType)
+			}
+			m.ExpectedEndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedEndAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1605,23 +24429,25 @@ func (m *Passenger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, LocalizedText{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1647,7 +24473,7 @@ func (m *Passenger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
+func (m *UpdateIncidentMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1670,10 +24496,10 @@ func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrainArriveStationEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateIncidentMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrainArriveStationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateIncidentMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1714,7 +24540,7 @@ func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncidentKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1741,16 +24567,16 @@ func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrimaryKey == nil {
-				m.PrimaryKey = []byte{}
+			m.IncidentKey = append(m.IncidentKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.IncidentKey == nil {
+				m.IncidentKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
 			}
-			var byteLen int
+			m.Severity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1760,29 +24586,14 @@ func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Severity |= IncidentSeverity(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StationKey == nil {
-				m.StationKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1809,16 +24620,14 @@ func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.TrainKey == nil {
-				m.TrainKey = []byte{}
-			}
+			m.StationKeys = append(m.StationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.StationKeys[len(m.StationKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArrivedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedEndAt", wireType)
 			}
-			m.ArrivedAt = 0
+			m.ExpectedEndAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1828,11 +24637,45 @@ func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ArrivedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				m.ExpectedEndAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, LocalizedText{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1857,7 +24700,7 @@ func (m *TrainArriveStationEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterPassengerMsg) Unmarshal(dAtA []byte) error {
+func (m *ResolveIncidentMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1880,10 +24723,10 @@ func (m *RegisterPassengerMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterPassengerMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResolveIncidentMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterPassengerMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResolveIncidentMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1924,9 +24767,9 @@ func (m *RegisterPassengerMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncidentKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1936,23 +24779,25 @@ func (m *RegisterPassengerMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.IncidentKey = append(m.IncidentKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.IncidentKey == nil {
+				m.IncidentKey = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1978,7 +24823,7 @@ func (m *RegisterPassengerMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrainArriveStationEventMsg) Unmarshal(dAtA []byte) error {
+func (m *MarkTrainSilentMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2001,10 +24846,10 @@ func (m *TrainArriveStationEventMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrainArriveStationEventMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarkTrainSilentMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrainArriveStationEventMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarkTrainSilentMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2045,7 +24890,7 @@ func (m *TrainArriveStationEventMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2072,14 +24917,67 @@ func (m *TrainArriveStationEventMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StationKey == nil {
-				m.StationKey = []byte{}
+			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrainKey == nil {
+				m.TrainKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveIncidentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveIncidentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveIncidentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrainKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StationKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2106,11 +25004,30 @@ func (m *TrainArriveStationEventMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrainKey = append(m.TrainKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.TrainKey == nil {
-				m.TrainKey = []byte{}
+			m.StationKey = append(m.StationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StationKey == nil {
+				m.StationKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			m.At = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.At |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -0,0 +1,56 @@
+package metro
+
+import (
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+// Validate ensures the Configuration is valid
+func (c *Configuration) Validate() error {
+	var errs error
+	// Owner field is optional.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	errs = errors.AppendField(errs, "Admin", c.Admin.Validate())
+	// FareCollector field is optional, as long as there is no fare to collect.
+	if len(c.FareCollector) != 0 {
+		errs = errors.AppendField(errs, "FareCollector", c.FareCollector.Validate())
+	}
+	// FareAdmin field is optional.
+	if len(c.FareAdmin) != 0 {
+		errs = errors.AppendField(errs, "FareAdmin", c.FareAdmin.Validate())
+	}
+	// CategoryIssuer field is optional.
+	if len(c.CategoryIssuer) != 0 {
+		errs = errors.AppendField(errs, "CategoryIssuer", c.CategoryIssuer.Validate())
+	}
+	// FleetManager field is optional.
+	if len(c.FleetManager) != 0 {
+		errs = errors.AppendField(errs, "FleetManager", c.FleetManager.Validate())
+	}
+	// CurrencyIssuer field is optional.
+	if len(c.CurrencyIssuer) != 0 {
+		errs = errors.AppendField(errs, "CurrencyIssuer", c.CurrencyIssuer.Validate())
+	}
+	for _, m := range c.Maintainers {
+		errs = errors.AppendField(errs, "Maintainers", m.Validate())
+	}
+	for _, o := range c.Operators {
+		errs = errors.AppendField(errs, "Operators", o.Validate())
+	}
+	// MaxFare field is optional, expired journeys are free without it.
+	if c.MaxFare != nil {
+		errs = errors.AppendField(errs, "MaxFare", validateFare(*c.MaxFare))
+	}
+	return errs
+}
+
+// loadConf returns the metro configuration stored in gconf.
+func loadConf(db gconf.ReadStore) (*Configuration, error) {
+	var conf Configuration
+	if err := gconf.Load(db, packageName, &conf); err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	return &conf, nil
+}
@@ -0,0 +1,87 @@
+package metro
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// lineDelayWindow is the number of most recent arrivals a line delay
+	// aggregate is computed from.
+	lineDelayWindow = 20
+)
+
+// Tags emitted with every arrival matched with a scheduled stop. Late trains
+// can be found with a query like "metro.delay > 120".
+const (
+	// DelayTag holds the delay of the arrival in seconds.
+	DelayTag = "metro.delay"
+	// DelayLineTag holds the hex encoded key of the line of the arrival.
+	DelayLineTag = "metro.line"
+)
+
+// nearestScheduledStop returns the stop planned for given train at given
+// station that is closest to the arrival time, or nil if there is none.
+func nearestScheduledStop(db weave.ReadOnlyKVStore, stops orm.SerialModelBucket, trainKey, stationKey []byte, arrivedAt weave.UnixTime) (*ScheduledStop, error) {
+	var planned []ScheduledStop
+	if err := stops.ByIndex(db, "train_station", TrainStationIndexKey(trainKey, stationKey), &planned); err != nil {
+		return nil, errors.Wrap(err, "cannot load scheduled stops")
+	}
+	var nearest *ScheduledStop
+	for i, s := range planned {
+		if nearest == nil || absDelay(arrivedAt, s.PlannedAt) < absDelay(arrivedAt, nearest.PlannedAt) {
+			nearest = &planned[i]
+		}
+	}
+	return nearest, nil
+}
+
+func absDelay(arrivedAt, plannedAt weave.UnixTime) int64 {
+	d := int64(arrivedAt - plannedAt)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// recordLineDelay adds the delay of an arrival to the rolling delay aggregate
+// of given line.
+func recordLineDelay(db weave.KVStore, delays orm.ModelBucket, lineKey []byte, delay int64, now weave.UnixTime) error {
+	var ld LineDelay
+	switch err := delays.One(db, lineKey, &ld); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		ld = LineDelay{Metadata: &weave.Metadata{Schema: 1}}
+	default:
+		return errors.Wrap(err, "cannot load line delay")
+	}
+
+	ld.RecentDelays = append(ld.RecentDelays, delay)
+	if n := len(ld.RecentDelays); n > lineDelayWindow {
+		ld.RecentDelays = ld.RecentDelays[n-lineDelayWindow:]
+	}
+	var total int64
+	for _, d := range ld.RecentDelays {
+		total += d
+	}
+	ld.AverageDelay = total / int64(len(ld.RecentDelays))
+	ld.UpdatedAt = now
+
+	if _, err := delays.Put(db, lineKey, &ld); err != nil {
+		return errors.Wrap(err, "cannot store line delay")
+	}
+	return nil
+}
+
+// delayTags returns the ABCI tags announcing the delay of an arrival.
+func delayTags(lineKey []byte, delay int64) []common.KVPair {
+	return []common.KVPair{
+		{Key: []byte(DelayTag), Value: []byte(strconv.FormatInt(delay, 10))},
+		{Key: []byte(DelayLineTag), Value: []byte(hex.EncodeToString(lineKey))},
+	}
+}
@@ -0,0 +1,124 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestArrivalDelay(t *testing.T) {
+	admin := weavetest.NewCondition()
+	trainSigner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Admin:    admin.Address(),
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "c"},
+	)
+	saveAll(t, db, NewTrainBucket(), &Train{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  trainSigner.Address(),
+	})
+	saveAll(t, db, NewLineBucket(), &Line{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Name:        "M1",
+		Color:       "#e30613",
+		StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+	})
+
+	planned := time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)
+	schedule := NewScheduleStopHandler(&weavetest.Auth{Signer: admin})
+	scheduleAt := func(station uint64, at time.Time) error {
+		_, err := schedule.Deliver(context.Background(), db, &weavetest.Tx{Msg: &ScheduleStopMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			TrainKey:   weavetest.SequenceID(1),
+			StationKey: weavetest.SequenceID(station),
+			LineKey:    weavetest.SequenceID(1),
+			PlannedAt:  weave.AsUnixTime(at),
+		}})
+		return err
+	}
+	if err := scheduleAt(1, planned); err != nil {
+		t.Fatalf("cannot schedule stop: %+v", err)
+	}
+	if err := scheduleAt(1, planned.Add(time.Hour)); err != nil {
+		t.Fatalf("cannot schedule stop: %+v", err)
+	}
+	if err := scheduleAt(3, planned); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for a station off the line, got %+v", err)
+	}
+
+	arrive := NewTrainArriveStationEventHandler(&weavetest.Auth{Signer: trainSigner}, &weavetest.Cron{})
+	arriveAt := func(station uint64, at time.Time) (*TrainArriveStationEvent, *weave.DeliverResult) {
+		t.Helper()
+		ctx := weave.WithBlockTime(context.Background(), at)
+		res, err := arrive.Deliver(ctx, db, &weavetest.Tx{Msg: &TrainArriveStationEventMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			StationKey: weavetest.SequenceID(station),
+			TrainKey:   weavetest.SequenceID(1),
+		}})
+		if err != nil {
+			t.Fatalf("cannot arrive: %+v", err)
+		}
+		var event TrainArriveStationEvent
+		if err := NewTrainArriveStationEventBucket().ByID(db, res.Data, &event); err != nil {
+			t.Fatalf("cannot load stored event: %s", err)
+		}
+		return &event, res
+	}
+
+	// Nearest to the first planned stop, three minutes late.
+	event, res := arriveAt(1, planned.Add(3*time.Minute))
+	if string(event.ScheduledStopKey) != string(weavetest.SequenceID(1)) {
+		t.Fatalf("want first stop matched, got %x", event.ScheduledStopKey)
+	}
+	if event.Delay != 180 {
+		t.Fatalf("want 180 seconds delay, got %d", event.Delay)
+	}
+	if len(res.Tags) != 2 || string(res.Tags[0].Key) != DelayTag || string(res.Tags[0].Value) != "180" {
+		t.Fatalf("unexpected tags: %+v", res.Tags)
+	}
+
+	// Nearest to the second planned stop, one minute early.
+	event, _ = arriveAt(1, planned.Add(59*time.Minute))
+	if string(event.ScheduledStopKey) != string(weavetest.SequenceID(2)) || event.Delay != -60 {
+		t.Fatalf("want second stop matched one minute early, got %+v", event)
+	}
+
+	// Nothing is planned at the second station.
+	event, res = arriveAt(2, planned.Add(2*time.Hour))
+	if len(event.ScheduledStopKey) != 0 || event.Delay != 0 || len(res.Tags) != 0 {
+		t.Fatalf("unscheduled arrival must not be matched: %+v, %+v", event, res.Tags)
+	}
+
+	var ld LineDelay
+	if err := NewLineDelayBucket().One(db, weavetest.SequenceID(1), &ld); err != nil {
+		t.Fatalf("cannot load line delay: %s", err)
+	}
+	if len(ld.RecentDelays) != 2 || ld.AverageDelay != 60 {
+		t.Fatalf("unexpected line delay: %+v", ld)
+	}
+
+	for i := 0; i < lineDelayWindow; i++ {
+		arriveAt(1, planned.Add(time.Hour))
+	}
+	if err := NewLineDelayBucket().One(db, weavetest.SequenceID(1), &ld); err != nil {
+		t.Fatalf("cannot load line delay: %s", err)
+	}
+	if len(ld.RecentDelays) != lineDelayWindow || ld.AverageDelay != 0 {
+		t.Fatalf("old delays must leave the window: %+v", ld)
+	}
+}
@@ -19,7 +19,39 @@ func (m *TrainArriveStationEvent) Validate() error {
 
 	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
 	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	// ScheduledStopKey is optional.
+	if len(m.ScheduledStopKey) != 0 {
+		errs = errors.AppendField(errs, "ScheduledStopKey", orm.ValidateSequence(m.ScheduledStopKey))
+	}
+	for _, k := range m.IncidentKeys {
+		errs = errors.AppendField(errs, "IncidentKeys", orm.ValidateSequence(k))
+	}
 
 	// validate data
 	return errs
 }
+
+var _ orm.SerialModel = (*TrainDepartStationEvent)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *TrainDepartStationEvent) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates departure event fields
+func (m *TrainDepartStationEvent) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+	errs = errors.AppendField(errs, "ArrivalKey", orm.ValidateSequence(m.ArrivalKey))
+	errs = errors.AppendField(errs, "DepartedAt", m.DepartedAt.Validate())
+	if m.Dwell < 0 {
+		errs = errors.AppendField(errs, "Dwell", errors.Wrap(errors.ErrInput, "must not be negative"))
+	}
+
+	return errs
+}
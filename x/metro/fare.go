@@ -0,0 +1,223 @@
+package metro
+
+import (
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// fareTableKey is the key under which the only fare table is stored.
+var fareTableKey = []byte("current")
+
+// FareChangeCondition returns the condition that authorizes activating given
+// fare change. It is only ever granted to the scheduled cron task.
+func FareChangeCondition(fareChangeKey []byte) weave.Condition {
+	return weave.NewCondition(packageName, "farechange", fareChangeKey)
+}
+
+// loadFareTable returns the fare table currently in use.
+func loadFareTable(db weave.ReadOnlyKVStore, fares orm.ModelBucket) (*FareTable, error) {
+	var table FareTable
+	if err := fares.One(db, fareTableKey, &table); err != nil {
+		return nil, errors.Wrap(err, "cannot load fare table")
+	}
+	return &table, nil
+}
+
+// quoteFare returns the price of a trip between two stations, started at given
+// time by a passenger of given category.
+func quoteFare(db weave.ReadOnlyKVStore, table *FareTable, entry, exit []byte, category PassengerCategory, at weave.UnixTime) (*FareQuote, error) {
+	stops, err := stopsBetween(db, entry, exit)
+	if err != nil {
+		return nil, err
+	}
+	zones := zoneDistance(table.zoneOf(entry), table.zoneOf(exit))
+
+	stopFare, err := table.StopFare.Multiply(int64(stops))
+	if err != nil {
+		return nil, errors.Wrap(err, "stop fare")
+	}
+	zoneFare, err := table.ZoneFare.Multiply(int64(zones))
+	if err != nil {
+		return nil, errors.Wrap(err, "zone fare")
+	}
+	fare, err := table.BaseFare.Add(stopFare)
+	if err != nil {
+		return nil, errors.Wrap(err, "stop fare")
+	}
+	if fare, err = fare.Add(zoneFare); err != nil {
+		return nil, errors.Wrap(err, "zone fare")
+	}
+	if fare, err = percentOf(fare, table.timePercent(at.Time())); err != nil {
+		return nil, errors.Wrap(err, "time multiplier")
+	}
+	if fare, err = percentOf(fare, 100-table.discountPercent(category)); err != nil {
+		return nil, errors.Wrap(err, "discount")
+	}
+
+	return &FareQuote{
+		Fare:  fare,
+		Stops: uint32(stops),
+		Zones: zones,
+	}, nil
+}
+
+// zoneOf returns the zone of given station.
+func (t *FareTable) zoneOf(stationKey []byte) uint32 {
+	for _, z := range t.Zones {
+		if string(z.StationKey) == string(stationKey) {
+			return z.Zone
+		}
+	}
+	return 0
+}
+
+// timePercent returns the percent of the fare charged for trips started at
+// given time.
+func (t *FareTable) timePercent(at time.Time) uint32 {
+	at = at.UTC()
+	minute := uint32(at.Hour()*60 + at.Minute())
+	for _, m := range t.TimeMultipliers {
+		if m.StartMinute <= minute && minute < m.EndMinute {
+			return m.Percent
+		}
+	}
+	return 100
+}
+
+// discountPercent returns the percent of the fare that passengers of given
+// category are not charged.
+func (t *FareTable) discountPercent(category PassengerCategory) uint32 {
+	for _, d := range t.Discounts {
+		if d.Category == category {
+			return d.Percent
+		}
+	}
+	return 0
+}
+
+func zoneDistance(a, b uint32) uint32 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// percentOf returns given percent of the amount, rounded down.
+func percentOf(amount coin.Coin, percent uint32) (coin.Coin, error) {
+	if percent == 100 {
+		return amount, nil
+	}
+	scaled, err := amount.Multiply(int64(percent))
+	if err != nil {
+		return coin.Coin{}, err
+	}
+	res, _, err := scaled.Divide(100)
+	return res, err
+}
+
+// stopsBetween returns the smallest number of stops a passenger must travel
+// to get from one station to another, changing lines where they cross.
+func stopsBetween(db weave.ReadOnlyKVStore, from, to []byte) (int, error) {
+	if string(from) == string(to) {
+		return 0, nil
+	}
+
+	graph, err := loadNetwork(db)
+	if err != nil {
+		return 0, err
+	}
+
+	stops := map[string]int{string(from): 0}
+	queue := []string{string(from)}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range graph[current] {
+			if _, ok := stops[next]; ok {
+				continue
+			}
+			stops[next] = stops[current] + 1
+			if next == string(to) {
+				return stops[next], nil
+			}
+			queue = append(queue, next)
+		}
+	}
+	return 0, errors.Wrap(errors.ErrInput, "stations are not connected")
+}
+
+// loadNetwork returns the station adjacency list built from all lines. Two
+// stations are adjacent if they follow each other on any line.
+func loadNetwork(db weave.ReadOnlyKVStore) (map[string][]string, error) {
+	it, err := NewLineBucket().PrefixScan(db, nil, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot scan lines")
+	}
+	defer it.Release()
+
+	graph := make(map[string][]string)
+	for {
+		var line Line
+		switch err := it.LoadNext(&line); {
+		case err == nil:
+		case errors.ErrIteratorDone.Is(err):
+			return graph, nil
+		default:
+			return nil, errors.Wrap(err, "cannot load line")
+		}
+		for i := 1; i < len(line.StationKeys); i++ {
+			a, b := string(line.StationKeys[i-1]), string(line.StationKeys[i])
+			graph[a] = append(graph[a], b)
+			graph[b] = append(graph[b], a)
+		}
+	}
+}
+
+// fareQuoteQuery handles the /farequote query path. Query data must be a
+// serialized FareQuoteRequest.
+type fareQuoteQuery struct {
+	fares orm.ModelBucket
+}
+
+var _ weave.QueryHandler = fareQuoteQuery{}
+
+func (q fareQuoteQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrInput, "only key queries are supported")
+	}
+	var req FareQuoteRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal request")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+	table, err := loadFareTable(db, q.fares)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := quoteFare(db, table, req.EntryStationKey, req.ExitStationKey, req.Category, req.At)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := quote.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal quote")
+	}
+	return []weave.Model{weave.Pair(data, raw)}, nil
+}
+
+// Validate ensures the FareQuoteRequest is valid
+func (r *FareQuoteRequest) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "EntryStationKey", orm.ValidateSequence(r.EntryStationKey))
+	errs = errors.AppendField(errs, "ExitStationKey", orm.ValidateSequence(r.ExitStationKey))
+	errs = errors.AppendField(errs, "At", r.At.Validate())
+
+	return errs
+}
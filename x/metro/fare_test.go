@@ -0,0 +1,164 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestQuoteFare(t *testing.T) {
+	db := store.MemStore()
+	saveAll(t, db, NewLineBucket(), &Line{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Name:        "M1",
+		Color:       "#e30613",
+		StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(3)},
+	})
+
+	table := &FareTable{
+		Metadata: &weave.Metadata{Schema: 1},
+		BaseFare: coin.NewCoin(1, 0, "METR"),
+		StopFare: coin.NewCoin(0, 500000000, "METR"),
+		ZoneFare: coin.NewCoin(2, 0, "METR"),
+		Zones: []StationZone{
+			{StationKey: weavetest.SequenceID(3), Zone: 1},
+		},
+		TimeMultipliers: []TimeMultiplier{
+			{StartMinute: 7 * 60, EndMinute: 9 * 60, Percent: 150},
+		},
+		Discounts: []Discount{
+			{Category: CategoryStudent, Percent: 50},
+		},
+	}
+	if err := table.Validate(); err != nil {
+		t.Fatalf("invalid fare table: %s", err)
+	}
+
+	offPeak := weave.AsUnixTime(time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC))
+	peak := weave.AsUnixTime(time.Date(2019, 1, 1, 8, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		exit     uint64
+		category PassengerCategory
+		at       weave.UnixTime
+		want     coin.Coin
+	}{
+		"single stop within a zone": {
+			exit: 2,
+			at:   offPeak,
+			want: coin.NewCoin(1, 500000000, "METR"),
+		},
+		"crossing a zone boundary": {
+			exit: 3,
+			at:   offPeak,
+			want: coin.NewCoin(4, 0, "METR"),
+		},
+		"peak hours": {
+			exit: 3,
+			at:   peak,
+			want: coin.NewCoin(6, 0, "METR"),
+		},
+		"student discount": {
+			exit:     3,
+			category: CategoryStudent,
+			at:       offPeak,
+			want:     coin.NewCoin(2, 0, "METR"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			quote, err := quoteFare(db, table, weavetest.SequenceID(1), weavetest.SequenceID(tc.exit), tc.category, tc.at)
+			if err != nil {
+				t.Fatalf("cannot quote fare: %s", err)
+			}
+			if !quote.Fare.Equals(tc.want) {
+				t.Fatalf("want %v fare, got %v", tc.want, quote.Fare)
+			}
+		})
+	}
+}
+
+func TestScheduleFareChange(t *testing.T) {
+	fareAdmin := weavetest.NewCondition()
+	now := time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		signer     weave.Condition
+		activateAt time.Time
+		wantErr    *errors.Error
+	}{
+		"fare admin announces a change": {
+			signer:     fareAdmin,
+			activateAt: now.Add(30 * 24 * time.Hour),
+		},
+		"signer is not the fare admin": {
+			signer:     weavetest.NewCondition(),
+			activateAt: now.Add(30 * 24 * time.Hour),
+			wantErr:    errors.ErrUnauthorized,
+		},
+		"activation time already passed": {
+			signer:     fareAdmin,
+			activateAt: now,
+			wantErr:    errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			if err := gconf.Save(db, "metro", &Configuration{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Admin:     weavetest.NewCondition().Address(),
+				FareAdmin: fareAdmin.Address(),
+			}); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+
+			var scheduler weavetest.Cron
+			h := NewScheduleFareChangeHandler(&weavetest.Auth{Signer: tc.signer}, &scheduler)
+			ctx := weave.WithBlockTime(context.Background(), now)
+			res, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &ScheduleFareChangeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				FareTable: &FareTable{
+					Metadata: &weave.Metadata{Schema: 1},
+					BaseFare: coin.NewCoin(4, 0, "TRY"),
+				},
+				ActivateAt: weave.AsUnixTime(tc.activateAt),
+			}})
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("want %v error, got %+v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var change FareChange
+			if err := NewFareChangeBucket().ByID(db, res.Data, &change); err != nil {
+				t.Fatalf("cannot load fare change: %s", err)
+			}
+			if len(change.TaskID) == 0 || change.IsActivated() {
+				t.Fatalf("want a pending change with a task, got %+v", change)
+			}
+			if err := NewFareTableBucket().One(db, fareTableKey, &FareTable{}); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("fare table must not change before the activation, got %+v", err)
+			}
+
+			// Only the scheduled task can activate the change.
+			_, err = NewActivateFareChangeHandler(&weavetest.Auth{Signer: fareAdmin}).Deliver(ctx, db, &weavetest.Tx{Msg: &ActivateFareChangeMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				FareChangeKey: change.PrimaryKey,
+			}})
+			if !errors.ErrUnauthorized.Is(err) {
+				t.Fatalf("want unauthorized error, got %+v", err)
+			}
+		})
+	}
+}
@@ -1,10 +1,17 @@
 package metro
 
 import (
+	"bytes"
+	"time"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
 )
 
 const (
@@ -15,15 +22,83 @@ const (
 func RegisterQuery(qr weave.QueryRouter) {
 	NewStationBucket().Register("stations", qr)
 	NewTrainBucket().Register("trains", qr)
+	NewTrainChangeBucket().Register("trainchanges", qr)
 	NewPassengerBucket().Register("passengers", qr)
 	NewTrainArriveStationEventBucket().Register("tr-arrival", qr)
+	NewTrainDepartStationEventBucket().Register("tr-departure", qr)
+	NewLineBucket().Register("lines", qr)
+	NewTripBucket().Register("trips", qr)
+	NewFareTableBucket().Register("fares", qr)
+	NewFareChangeBucket().Register("farechanges", qr)
+	NewJourneyBucket().Register("journeys", qr)
+	NewPassBucket().Register("passes", qr)
+	qr.Register("/passes/valid", validPassQuery{passes: NewPassBucket()})
+	NewEquipmentBucket().Register("equipment", qr)
+	NewConnectionBucket().Register("connections", qr)
+	qr.Register("/routes", routeQuery{
+		stations:    NewStationBucket(),
+		connections: NewConnectionBucket(),
+	})
+	NewTimetableBucket().Register("timetables", qr)
+	NewScheduledStopBucket().Register("scheduledstops", qr)
+	NewLineDelayBucket().Register("linedelays", qr)
+	NewTrainPositionBucket().Register("positions", qr)
+	qr.Register("/farequote", fareQuoteQuery{fares: NewFareTableBucket()})
+	qr.Register("/departures", departuresQuery{timetables: NewTimetableBucket()})
+	NewIncidentBucket().Register("incidents", qr)
+	qr.Register("/incidents/active", activeIncidentsQuery{incidents: NewIncidentBucket()})
 }
 
 // RegisterRoutes registers handlers for message processing.
-func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, ctrl cash.Controller, scheduler weave.Scheduler) {
 	//r = migration.SchemaMigratingRegistry(packageName, r)
 	r.Handle(&RegisterPassengerMsg{}, NewRegisterPassengerHandler(auth))
-	r.Handle(&TrainArriveStationEventMsg{}, NewTrainArriveStationEventHandler(auth))
+	r.Handle(&TrainArriveStationEventMsg{}, NewTrainArriveStationEventHandler(auth, scheduler))
+	r.Handle(&TrainDepartStationEventMsg{}, NewTrainDepartStationEventHandler(auth))
+	r.Handle(&CreateLineMsg{}, NewCreateLineHandler(auth))
+	r.Handle(&UpdateLineMsg{}, NewUpdateLineHandler(auth))
+	r.Handle(&TapInMsg{}, NewTapInHandler(auth))
+	r.Handle(&TapOutMsg{}, NewTapOutHandler(auth, ctrl))
+	r.Handle(&StartJourneyMsg{}, NewStartJourneyHandler(auth, scheduler))
+	r.Handle(&EndJourneyMsg{}, NewEndJourneyHandler(auth, ctrl, scheduler))
+	r.Handle(&PurchasePassMsg{}, NewPurchasePassHandler(auth, ctrl))
+	r.Handle(&CancelPassMsg{}, NewCancelPassHandler(auth, ctrl))
+	r.Handle(&ReleasePassMsg{}, NewReleasePassHandler(auth, ctrl))
+	r.Handle(&UpdateFareTableMsg{}, NewUpdateFareTableHandler(auth))
+	r.Handle(&ScheduleFareChangeMsg{}, NewScheduleFareChangeHandler(auth, scheduler))
+	r.Handle(&SetPassengerCategoryMsg{}, NewSetPassengerCategoryHandler(auth))
+	r.Handle(&PayFareMsg{}, NewPayFareHandler(auth, ctrl))
+	r.Handle(&UpdatePassengerMsg{}, NewUpdatePassengerHandler(auth))
+	r.Handle(&DeregisterPassengerMsg{}, NewDeregisterPassengerHandler(auth))
+	r.Handle(&CreateStationMsg{}, NewCreateStationHandler(auth))
+	r.Handle(&UpdateStationMsg{}, NewUpdateStationHandler(auth))
+	r.Handle(&RetireStationMsg{}, NewRetireStationHandler(auth))
+	r.Handle(&RegisterTrainMsg{}, NewRegisterTrainHandler(auth))
+	r.Handle(&ReassignTrainMsg{}, NewReassignTrainHandler(auth))
+	r.Handle(&DecommissionTrainMsg{}, NewDecommissionTrainHandler(auth))
+	r.Handle(&ReportEquipmentStatusMsg{}, NewReportEquipmentStatusHandler(auth))
+	r.Handle(&SetConnectionMsg{}, NewSetConnectionHandler(auth))
+	r.Handle(&PublishTimetableMsg{}, NewPublishTimetableHandler(auth))
+	r.Handle(&SupersedeTimetableMsg{}, NewSupersedeTimetableHandler(auth))
+	r.Handle(&ScheduleStopMsg{}, NewScheduleStopHandler(auth))
+	r.Handle(&OpenIncidentMsg{}, NewOpenIncidentHandler(auth))
+	r.Handle(&UpdateIncidentMsg{}, NewUpdateIncidentHandler(auth))
+	r.Handle(&ResolveIncidentMsg{}, NewResolveIncidentHandler(auth))
+}
+
+// RegisterCronRoutes registers routes for messages that are only executed as
+// scheduled cron tasks.
+func RegisterCronRoutes(r weave.Registry, auth x.Authenticator, ctrl cash.Controller) {
+	r.Handle(&MarkTrainSilentMsg{}, NewMarkTrainSilentHandler(auth))
+	r.Handle(&ActivateFareChangeMsg{}, NewActivateFareChangeHandler(auth))
+	r.Handle(&ExpireJourneyMsg{}, NewExpireJourneyHandler(auth, ctrl))
+}
+
+// RegisterCurrencyRoutes registers the currency extension messages with
+// handlers that take the issuer from the metro configuration.
+func RegisterCurrencyRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry("currency", r)
+	r.Handle(&currency.CreateMsg{}, NewCreateCurrencyHandler(auth))
 }
 
 // ------------------- RegisterPassengerHandler -------------------
@@ -58,9 +133,18 @@ func (h RegisterPassengerHandler) validate(ctx weave.Context, store weave.KVStor
 	}
 	now := weave.AsUnixTime(blockTime)
 
+	signer := x.AnySigner(ctx, h.auth)
+	if signer == nil {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "signature required")
+	}
+	address := signer.Address()
+	if err := requireUnboundAddress(store, h.b, address); err != nil {
+		return nil, nil, err
+	}
+
 	p := &Passenger{
 		Metadata:     &weave.Metadata{Schema: 1},
-		Address:      x.AnySigner(ctx, h.auth).Address(),
+		Address:      address,
 		Name:         msg.Name,
 		RegisteredAt: now,
 	}
@@ -99,31 +183,69 @@ func (h RegisterPassengerHandler) Deliver(ctx weave.Context, store weave.KVStore
 
 // TrainArriveStationEventHandler will handle TrainArriveStationEventMsg
 type TrainArriveStationEventHandler struct {
-	auth x.Authenticator
-	b    orm.SerialModelBucket
+	auth      x.Authenticator
+	b         orm.SerialModelBucket
+	stations  orm.SerialModelBucket
+	trains    orm.SerialModelBucket
+	stops     orm.SerialModelBucket
+	delays    orm.ModelBucket
+	lines     orm.SerialModelBucket
+	positions orm.ModelBucket
+	incidents orm.SerialModelBucket
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = TrainArriveStationEventHandler{}
 
-// NewTrainArriveStationEventHandler creates a event message handler
-func NewTrainArriveStationEventHandler(auth x.Authenticator) weave.Handler {
+// NewTrainArriveStationEventHandler creates a event message handler. Each
+// arrival reschedules the watchdog of the train using given scheduler.
+func NewTrainArriveStationEventHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
 	return TrainArriveStationEventHandler{
-		auth: auth,
-		b:    NewTrainArriveStationEventBucket(),
+		auth:      auth,
+		scheduler: scheduler,
+		b:         NewTrainArriveStationEventBucket(),
+		stations:  NewStationBucket(),
+		trains:    NewTrainBucket(),
+		stops:     NewScheduledStopBucket(),
+		delays:    NewLineDelayBucket(),
+		lines:     NewLineBucket(),
+		positions: NewTrainPositionBucket(),
+		incidents: NewIncidentBucket(),
 	}
 }
 
-// validate does all common pre-processing between Check and Deliver
-func (h TrainArriveStationEventHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*TrainArriveStationEventMsg, *TrainArriveStationEvent, error) {
+// validate does all common pre-processing between Check and Deliver. The
+// arrival is matched with the nearest scheduled stop, which is returned if
+// found.
+func (h TrainArriveStationEventHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*TrainArriveStationEventMsg, *TrainArriveStationEvent, *ScheduledStop, error) {
 	var msg TrainArriveStationEventMsg
 
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, nil, errors.Wrap(err, "load msg")
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var station Station
+	if err := h.stations.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "station is retired")
+	}
+	var train Train
+	if err := h.trains.ByID(store, msg.TrainKey, &train); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load train")
+	}
+	// Only the train itself can report its arrival.
+	if !h.auth.HasAddress(ctx, train.Address) {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "train signature required")
+	}
+	if train.IsDecommissioned() {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "train is decommissioned")
 	}
 
 	blockTime, err := weave.BlockTime(ctx)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "no block time in header")
+		return nil, nil, nil, errors.Wrap(err, "no block time in header")
 	}
 	now := weave.AsUnixTime(blockTime)
 
@@ -134,13 +256,30 @@ func (h TrainArriveStationEventHandler) validate(ctx weave.Context, store weave.
 		ArrivedAt:  now,
 	}
 
-	return &msg, tae, nil
+	stop, err := nearestScheduledStop(store, h.stops, msg.TrainKey, msg.StationKey, now)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if stop != nil {
+		tae.ScheduledStopKey = stop.PrimaryKey
+		tae.Delay = int64(now - stop.PlannedAt)
+	}
+
+	incidents, err := activeIncidents(store, h.incidents, msg.StationKey, now)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, i := range incidents {
+		tae.IncidentKeys = append(tae.IncidentKeys, i.PrimaryKey)
+	}
+
+	return &msg, tae, stop, nil
 }
 
 // Check just verifies it is properly formed and returns
 // the cost of executing it.
 func (h TrainArriveStationEventHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	_, _, err := h.validate(ctx, store, tx)
+	_, _, _, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,16 +289,3244 @@ func (h TrainArriveStationEventHandler) Check(ctx weave.Context, store weave.KVS
 
 // Deliver creates an custom state and saves if all preconditions are met
 func (h TrainArriveStationEventHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	_, tae, err := h.validate(ctx, store, tx)
+	_, tae, stop, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
 
 	err = h.b.Save(store, tae)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot store passenger")
+		return nil, errors.Wrap(err, "cannot store arrival event")
 	}
 
 	// Returns generated user PrimaryKey as response
-	return &weave.DeliverResult{Data: tae.PrimaryKey}, nil
+	res := &weave.DeliverResult{Data: tae.PrimaryKey}
+	var lineKey []byte
+	if stop != nil {
+		lineKey = stop.LineKey
+		if err := recordLineDelay(store, h.delays, stop.LineKey, tae.Delay, tae.ArrivedAt); err != nil {
+			return nil, err
+		}
+		res.Tags = delayTags(stop.LineKey, tae.Delay)
+	}
+	res.Tags = append(res.Tags, incidentTags(tae.IncidentKeys)...)
+	if _, err := moveTrain(store, h.positions, h.lines, tae.PrimaryKey, tae.TrainKey, tae.StationKey, lineKey, tae.ArrivedAt); err != nil {
+		return nil, err
+	}
+
+	// Without configuration the watchdog is disabled.
+	var silence time.Duration
+	switch conf, err := loadConf(store); {
+	case err == nil:
+		silence = time.Duration(conf.TrainSilenceMinutes) * time.Minute
+	case !errors.ErrNotFound.Is(err):
+		return nil, err
+	}
+	if err := rearmWatchdog(store, h.scheduler, h.trains, tae.TrainKey, silence, tae.ArrivedAt.Time()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ------------------- MarkTrainSilentHandler -------------------
+
+// MarkTrainSilentHandler will handle MarkTrainSilentMsg scheduled by the
+// watchdog of a train.
+type MarkTrainSilentHandler struct {
+	auth   x.Authenticator
+	trains orm.SerialModelBucket
+}
+
+var _ weave.Handler = MarkTrainSilentHandler{}
+
+// NewMarkTrainSilentHandler creates a watchdog message handler
+func NewMarkTrainSilentHandler(auth x.Authenticator) weave.Handler {
+	return MarkTrainSilentHandler{
+		auth:   auth,
+		trains: NewTrainBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h MarkTrainSilentHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*MarkTrainSilentMsg, *Train, error) {
+	var msg MarkTrainSilentMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, WatchdogCondition(msg.TrainKey).Address()) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "watchdog task required")
+	}
+	var train Train
+	if err := h.trains.ByID(store, msg.TrainKey, &train); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load train")
+	}
+	if train.IsDecommissioned() {
+		return nil, nil, errors.Wrap(errors.ErrState, "train is decommissioned")
+	}
+
+	return &msg, &train, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h MarkTrainSilentHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver marks the train silent since the current block time.
+func (h MarkTrainSilentHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, train, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+	train.SilentSince = weave.AsUnixTime(blockTime)
+	train.WatchdogTaskID = nil
+
+	if err := h.trains.Save(store, train); err != nil {
+		return nil, errors.Wrap(err, "cannot store train")
+	}
+	return &weave.DeliverResult{Data: train.PrimaryKey}, nil
+}
+
+// ------------------- TrainDepartStationEventHandler -------------------
+
+// TrainDepartStationEventHandler will handle TrainDepartStationEventMsg
+type TrainDepartStationEventHandler struct {
+	auth      x.Authenticator
+	b         orm.SerialModelBucket
+	trains    orm.SerialModelBucket
+	positions orm.ModelBucket
+}
+
+var _ weave.Handler = TrainDepartStationEventHandler{}
+
+// NewTrainDepartStationEventHandler creates a departure event message handler
+func NewTrainDepartStationEventHandler(auth x.Authenticator) weave.Handler {
+	return TrainDepartStationEventHandler{
+		auth:      auth,
+		b:         NewTrainDepartStationEventBucket(),
+		trains:    NewTrainBucket(),
+		positions: NewTrainPositionBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver. It
+// returns the train position updated with the departure.
+func (h TrainDepartStationEventHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*TrainDepartStationEventMsg, *TrainDepartStationEvent, *TrainPosition, error) {
+	var msg TrainDepartStationEventMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	train, err := loadActiveTrain(store, h.trains, msg.TrainKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// Only the train itself can report its departure.
+	if !h.auth.HasAddress(ctx, train.Address) {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "train signature required")
+	}
+
+	var pos TrainPosition
+	switch err := h.positions.One(store, msg.TrainKey, &pos); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "train did not arrive at the station")
+	default:
+		return nil, nil, nil, errors.Wrap(err, "cannot load train position")
+	}
+	if !pos.IsAtStation(msg.StationKey) {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "train did not arrive at the station")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	now := weave.AsUnixTime(blockTime)
+
+	tde := &TrainDepartStationEvent{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: msg.StationKey,
+		TrainKey:   msg.TrainKey,
+		ArrivalKey: pos.ArrivalKey,
+		DepartedAt: now,
+		Dwell:      int64(now - pos.ArrivedAt),
+	}
+	pos.DepartedAt = now
+
+	return &msg, tde, &pos, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h TrainDepartStationEventHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the departure event if all preconditions are met
+func (h TrainDepartStationEventHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, tde, pos, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, tde)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store departure event")
+	}
+	if _, err := h.positions.Put(store, tde.TrainKey, pos); err != nil {
+		return nil, errors.Wrap(err, "cannot store train position")
+	}
+
+	return &weave.DeliverResult{Data: tde.PrimaryKey}, nil
+}
+
+// ------------------- CreateLineHandler -------------------
+
+// CreateLineHandler will handle CreateLineMsg
+type CreateLineHandler struct {
+	auth     x.Authenticator
+	b        orm.SerialModelBucket
+	stations orm.SerialModelBucket
+}
+
+var _ weave.Handler = CreateLineHandler{}
+
+// NewCreateLineHandler creates a line message handler
+func NewCreateLineHandler(auth x.Authenticator) weave.Handler {
+	return CreateLineHandler{
+		auth:     auth,
+		b:        NewLineBucket(),
+		stations: NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CreateLineHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CreateLineMsg, *Line, error) {
+	var msg CreateLineMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+	if err := requireOpenStations(store, h.stations, msg.StationKeys); err != nil {
+		return nil, nil, err
+	}
+	if err := requireUnusedLineName(store, h.b, msg.Name, nil); err != nil {
+		return nil, nil, err
+	}
+
+	line := &Line{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Name:        msg.Name,
+		Color:       msg.Color,
+		StationKeys: msg.StationKeys,
+	}
+
+	return &msg, line, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CreateLineHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver creates a line and saves if all preconditions are met
+func (h CreateLineHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, line, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, line)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store line")
+	}
+
+	// Returns generated line PrimaryKey as response
+	return &weave.DeliverResult{Data: line.PrimaryKey}, nil
+}
+
+// ------------------- UpdateLineHandler -------------------
+
+// UpdateLineHandler will handle UpdateLineMsg
+type UpdateLineHandler struct {
+	auth     x.Authenticator
+	b        orm.SerialModelBucket
+	stations orm.SerialModelBucket
+}
+
+var _ weave.Handler = UpdateLineHandler{}
+
+// NewUpdateLineHandler creates a line update message handler
+func NewUpdateLineHandler(auth x.Authenticator) weave.Handler {
+	return UpdateLineHandler{
+		auth:     auth,
+		b:        NewLineBucket(),
+		stations: NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h UpdateLineHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*UpdateLineMsg, *Line, error) {
+	var msg UpdateLineMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+
+	var line Line
+	if err := h.b.ByID(store, msg.LineKey, &line); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load line")
+	}
+	if err := requireOpenStations(store, h.stations, msg.StationKeys); err != nil {
+		return nil, nil, err
+	}
+	if err := requireUnusedLineName(store, h.b, msg.Name, line.PrimaryKey); err != nil {
+		return nil, nil, err
+	}
+
+	line.Name = msg.Name
+	line.Color = msg.Color
+	line.StationKeys = msg.StationKeys
+
+	return &msg, &line, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h UpdateLineHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver updates a line and saves if all preconditions are met
+func (h UpdateLineHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, line, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, line)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store line")
+	}
+
+	return &weave.DeliverResult{Data: line.PrimaryKey}, nil
+}
+
+// ------------------- TapInHandler -------------------
+
+// TapInHandler will handle TapInMsg
+type TapInHandler struct {
+	auth       x.Authenticator
+	b          orm.SerialModelBucket
+	stations   orm.SerialModelBucket
+	passengers orm.SerialModelBucket
+}
+
+var _ weave.Handler = TapInHandler{}
+
+// NewTapInHandler creates a tap in message handler
+func NewTapInHandler(auth x.Authenticator) weave.Handler {
+	return TapInHandler{
+		auth:       auth,
+		b:          NewTripBucket(),
+		stations:   NewStationBucket(),
+		passengers: NewPassengerBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h TapInHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*TapInMsg, *Trip, error) {
+	var msg TapInMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var station Station
+	if err := h.stations.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, errors.Wrap(errors.ErrState, "station is retired")
+	}
+	if err := requireGate(ctx, h.auth, &station); err != nil {
+		return nil, nil, err
+	}
+	if _, err := loadPassenger(store, h.passengers, msg.PassengerKey); err != nil {
+		return nil, nil, err
+	}
+	if _, err := loadOpenTrip(store, h.b, msg.PassengerKey); !errors.ErrNotFound.Is(err) {
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.Wrap(errors.ErrDuplicate, "passenger already tapped in")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	trip := &Trip{
+		Metadata:        &weave.Metadata{Schema: 1},
+		PassengerKey:    msg.PassengerKey,
+		EntryStationKey: msg.StationKey,
+		EnteredAt:       weave.AsUnixTime(blockTime),
+	}
+
+	return &msg, trip, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h TapInHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver opens a trip and saves if all preconditions are met
+func (h TapInHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, trip, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, trip)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store trip")
+	}
+
+	// Returns generated trip PrimaryKey as response
+	return &weave.DeliverResult{Data: trip.PrimaryKey}, nil
+}
+
+// ------------------- TapOutHandler -------------------
+
+// TapOutHandler will handle TapOutMsg
+type TapOutHandler struct {
+	auth       x.Authenticator
+	ctrl       cash.Controller
+	b          orm.SerialModelBucket
+	stations   orm.SerialModelBucket
+	passengers orm.SerialModelBucket
+	fares      orm.ModelBucket
+}
+
+var _ weave.Handler = TapOutHandler{}
+
+// NewTapOutHandler creates a tap out message handler
+func NewTapOutHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return TapOutHandler{
+		auth:       auth,
+		ctrl:       ctrl,
+		b:          NewTripBucket(),
+		stations:   NewStationBucket(),
+		passengers: NewPassengerBucket(),
+		fares:      NewFareTableBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h TapOutHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*TapOutMsg, *Trip, *Passenger, *Configuration, error) {
+	var msg TapOutMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var station Station
+	if err := h.stations.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrState, "station is retired")
+	}
+	if err := requireGate(ctx, h.auth, &station); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	passenger, err := loadPassenger(store, h.passengers, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	trip, err := loadOpenTrip(store, h.b, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	table, err := loadFareTable(store, h.fares)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	category := passenger.ActiveCategory(trip.EnteredAt)
+	quote, err := quoteFare(store, table, trip.EntryStationKey, msg.StationKey, category, trip.EnteredAt)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	fare := quote.Fare
+	if !fare.IsZero() && len(conf.FareCollector) == 0 {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrState, "no fare collector configured")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	trip.ExitStationKey = msg.StationKey
+	trip.ExitedAt = weave.AsUnixTime(blockTime)
+	trip.Fare = &fare
+
+	return &msg, trip, passenger, conf, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h TapOutHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver closes the trip and charges the passenger if all preconditions are
+// met
+func (h TapOutHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, trip, passenger, conf, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !trip.Fare.IsZero() {
+		if err := h.ctrl.MoveCoins(store, passenger.Address, conf.FareCollector, *trip.Fare); err != nil {
+			return nil, errors.Wrap(err, "cannot charge fare")
+		}
+	}
+
+	err = h.b.Save(store, trip)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store trip")
+	}
+
+	return &weave.DeliverResult{Data: trip.PrimaryKey}, nil
+}
+
+// ------------------- StartJourneyHandler -------------------
+
+// StartJourneyHandler will handle StartJourneyMsg
+type StartJourneyHandler struct {
+	auth       x.Authenticator
+	scheduler  weave.Scheduler
+	b          orm.SerialModelBucket
+	stations   orm.SerialModelBucket
+	passengers orm.SerialModelBucket
+}
+
+var _ weave.Handler = StartJourneyHandler{}
+
+// NewStartJourneyHandler creates a journey start message handler. The
+// journey expiry is registered with given scheduler.
+func NewStartJourneyHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+	return StartJourneyHandler{
+		auth:       auth,
+		scheduler:  scheduler,
+		b:          NewJourneyBucket(),
+		stations:   NewStationBucket(),
+		passengers: NewPassengerBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h StartJourneyHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*StartJourneyMsg, *Journey, *Configuration, error) {
+	var msg StartJourneyMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var station Station
+	if err := h.stations.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "station is retired")
+	}
+	if err := requireGate(ctx, h.auth, &station); err != nil {
+		return nil, nil, nil, err
+	}
+	if _, err := loadPassenger(store, h.passengers, msg.PassengerKey); err != nil {
+		return nil, nil, nil, err
+	}
+	if _, err := loadOpenJourney(store, h.b, msg.PassengerKey); !errors.ErrNotFound.Is(err) {
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return nil, nil, nil, errors.Wrap(errors.ErrDuplicate, "passenger already started a journey")
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if conf.MaxJourneyMinutes == 0 {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "no maximum journey duration configured")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	journey := &Journey{
+		Metadata:        &weave.Metadata{Schema: 1},
+		PassengerKey:    msg.PassengerKey,
+		EntryStationKey: msg.StationKey,
+		StartedAt:       weave.AsUnixTime(blockTime),
+		Status:          JourneyOpen,
+	}
+
+	return &msg, journey, conf, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h StartJourneyHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver opens a journey and schedules its expiry if all preconditions are
+// met
+func (h StartJourneyHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, journey, conf, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key is needed to schedule the expiry, so the journey is stored
+	// twice.
+	if err := h.b.Save(store, journey); err != nil {
+		return nil, errors.Wrap(err, "cannot store journey")
+	}
+	msg := &ExpireJourneyMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		JourneyKey: journey.PrimaryKey,
+	}
+	expireAt := journey.StartedAt.Time().Add(time.Duration(conf.MaxJourneyMinutes) * time.Minute)
+	auth := []weave.Condition{JourneyCondition(journey.PrimaryKey)}
+	taskID, err := h.scheduler.Schedule(store, expireAt, auth, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule journey expiry")
+	}
+	journey.ExpiryTaskID = taskID
+	if err := h.b.Save(store, journey); err != nil {
+		return nil, errors.Wrap(err, "cannot store journey")
+	}
+
+	// Returns generated journey PrimaryKey as response
+	return &weave.DeliverResult{Data: journey.PrimaryKey}, nil
+}
+
+// ------------------- EndJourneyHandler -------------------
+
+// EndJourneyHandler will handle EndJourneyMsg
+type EndJourneyHandler struct {
+	auth       x.Authenticator
+	ctrl       cash.Controller
+	scheduler  weave.Scheduler
+	b          orm.SerialModelBucket
+	stations   orm.SerialModelBucket
+	passengers orm.SerialModelBucket
+	fares      orm.ModelBucket
+}
+
+var _ weave.Handler = EndJourneyHandler{}
+
+// NewEndJourneyHandler creates a journey end message handler. The pending
+// journey expiry is cancelled with given scheduler.
+func NewEndJourneyHandler(auth x.Authenticator, ctrl cash.Controller, scheduler weave.Scheduler) weave.Handler {
+	return EndJourneyHandler{
+		auth:       auth,
+		ctrl:       ctrl,
+		scheduler:  scheduler,
+		b:          NewJourneyBucket(),
+		stations:   NewStationBucket(),
+		passengers: NewPassengerBucket(),
+		fares:      NewFareTableBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h EndJourneyHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*EndJourneyMsg, *Journey, *Passenger, *Configuration, error) {
+	var msg EndJourneyMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var station Station
+	if err := h.stations.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrState, "station is retired")
+	}
+	if err := requireGate(ctx, h.auth, &station); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	passenger, err := loadPassenger(store, h.passengers, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	journey, err := loadOpenJourney(store, h.b, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	table, err := loadFareTable(store, h.fares)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	category := passenger.ActiveCategory(journey.StartedAt)
+	quote, err := quoteFare(store, table, journey.EntryStationKey, msg.StationKey, category, journey.StartedAt)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	fare := quote.Fare
+	if !fare.IsZero() && len(conf.FareCollector) == 0 {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrState, "no fare collector configured")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	journey.ExitStationKey = msg.StationKey
+	journey.EndedAt = weave.AsUnixTime(blockTime)
+	journey.Fare = &fare
+	journey.Status = JourneyCompleted
+
+	return &msg, journey, passenger, conf, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h EndJourneyHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver closes the journey, cancels its expiry and charges the passenger
+// if all preconditions are met
+func (h EndJourneyHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, journey, passenger, conf, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !journey.Fare.IsZero() {
+		if err := h.ctrl.MoveCoins(store, passenger.Address, conf.FareCollector, *journey.Fare); err != nil {
+			return nil, errors.Wrap(err, "cannot charge fare")
+		}
+	}
+
+	// The expiry task is gone if it failed to charge the maximum fare.
+	if err := h.scheduler.Delete(store, journey.ExpiryTaskID); err != nil && !errors.ErrNotFound.Is(err) {
+		return nil, errors.Wrap(err, "cannot cancel journey expiry")
+	}
+	journey.ExpiryTaskID = nil
+
+	if err := h.b.Save(store, journey); err != nil {
+		return nil, errors.Wrap(err, "cannot store journey")
+	}
+
+	return &weave.DeliverResult{Data: journey.PrimaryKey}, nil
+}
+
+// ------------------- ExpireJourneyHandler -------------------
+
+// ExpireJourneyHandler will handle ExpireJourneyMsg scheduled when a journey
+// starts.
+type ExpireJourneyHandler struct {
+	auth       x.Authenticator
+	ctrl       cash.Controller
+	b          orm.SerialModelBucket
+	passengers orm.SerialModelBucket
+}
+
+var _ weave.Handler = ExpireJourneyHandler{}
+
+// NewExpireJourneyHandler creates a journey expiry handler
+func NewExpireJourneyHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return ExpireJourneyHandler{
+		auth:       auth,
+		ctrl:       ctrl,
+		b:          NewJourneyBucket(),
+		passengers: NewPassengerBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ExpireJourneyHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ExpireJourneyMsg, *Journey, *Passenger, *Configuration, error) {
+	var msg ExpireJourneyMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, JourneyCondition(msg.JourneyKey).Address()) {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "journey expiry task required")
+	}
+	var journey Journey
+	if err := h.b.ByID(store, msg.JourneyKey, &journey); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "cannot load journey")
+	}
+	if !journey.IsOpen() {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrState, "journey already ended")
+	}
+	var passenger Passenger
+	if err := h.passengers.ByID(store, journey.PassengerKey, &passenger); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "cannot load passenger")
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	var fare coin.Coin
+	if conf.MaxFare != nil {
+		fare = *conf.MaxFare
+	}
+	if !fare.IsZero() && len(conf.FareCollector) == 0 {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrState, "no fare collector configured")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	journey.EndedAt = weave.AsUnixTime(blockTime)
+	journey.Fare = &fare
+	journey.Status = JourneyExpired
+	journey.ExpiryTaskID = nil
+
+	return &msg, &journey, &passenger, conf, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ExpireJourneyHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver closes the journey and charges the passenger the maximum fare
+func (h ExpireJourneyHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, journey, passenger, conf, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !journey.Fare.IsZero() {
+		if err := h.ctrl.MoveCoins(store, passenger.Address, conf.FareCollector, *journey.Fare); err != nil {
+			return nil, errors.Wrap(err, "cannot charge maximum fare")
+		}
+	}
+
+	if err := h.b.Save(store, journey); err != nil {
+		return nil, errors.Wrap(err, "cannot store journey")
+	}
+
+	return &weave.DeliverResult{Data: journey.PrimaryKey}, nil
+}
+
+// ------------------- PurchasePassHandler -------------------
+
+// PurchasePassHandler will handle PurchasePassMsg
+type PurchasePassHandler struct {
+	auth       x.Authenticator
+	ctrl       cash.Controller
+	b          orm.SerialModelBucket
+	passengers orm.SerialModelBucket
+	fares      orm.ModelBucket
+}
+
+var _ weave.Handler = PurchasePassHandler{}
+
+// NewPurchasePassHandler creates a pass purchase message handler
+func NewPurchasePassHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return PurchasePassHandler{
+		auth:       auth,
+		ctrl:       ctrl,
+		b:          NewPassBucket(),
+		passengers: NewPassengerBucket(),
+		fares:      NewFareTableBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h PurchasePassHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*PurchasePassMsg, *Pass, error) {
+	var msg PurchasePassMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	passenger, err := loadPassenger(store, h.passengers, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !h.auth.HasAddress(ctx, passenger.Address) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "passenger signature required")
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	table, err := loadFareTable(store, h.fares)
+	if err != nil {
+		return nil, nil, err
+	}
+	price, err := passPrice(table, msg.Kind)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !price.IsZero() && len(conf.FareCollector) == 0 {
+		return nil, nil, errors.Wrap(errors.ErrState, "no fare collector configured")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	now := weave.AsUnixTime(blockTime)
+	validFrom := msg.ValidFrom
+	if validFrom == 0 {
+		validFrom = now
+	}
+	if validFrom < now {
+		return nil, nil, errors.Wrap(errors.ErrInput, "pass cannot start in the past")
+	}
+
+	pass := &Pass{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: msg.PassengerKey,
+		Holder:       passenger.Address,
+		Kind:         msg.Kind,
+		ValidFrom:    validFrom,
+		ValidUntil:   validFrom.Add(passDurations[msg.Kind]),
+		Price:        price,
+	}
+
+	return &msg, pass, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h PurchasePassHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the pass and moves its price to the pass escrow if all
+// preconditions are met
+func (h PurchasePassHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, pass, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pass must be stored first, its key is needed for the escrow address.
+	if err := h.b.Save(store, pass); err != nil {
+		return nil, errors.Wrap(err, "cannot store pass")
+	}
+	if !pass.Price.IsZero() {
+		if err := h.ctrl.MoveCoins(store, pass.Holder, PassCondition(pass.PrimaryKey).Address(), pass.Price); err != nil {
+			return nil, errors.Wrap(err, "cannot pay for pass")
+		}
+	}
+
+	return &weave.DeliverResult{Data: pass.PrimaryKey}, nil
+}
+
+// ------------------- CancelPassHandler -------------------
+
+// CancelPassHandler will handle CancelPassMsg
+type CancelPassHandler struct {
+	auth x.Authenticator
+	ctrl cash.Controller
+	b    orm.SerialModelBucket
+}
+
+var _ weave.Handler = CancelPassHandler{}
+
+// NewCancelPassHandler creates a pass cancellation message handler
+func NewCancelPassHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return CancelPassHandler{
+		auth: auth,
+		ctrl: ctrl,
+		b:    NewPassBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CancelPassHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CancelPassMsg, *Pass, *Configuration, coin.Coin, coin.Coin, error) {
+	var msg CancelPassMsg
+	var due, refund coin.Coin
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, due, refund, errors.Wrap(err, "load msg")
+	}
+
+	var pass Pass
+	if err := h.b.ByID(store, msg.PassKey, &pass); err != nil {
+		return nil, nil, nil, due, refund, errors.Wrap(err, "cannot load pass")
+	}
+	if !h.auth.HasAddress(ctx, pass.Holder) {
+		return nil, nil, nil, due, refund, errors.Wrap(errors.ErrUnauthorized, "pass holder signature required")
+	}
+	if pass.IsCancelled() {
+		return nil, nil, nil, due, refund, errors.Wrap(errors.ErrState, "pass already cancelled")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, due, refund, errors.Wrap(err, "no block time in header")
+	}
+	now := weave.AsUnixTime(blockTime)
+	if now >= pass.ValidUntil {
+		return nil, nil, nil, due, refund, errors.Wrap(errors.ErrState, "pass already expired")
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, nil, due, refund, err
+	}
+
+	pass.CancelledAt = now
+	if due, err = releasable(&pass, now); err != nil {
+		return nil, nil, nil, due, refund, err
+	}
+	if !due.IsZero() && len(conf.FareCollector) == 0 {
+		return nil, nil, nil, due, refund, errors.Wrap(errors.ErrState, "no fare collector configured")
+	}
+	if pass.Released, err = pass.Released.Add(due); err != nil {
+		return nil, nil, nil, due, refund, errors.Wrap(err, "released amount")
+	}
+	if refund, err = pass.Price.Subtract(pass.Released); err != nil {
+		return nil, nil, nil, due, refund, errors.Wrap(err, "refund amount")
+	}
+
+	return &msg, &pass, conf, due, refund, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CancelPassHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver cancels the pass, releases the elapsed part of the price to the
+// fare collector and refunds the rest to the holder if all preconditions are
+// met
+func (h CancelPassHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, pass, conf, due, refund, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	escrow := PassCondition(pass.PrimaryKey).Address()
+	if !due.IsZero() {
+		if err := h.ctrl.MoveCoins(store, escrow, conf.FareCollector, due); err != nil {
+			return nil, errors.Wrap(err, "cannot release pass payment")
+		}
+	}
+	if !refund.IsZero() {
+		if err := h.ctrl.MoveCoins(store, escrow, pass.Holder, refund); err != nil {
+			return nil, errors.Wrap(err, "cannot refund pass")
+		}
+	}
+
+	if err := h.b.Save(store, pass); err != nil {
+		return nil, errors.Wrap(err, "cannot store pass")
+	}
+
+	return &weave.DeliverResult{Data: pass.PrimaryKey}, nil
+}
+
+// ------------------- ReleasePassHandler -------------------
+
+// ReleasePassHandler will handle ReleasePassMsg
+type ReleasePassHandler struct {
+	auth x.Authenticator
+	ctrl cash.Controller
+	b    orm.SerialModelBucket
+}
+
+var _ weave.Handler = ReleasePassHandler{}
+
+// NewReleasePassHandler creates a pass payment release message handler
+func NewReleasePassHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return ReleasePassHandler{
+		auth: auth,
+		ctrl: ctrl,
+		b:    NewPassBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ReleasePassHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ReleasePassMsg, *Pass, *Configuration, coin.Coin, error) {
+	var msg ReleasePassMsg
+	var due coin.Coin
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, due, errors.Wrap(err, "load msg")
+	}
+
+	var pass Pass
+	if err := h.b.ByID(store, msg.PassKey, &pass); err != nil {
+		return nil, nil, nil, due, errors.Wrap(err, "cannot load pass")
+	}
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, due, errors.Wrap(err, "no block time in header")
+	}
+	if due, err = releasable(&pass, weave.AsUnixTime(blockTime)); err != nil {
+		return nil, nil, nil, due, err
+	}
+	if due.IsZero() {
+		return nil, nil, nil, due, errors.Wrap(errors.ErrState, "nothing to release")
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, nil, due, err
+	}
+	if len(conf.FareCollector) == 0 {
+		return nil, nil, nil, due, errors.Wrap(errors.ErrState, "no fare collector configured")
+	}
+	if pass.Released, err = pass.Released.Add(due); err != nil {
+		return nil, nil, nil, due, errors.Wrap(err, "released amount")
+	}
+
+	return &msg, &pass, conf, due, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ReleasePassHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver pays out the earned part of the pass price to the fare collector if
+// all preconditions are met
+func (h ReleasePassHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, pass, conf, due, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.ctrl.MoveCoins(store, PassCondition(pass.PrimaryKey).Address(), conf.FareCollector, due); err != nil {
+		return nil, errors.Wrap(err, "cannot release pass payment")
+	}
+
+	if err := h.b.Save(store, pass); err != nil {
+		return nil, errors.Wrap(err, "cannot store pass")
+	}
+
+	return &weave.DeliverResult{Data: pass.PrimaryKey}, nil
+}
+
+// ------------------- UpdateFareTableHandler -------------------
+
+// UpdateFareTableHandler will handle UpdateFareTableMsg
+type UpdateFareTableHandler struct {
+	auth  x.Authenticator
+	fares orm.ModelBucket
+}
+
+var _ weave.Handler = UpdateFareTableHandler{}
+
+// NewUpdateFareTableHandler creates a fare table update message handler
+func NewUpdateFareTableHandler(auth x.Authenticator) weave.Handler {
+	return UpdateFareTableHandler{
+		auth:  auth,
+		fares: NewFareTableBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h UpdateFareTableHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*UpdateFareTableMsg, error) {
+	var msg UpdateFareTableMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	if !h.auth.HasAddress(ctx, conf.FareAdmin) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "fare admin signature required")
+	}
+
+	return &msg, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h UpdateFareTableHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver replaces the fare table if all preconditions are met
+func (h UpdateFareTableHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.fares.Put(store, fareTableKey, msg.FareTable); err != nil {
+		return nil, errors.Wrap(err, "cannot store fare table")
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- ScheduleFareChangeHandler -------------------
+
+// ScheduleFareChangeHandler will handle ScheduleFareChangeMsg
+type ScheduleFareChangeHandler struct {
+	auth      x.Authenticator
+	b         orm.SerialModelBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = ScheduleFareChangeHandler{}
+
+// NewScheduleFareChangeHandler creates a fare change message handler. The
+// change is activated by a task registered with given scheduler.
+func NewScheduleFareChangeHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+	return ScheduleFareChangeHandler{
+		auth:      auth,
+		b:         NewFareChangeBucket(),
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ScheduleFareChangeHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ScheduleFareChangeMsg, *FareChange, error) {
+	var msg ScheduleFareChangeMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !h.auth.HasAddress(ctx, conf.FareAdmin) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "fare admin signature required")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	if !msg.ActivateAt.Time().After(blockTime) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "activation time must be in the future")
+	}
+
+	change := &FareChange{
+		Metadata:    &weave.Metadata{Schema: 1},
+		FareTable:   msg.FareTable,
+		ActivateAt:  msg.ActivateAt,
+		ScheduledBy: conf.FareAdmin,
+	}
+
+	return &msg, change, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ScheduleFareChangeHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the fare change and schedules its activation
+func (h ScheduleFareChangeHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, change, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key is needed to schedule the activation, so the change is
+	// stored twice.
+	if err := h.b.Save(store, change); err != nil {
+		return nil, errors.Wrap(err, "cannot store fare change")
+	}
+	msg := &ActivateFareChangeMsg{
+		Metadata:      &weave.Metadata{Schema: 1},
+		FareChangeKey: change.PrimaryKey,
+	}
+	auth := []weave.Condition{FareChangeCondition(change.PrimaryKey)}
+	taskID, err := h.scheduler.Schedule(store, change.ActivateAt.Time(), auth, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule fare change")
+	}
+	change.TaskID = taskID
+	if err := h.b.Save(store, change); err != nil {
+		return nil, errors.Wrap(err, "cannot store fare change")
+	}
+
+	return &weave.DeliverResult{Data: change.PrimaryKey}, nil
+}
+
+// ------------------- ActivateFareChangeHandler -------------------
+
+// ActivateFareChangeHandler will handle ActivateFareChangeMsg scheduled for
+// a fare change.
+type ActivateFareChangeHandler struct {
+	auth  x.Authenticator
+	b     orm.SerialModelBucket
+	fares orm.ModelBucket
+}
+
+var _ weave.Handler = ActivateFareChangeHandler{}
+
+// NewActivateFareChangeHandler creates a fare change activation handler
+func NewActivateFareChangeHandler(auth x.Authenticator) weave.Handler {
+	return ActivateFareChangeHandler{
+		auth:  auth,
+		b:     NewFareChangeBucket(),
+		fares: NewFareTableBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ActivateFareChangeHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ActivateFareChangeMsg, *FareChange, error) {
+	var msg ActivateFareChangeMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, FareChangeCondition(msg.FareChangeKey).Address()) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "fare change task required")
+	}
+	var change FareChange
+	if err := h.b.ByID(store, msg.FareChangeKey, &change); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load fare change")
+	}
+	if change.IsActivated() {
+		return nil, nil, errors.Wrap(errors.ErrState, "fare change already activated")
+	}
+
+	return &msg, &change, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ActivateFareChangeHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver replaces the fare table in use with the one of the change
+func (h ActivateFareChangeHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, change, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+	if _, err := h.fares.Put(store, fareTableKey, change.FareTable); err != nil {
+		return nil, errors.Wrap(err, "cannot store fare table")
+	}
+	change.ActivatedAt = weave.AsUnixTime(blockTime)
+	change.TaskID = nil
+	if err := h.b.Save(store, change); err != nil {
+		return nil, errors.Wrap(err, "cannot store fare change")
+	}
+
+	return &weave.DeliverResult{Data: change.PrimaryKey}, nil
+}
+
+// ------------------- SetPassengerCategoryHandler -------------------
+
+// SetPassengerCategoryHandler will handle SetPassengerCategoryMsg
+type SetPassengerCategoryHandler struct {
+	auth x.Authenticator
+	b    orm.SerialModelBucket
+}
+
+var _ weave.Handler = SetPassengerCategoryHandler{}
+
+// NewSetPassengerCategoryHandler creates a passenger category message handler
+func NewSetPassengerCategoryHandler(auth x.Authenticator) weave.Handler {
+	return SetPassengerCategoryHandler{
+		auth: auth,
+		b:    NewPassengerBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h SetPassengerCategoryHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SetPassengerCategoryMsg, *Passenger, error) {
+	var msg SetPassengerCategoryMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !h.auth.HasAddress(ctx, conf.CategoryIssuer) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "category issuer signature required")
+	}
+
+	passenger, err := loadPassenger(store, h.b, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	if msg.Category != CategoryStandard && !msg.ExpiresAt.Time().After(blockTime) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "category expiration must be in the future")
+	}
+
+	passenger.Category = msg.Category
+	passenger.CategoryExpiresAt = msg.ExpiresAt
+
+	return &msg, passenger, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h SetPassengerCategoryHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver updates the passenger category if all preconditions are met
+func (h SetPassengerCategoryHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, passenger, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, passenger)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store passenger")
+	}
+
+	return &weave.DeliverResult{Data: passenger.PrimaryKey}, nil
+}
+
+// ------------------- PayFareHandler -------------------
+
+// PayFareHandler will handle PayFareMsg
+type PayFareHandler struct {
+	auth       x.Authenticator
+	ctrl       cash.Controller
+	b          orm.SerialModelBucket
+	stations   orm.SerialModelBucket
+	passengers orm.SerialModelBucket
+	fares      orm.ModelBucket
+}
+
+var _ weave.Handler = PayFareHandler{}
+
+// NewPayFareHandler creates a fare payment message handler
+func NewPayFareHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return PayFareHandler{
+		auth:       auth,
+		ctrl:       ctrl,
+		b:          NewTripBucket(),
+		stations:   NewStationBucket(),
+		passengers: NewPassengerBucket(),
+		fares:      NewFareTableBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h PayFareHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*PayFareMsg, *Trip, *Passenger, *Configuration, error) {
+	var msg PayFareMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	passenger, err := loadPassenger(store, h.passengers, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if !h.auth.HasAddress(ctx, passenger.Address) {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "passenger signature required")
+	}
+	if err := requireStations(store, h.stations, [][]byte{msg.EntryStationKey, msg.ExitStationKey}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	now := weave.AsUnixTime(blockTime)
+
+	table, err := loadFareTable(store, h.fares)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	quote, err := quoteFare(store, table, msg.EntryStationKey, msg.ExitStationKey, passenger.ActiveCategory(now), now)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	fare := quote.Fare
+	if !fare.IsZero() && len(conf.FareCollector) == 0 {
+		return nil, nil, nil, nil, errors.Wrap(errors.ErrState, "no fare collector configured")
+	}
+
+	// A paid fare is recorded as a closed trip.
+	trip := &Trip{
+		Metadata:        &weave.Metadata{Schema: 1},
+		PassengerKey:    msg.PassengerKey,
+		EntryStationKey: msg.EntryStationKey,
+		EnteredAt:       now,
+		ExitStationKey:  msg.ExitStationKey,
+		ExitedAt:        now,
+		Fare:            &fare,
+	}
+
+	return &msg, trip, passenger, conf, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h PayFareHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver charges the passenger and records the trip if all preconditions are
+// met
+func (h PayFareHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, trip, passenger, conf, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !trip.Fare.IsZero() {
+		if err := h.ctrl.MoveCoins(store, passenger.Address, conf.FareCollector, *trip.Fare); err != nil {
+			return nil, errors.Wrap(err, "cannot charge fare")
+		}
+	}
+
+	err = h.b.Save(store, trip)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store trip")
+	}
+
+	// Returns generated trip PrimaryKey as response
+	return &weave.DeliverResult{Data: trip.PrimaryKey}, nil
+}
+
+// ------------------- UpdatePassengerHandler -------------------
+
+// UpdatePassengerHandler will handle UpdatePassengerMsg
+type UpdatePassengerHandler struct {
+	auth   x.Authenticator
+	b      orm.SerialModelBucket
+	passes orm.SerialModelBucket
+}
+
+var _ weave.Handler = UpdatePassengerHandler{}
+
+// NewUpdatePassengerHandler creates a passenger update message handler
+func NewUpdatePassengerHandler(auth x.Authenticator) weave.Handler {
+	return UpdatePassengerHandler{
+		auth:   auth,
+		b:      NewPassengerBucket(),
+		passes: NewPassBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h UpdatePassengerHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*UpdatePassengerMsg, *Passenger, error) {
+	var msg UpdatePassengerMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	passenger, err := loadPassenger(store, h.b, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !h.auth.HasAddress(ctx, passenger.Address) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "passenger signature required")
+	}
+	if len(msg.Address) != 0 && !msg.Address.Equals(passenger.Address) {
+		if err := requireUnboundAddress(store, h.b, msg.Address); err != nil {
+			return nil, nil, err
+		}
+		passenger.Address = msg.Address
+	}
+	passenger.Name = msg.Name
+
+	return &msg, passenger, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h UpdatePassengerHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver updates the passenger if all preconditions are met
+func (h UpdatePassengerHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, passenger, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, passenger)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store passenger")
+	}
+
+	// Passes are checked at gates and refunded by the holder address, so
+	// they follow the passenger to a rotated address.
+	var passes []Pass
+	if err := h.passes.ByIndex(store, "passenger", passenger.PrimaryKey, &passes); err != nil {
+		return nil, errors.Wrap(err, "cannot load passes")
+	}
+	for i := range passes {
+		if passes[i].Holder.Equals(passenger.Address) {
+			continue
+		}
+		passes[i].Holder = passenger.Address
+		if err := h.passes.Save(store, &passes[i]); err != nil {
+			return nil, errors.Wrap(err, "cannot store pass")
+		}
+	}
+
+	return &weave.DeliverResult{Data: passenger.PrimaryKey}, nil
+}
+
+// ------------------- DeregisterPassengerHandler -------------------
+
+// DeregisterPassengerHandler will handle DeregisterPassengerMsg
+type DeregisterPassengerHandler struct {
+	auth     x.Authenticator
+	b        orm.SerialModelBucket
+	trips    orm.SerialModelBucket
+	journeys orm.SerialModelBucket
+}
+
+var _ weave.Handler = DeregisterPassengerHandler{}
+
+// NewDeregisterPassengerHandler creates a passenger deregistration message
+// handler
+func NewDeregisterPassengerHandler(auth x.Authenticator) weave.Handler {
+	return DeregisterPassengerHandler{
+		auth:     auth,
+		b:        NewPassengerBucket(),
+		trips:    NewTripBucket(),
+		journeys: NewJourneyBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h DeregisterPassengerHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*DeregisterPassengerMsg, *Passenger, error) {
+	var msg DeregisterPassengerMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	passenger, err := loadPassenger(store, h.b, msg.PassengerKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !h.auth.HasAddress(ctx, passenger.Address) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "passenger signature required")
+	}
+	if _, err := loadOpenTrip(store, h.trips, msg.PassengerKey); !errors.ErrNotFound.Is(err) {
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.Wrap(errors.ErrState, "passenger must tap out first")
+	}
+	if _, err := loadOpenJourney(store, h.journeys, msg.PassengerKey); !errors.ErrNotFound.Is(err) {
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.Wrap(errors.ErrState, "passenger must end the journey first")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	// The passenger is kept as a tombstone so that the primary key is never
+	// referenced again.
+	passenger.DeregisteredAt = weave.AsUnixTime(blockTime)
+
+	return &msg, passenger, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h DeregisterPassengerHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver deregisters the passenger if all preconditions are met
+func (h DeregisterPassengerHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, passenger, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, passenger)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store passenger")
+	}
+
+	return &weave.DeliverResult{Data: passenger.PrimaryKey}, nil
+}
+
+// ------------------- CreateStationHandler -------------------
+
+// CreateStationHandler will handle CreateStationMsg
+type CreateStationHandler struct {
+	auth x.Authenticator
+	b    orm.SerialModelBucket
+}
+
+var _ weave.Handler = CreateStationHandler{}
+
+// NewCreateStationHandler creates a station message handler
+func NewCreateStationHandler(auth x.Authenticator) weave.Handler {
+	return CreateStationHandler{
+		auth: auth,
+		b:    NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CreateStationHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CreateStationMsg, *Station, error) {
+	var msg CreateStationMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+
+	station := &Station{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Station:      msg.Station,
+		Escalator:    msg.Escalator,
+		Elevator:     msg.Elevator,
+		IsPeronAda:   msg.IsPeronAda,
+		TicketOffice: msg.TicketOffice,
+		TollGateEnt:  msg.TollGateEnt,
+		TollGateEx:   msg.TollGateEx,
+		EntranceExit: msg.EntranceExit,
+		Gates:        msg.Gates,
+	}
+
+	return &msg, station, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CreateStationHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver creates a station and saves if all preconditions are met
+func (h CreateStationHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, station, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, station)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store station")
+	}
+
+	// Returns generated station PrimaryKey as response
+	return &weave.DeliverResult{Data: station.PrimaryKey}, nil
+}
+
+// ------------------- UpdateStationHandler -------------------
+
+// UpdateStationHandler will handle UpdateStationMsg
+type UpdateStationHandler struct {
+	auth x.Authenticator
+	b    orm.SerialModelBucket
+}
+
+var _ weave.Handler = UpdateStationHandler{}
+
+// NewUpdateStationHandler creates a station update message handler
+func NewUpdateStationHandler(auth x.Authenticator) weave.Handler {
+	return UpdateStationHandler{
+		auth: auth,
+		b:    NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h UpdateStationHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*UpdateStationMsg, *Station, error) {
+	var msg UpdateStationMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+
+	var station Station
+	if err := h.b.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, errors.Wrap(errors.ErrState, "station is retired")
+	}
+
+	station.Station = msg.Station
+	station.Escalator = msg.Escalator
+	station.Elevator = msg.Elevator
+	station.IsPeronAda = msg.IsPeronAda
+	station.TicketOffice = msg.TicketOffice
+	station.TollGateEnt = msg.TollGateEnt
+	station.TollGateEx = msg.TollGateEx
+	station.EntranceExit = msg.EntranceExit
+	station.Gates = msg.Gates
+
+	return &msg, &station, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h UpdateStationHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver updates a station and saves if all preconditions are met
+func (h UpdateStationHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, station, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, station)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store station")
+	}
+
+	return &weave.DeliverResult{Data: station.PrimaryKey}, nil
+}
+
+// ------------------- RetireStationHandler -------------------
+
+// RetireStationHandler will handle RetireStationMsg
+type RetireStationHandler struct {
+	auth x.Authenticator
+	b    orm.SerialModelBucket
+}
+
+var _ weave.Handler = RetireStationHandler{}
+
+// NewRetireStationHandler creates a station retirement message handler
+func NewRetireStationHandler(auth x.Authenticator) weave.Handler {
+	return RetireStationHandler{
+		auth: auth,
+		b:    NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h RetireStationHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*RetireStationMsg, *Station, error) {
+	var msg RetireStationMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+
+	var station Station
+	if err := h.b.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, errors.Wrap(errors.ErrState, "station is already retired")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	station.RetiredAt = weave.AsUnixTime(blockTime)
+
+	return &msg, &station, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h RetireStationHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver retires a station if all preconditions are met
+func (h RetireStationHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, station, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, station)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store station")
+	}
+
+	return &weave.DeliverResult{Data: station.PrimaryKey}, nil
+}
+
+// ------------------- RegisterTrainHandler -------------------
+
+// RegisterTrainHandler will handle RegisterTrainMsg
+type RegisterTrainHandler struct {
+	auth    x.Authenticator
+	b       orm.SerialModelBucket
+	changes orm.SerialModelBucket
+}
+
+var _ weave.Handler = RegisterTrainHandler{}
+
+// NewRegisterTrainHandler creates a train registration message handler
+func NewRegisterTrainHandler(auth x.Authenticator) weave.Handler {
+	return RegisterTrainHandler{
+		auth:    auth,
+		b:       NewTrainBucket(),
+		changes: NewTrainChangeBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h RegisterTrainHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*RegisterTrainMsg, *Train, *TrainChange, error) {
+	var msg RegisterTrainMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireFleetManager(ctx, store, h.auth); err != nil {
+		return nil, nil, nil, err
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	train := &Train{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Address:   msg.Address,
+		Model:     msg.Model,
+		Capacity:  msg.Capacity,
+		CarCount:  msg.CarCount,
+		HomeDepot: msg.HomeDepot,
+	}
+	change := &TrainChange{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Kind:      TrainRegistered,
+		Address:   msg.Address,
+		ChangedAt: weave.AsUnixTime(blockTime),
+	}
+
+	return &msg, train, change, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h RegisterTrainHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver creates a train and saves if all preconditions are met
+func (h RegisterTrainHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, train, change, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, train)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store train")
+	}
+	change.TrainKey = train.PrimaryKey
+	if err := h.changes.Save(store, change); err != nil {
+		return nil, errors.Wrap(err, "cannot store train change")
+	}
+
+	// Returns generated train PrimaryKey as response
+	return &weave.DeliverResult{Data: train.PrimaryKey}, nil
+}
+
+// ------------------- ReassignTrainHandler -------------------
+
+// ReassignTrainHandler will handle ReassignTrainMsg
+type ReassignTrainHandler struct {
+	auth    x.Authenticator
+	b       orm.SerialModelBucket
+	changes orm.SerialModelBucket
+}
+
+var _ weave.Handler = ReassignTrainHandler{}
+
+// NewReassignTrainHandler creates a train address reassignment message
+// handler
+func NewReassignTrainHandler(auth x.Authenticator) weave.Handler {
+	return ReassignTrainHandler{
+		auth:    auth,
+		b:       NewTrainBucket(),
+		changes: NewTrainChangeBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ReassignTrainHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ReassignTrainMsg, *Train, *TrainChange, error) {
+	var msg ReassignTrainMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireFleetManager(ctx, store, h.auth); err != nil {
+		return nil, nil, nil, err
+	}
+
+	train, err := loadActiveTrain(store, h.b, msg.TrainKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	change := &TrainChange{
+		Metadata:        &weave.Metadata{Schema: 1},
+		TrainKey:        train.PrimaryKey,
+		Kind:            TrainReassigned,
+		Address:         msg.Address,
+		PreviousAddress: train.Address,
+		ChangedAt:       weave.AsUnixTime(blockTime),
+	}
+	train.Address = msg.Address
+
+	return &msg, train, change, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ReassignTrainHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver changes the train address if all preconditions are met
+func (h ReassignTrainHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, train, change, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, train)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store train")
+	}
+	if err := h.changes.Save(store, change); err != nil {
+		return nil, errors.Wrap(err, "cannot store train change")
+	}
+
+	return &weave.DeliverResult{Data: train.PrimaryKey}, nil
+}
+
+// ------------------- DecommissionTrainHandler -------------------
+
+// DecommissionTrainHandler will handle DecommissionTrainMsg
+type DecommissionTrainHandler struct {
+	auth    x.Authenticator
+	b       orm.SerialModelBucket
+	changes orm.SerialModelBucket
+}
+
+var _ weave.Handler = DecommissionTrainHandler{}
+
+// NewDecommissionTrainHandler creates a train decommission message handler
+func NewDecommissionTrainHandler(auth x.Authenticator) weave.Handler {
+	return DecommissionTrainHandler{
+		auth:    auth,
+		b:       NewTrainBucket(),
+		changes: NewTrainChangeBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h DecommissionTrainHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*DecommissionTrainMsg, *Train, *TrainChange, error) {
+	var msg DecommissionTrainMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireFleetManager(ctx, store, h.auth); err != nil {
+		return nil, nil, nil, err
+	}
+
+	train, err := loadActiveTrain(store, h.b, msg.TrainKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	now := weave.AsUnixTime(blockTime)
+
+	train.DecommissionedAt = now
+	change := &TrainChange{
+		Metadata:  &weave.Metadata{Schema: 1},
+		TrainKey:  train.PrimaryKey,
+		Kind:      TrainDecommissioned,
+		Address:   train.Address,
+		ChangedAt: now,
+	}
+
+	return &msg, train, change, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h DecommissionTrainHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver decommissions the train if all preconditions are met
+func (h DecommissionTrainHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, train, change, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, train)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store train")
+	}
+	if err := h.changes.Save(store, change); err != nil {
+		return nil, errors.Wrap(err, "cannot store train change")
+	}
+
+	return &weave.DeliverResult{Data: train.PrimaryKey}, nil
+}
+
+// ------------------- ReportEquipmentStatusHandler -------------------
+
+// ReportEquipmentStatusHandler will handle ReportEquipmentStatusMsg
+type ReportEquipmentStatusHandler struct {
+	auth     x.Authenticator
+	b        orm.ModelBucket
+	stations orm.SerialModelBucket
+}
+
+var _ weave.Handler = ReportEquipmentStatusHandler{}
+
+// NewReportEquipmentStatusHandler creates an equipment status message handler
+func NewReportEquipmentStatusHandler(auth x.Authenticator) weave.Handler {
+	return ReportEquipmentStatusHandler{
+		auth:     auth,
+		b:        NewEquipmentBucket(),
+		stations: NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ReportEquipmentStatusHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ReportEquipmentStatusMsg, *Equipment, error) {
+	var msg ReportEquipmentStatusMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	maintainer, err := signingMaintainer(ctx, h.auth, conf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var station Station
+	if err := h.stations.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, errors.Wrap(errors.ErrState, "station is retired")
+	}
+
+	var prev Equipment
+	switch err := h.b.One(store, EquipmentKey(msg.StationKey, msg.Identifier), &prev); {
+	case err == nil:
+		if prev.Type != msg.Type {
+			return nil, nil, errors.Wrapf(errors.ErrInput, "%s is a %s", msg.Identifier, prev.Type)
+		}
+	case errors.ErrNotFound.Is(err):
+		// First report of this unit.
+	default:
+		return nil, nil, errors.Wrap(err, "cannot load equipment")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	equipment := &Equipment{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: msg.StationKey,
+		Type:       msg.Type,
+		Identifier: msg.Identifier,
+		Status:     msg.Status,
+		UpdatedAt:  weave.AsUnixTime(blockTime),
+		ReportedBy: maintainer,
+	}
+
+	return &msg, equipment, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ReportEquipmentStatusHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the equipment status if all preconditions are met
+func (h ReportEquipmentStatusHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, equipment, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := EquipmentKey(equipment.StationKey, equipment.Identifier)
+	if _, err := h.b.Put(store, key, equipment); err != nil {
+		return nil, errors.Wrap(err, "cannot store equipment")
+	}
+
+	return &weave.DeliverResult{Data: key}, nil
+}
+
+// ------------------- SetConnectionHandler -------------------
+
+// SetConnectionHandler will handle SetConnectionMsg
+type SetConnectionHandler struct {
+	auth     x.Authenticator
+	b        orm.SerialModelBucket
+	stations orm.SerialModelBucket
+}
+
+var _ weave.Handler = SetConnectionHandler{}
+
+// NewSetConnectionHandler creates a station connection message handler
+func NewSetConnectionHandler(auth x.Authenticator) weave.Handler {
+	return SetConnectionHandler{
+		auth:     auth,
+		b:        NewConnectionBucket(),
+		stations: NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver. The
+// returned connection reuses the key of the connection it replaces.
+func (h SetConnectionHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SetConnectionMsg, *Connection, error) {
+	var msg SetConnectionMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+	if err := requireStations(store, h.stations, [][]byte{msg.FromStationKey, msg.ToStationKey}); err != nil {
+		return nil, nil, err
+	}
+
+	conn := &Connection{
+		Metadata:       &weave.Metadata{Schema: 1},
+		FromStationKey: msg.FromStationKey,
+		ToStationKey:   msg.ToStationKey,
+		TravelMinutes:  msg.TravelMinutes,
+	}
+	var existing []Connection
+	if err := h.b.ByIndex(store, "pair", ConnectionPairKey(msg.FromStationKey, msg.ToStationKey), &existing); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load connection")
+	}
+	if len(existing) != 0 {
+		conn.PrimaryKey = existing[0].PrimaryKey
+	} else if msg.TravelMinutes == 0 {
+		return nil, nil, errors.Wrap(errors.ErrNotFound, "stations are not connected")
+	}
+
+	return &msg, conn, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h SetConnectionHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores or removes the connection if all preconditions are met
+func (h SetConnectionHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, conn, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if conn.TravelMinutes == 0 {
+		if err := h.b.Delete(store, conn.PrimaryKey); err != nil {
+			return nil, errors.Wrap(err, "cannot delete connection")
+		}
+		return &weave.DeliverResult{Data: conn.PrimaryKey}, nil
+	}
+
+	err = h.b.Save(store, conn)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store connection")
+	}
+
+	return &weave.DeliverResult{Data: conn.PrimaryKey}, nil
+}
+
+// ------------------- PublishTimetableHandler -------------------
+
+// PublishTimetableHandler will handle PublishTimetableMsg
+type PublishTimetableHandler struct {
+	auth  x.Authenticator
+	b     orm.SerialModelBucket
+	lines orm.SerialModelBucket
+}
+
+var _ weave.Handler = PublishTimetableHandler{}
+
+// NewPublishTimetableHandler creates a timetable publishing message handler
+func NewPublishTimetableHandler(auth x.Authenticator) weave.Handler {
+	return PublishTimetableHandler{
+		auth:  auth,
+		b:     NewTimetableBucket(),
+		lines: NewLineBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h PublishTimetableHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*PublishTimetableMsg, *Timetable, error) {
+	var msg PublishTimetableMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+
+	var line Line
+	if err := h.lines.ByID(store, msg.LineKey, &line); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load line")
+	}
+	if err := requireLineStops(&line, msg.Stops); err != nil {
+		return nil, nil, err
+	}
+
+	var active []Timetable
+	if err := h.b.ByIndex(store, "active", ActiveTimetableIndexKey(msg.LineKey, msg.Service, msg.Direction), &active); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load active timetable")
+	}
+	if len(active) != 0 {
+		return nil, nil, errors.Wrap(errors.ErrDuplicate, "active timetable exists, supersede it instead")
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "block time")
+	}
+
+	timetable := &Timetable{
+		Metadata:    &weave.Metadata{Schema: 1},
+		LineKey:     msg.LineKey,
+		Service:     msg.Service,
+		Direction:   msg.Direction,
+		Stops:       msg.Stops,
+		PublishedAt: weave.AsUnixTime(now),
+	}
+
+	return &msg, timetable, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h PublishTimetableHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the timetable if all preconditions are met
+func (h PublishTimetableHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, timetable, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, timetable)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store timetable")
+	}
+
+	return &weave.DeliverResult{Data: timetable.PrimaryKey}, nil
+}
+
+// ------------------- SupersedeTimetableHandler -------------------
+
+// SupersedeTimetableHandler will handle SupersedeTimetableMsg
+type SupersedeTimetableHandler struct {
+	auth  x.Authenticator
+	b     orm.SerialModelBucket
+	lines orm.SerialModelBucket
+}
+
+var _ weave.Handler = SupersedeTimetableHandler{}
+
+// NewSupersedeTimetableHandler creates a timetable superseding message handler
+func NewSupersedeTimetableHandler(auth x.Authenticator) weave.Handler {
+	return SupersedeTimetableHandler{
+		auth:  auth,
+		b:     NewTimetableBucket(),
+		lines: NewLineBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver. It
+// returns the superseded timetable together with its replacement.
+func (h SupersedeTimetableHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SupersedeTimetableMsg, *Timetable, *Timetable, error) {
+	var msg SupersedeTimetableMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, nil, err
+	}
+
+	var old Timetable
+	if err := h.b.ByID(store, msg.TimetableKey, &old); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load timetable")
+	}
+	if !old.IsActive() {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "timetable is already superseded")
+	}
+
+	var line Line
+	if err := h.lines.ByID(store, old.LineKey, &line); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load line")
+	}
+	if err := requireLineStops(&line, msg.Stops); err != nil {
+		return nil, nil, nil, err
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "block time")
+	}
+
+	old.SupersededAt = weave.AsUnixTime(now)
+	timetable := &Timetable{
+		Metadata:    &weave.Metadata{Schema: 1},
+		LineKey:     old.LineKey,
+		Service:     old.Service,
+		Direction:   old.Direction,
+		Stops:       msg.Stops,
+		PublishedAt: weave.AsUnixTime(now),
+	}
+
+	return &msg, &old, timetable, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h SupersedeTimetableHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver replaces the active timetable if all preconditions are met
+func (h SupersedeTimetableHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, old, timetable, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The superseded timetable must leave the active index before its
+	// replacement is stored and only then it can point to it.
+	if err := h.b.Save(store, old); err != nil {
+		return nil, errors.Wrap(err, "cannot store superseded timetable")
+	}
+	if err := h.b.Save(store, timetable); err != nil {
+		return nil, errors.Wrap(err, "cannot store timetable")
+	}
+	old.SupersededBy = timetable.PrimaryKey
+	if err := h.b.Save(store, old); err != nil {
+		return nil, errors.Wrap(err, "cannot store superseded timetable")
+	}
+
+	return &weave.DeliverResult{Data: timetable.PrimaryKey}, nil
+}
+
+// ------------------- ScheduleStopHandler -------------------
+
+// ScheduleStopHandler will handle ScheduleStopMsg
+type ScheduleStopHandler struct {
+	auth     x.Authenticator
+	b        orm.SerialModelBucket
+	stations orm.SerialModelBucket
+	trains   orm.SerialModelBucket
+	lines    orm.SerialModelBucket
+}
+
+var _ weave.Handler = ScheduleStopHandler{}
+
+// NewScheduleStopHandler creates a stop scheduling message handler
+func NewScheduleStopHandler(auth x.Authenticator) weave.Handler {
+	return ScheduleStopHandler{
+		auth:     auth,
+		b:        NewScheduledStopBucket(),
+		stations: NewStationBucket(),
+		trains:   NewTrainBucket(),
+		lines:    NewLineBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ScheduleStopHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ScheduleStopMsg, *ScheduledStop, error) {
+	var msg ScheduleStopMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := requireAdmin(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := loadActiveTrain(store, h.trains, msg.TrainKey); err != nil {
+		return nil, nil, err
+	}
+	var station Station
+	if err := h.stations.ByID(store, msg.StationKey, &station); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load station")
+	}
+	if station.IsRetired() {
+		return nil, nil, errors.Wrap(errors.ErrState, "station is retired")
+	}
+	var line Line
+	if err := h.lines.ByID(store, msg.LineKey, &line); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load line")
+	}
+	if !line.HasStation(msg.StationKey) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "station is not on the line")
+	}
+
+	scheduled := &ScheduledStop{
+		Metadata:   &weave.Metadata{Schema: 1},
+		TrainKey:   msg.TrainKey,
+		StationKey: msg.StationKey,
+		LineKey:    msg.LineKey,
+		PlannedAt:  msg.PlannedAt,
+	}
+
+	return &msg, scheduled, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ScheduleStopHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the scheduled stop if all preconditions are met
+func (h ScheduleStopHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, stop, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, stop)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store scheduled stop")
+	}
+
+	return &weave.DeliverResult{Data: stop.PrimaryKey}, nil
+}
+
+// ------------------- OpenIncidentHandler -------------------
+
+// OpenIncidentHandler will handle OpenIncidentMsg
+type OpenIncidentHandler struct {
+	auth     x.Authenticator
+	b        orm.SerialModelBucket
+	stations orm.SerialModelBucket
+}
+
+var _ weave.Handler = OpenIncidentHandler{}
+
+// NewOpenIncidentHandler creates an incident announcing message handler
+func NewOpenIncidentHandler(auth x.Authenticator) weave.Handler {
+	return OpenIncidentHandler{
+		auth:     auth,
+		b:        NewIncidentBucket(),
+		stations: NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h OpenIncidentHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*OpenIncidentMsg, *Incident, error) {
+	var msg OpenIncidentMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	operator, err := signingOperator(ctx, store, h.auth)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := requireStations(store, h.stations, msg.StationKeys); err != nil {
+		return nil, nil, err
+	}
+
+	incident := &Incident{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Severity:      msg.Severity,
+		StationKeys:   msg.StationKeys,
+		StartedAt:     msg.StartedAt,
+		ExpectedEndAt: msg.ExpectedEndAt,
+		Messages:      msg.Messages,
+		OpenedBy:      operator,
+	}
+
+	return &msg, incident, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h OpenIncidentHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the incident if all preconditions are met
+func (h OpenIncidentHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, incident, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, incident)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store incident")
+	}
+
+	return &weave.DeliverResult{Data: incident.PrimaryKey}, nil
+}
+
+// ------------------- UpdateIncidentHandler -------------------
+
+// UpdateIncidentHandler will handle UpdateIncidentMsg
+type UpdateIncidentHandler struct {
+	auth     x.Authenticator
+	b        orm.SerialModelBucket
+	stations orm.SerialModelBucket
+}
+
+var _ weave.Handler = UpdateIncidentHandler{}
+
+// NewUpdateIncidentHandler creates an incident update message handler
+func NewUpdateIncidentHandler(auth x.Authenticator) weave.Handler {
+	return UpdateIncidentHandler{
+		auth:     auth,
+		b:        NewIncidentBucket(),
+		stations: NewStationBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h UpdateIncidentHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*UpdateIncidentMsg, *Incident, error) {
+	var msg UpdateIncidentMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if _, err := signingOperator(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+	incident, err := loadOpenIncident(store, h.b, msg.IncidentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := validateExpectedEnd(incident.StartedAt, msg.ExpectedEndAt); err != nil {
+		return nil, nil, errors.Wrap(err, "expected end")
+	}
+	if err := requireStations(store, h.stations, msg.StationKeys); err != nil {
+		return nil, nil, err
+	}
+
+	incident.Severity = msg.Severity
+	incident.StationKeys = msg.StationKeys
+	incident.ExpectedEndAt = msg.ExpectedEndAt
+	incident.Messages = msg.Messages
+
+	return &msg, incident, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h UpdateIncidentHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver updates the incident if all preconditions are met
+func (h UpdateIncidentHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, incident, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, incident)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store incident")
+	}
+
+	return &weave.DeliverResult{Data: incident.PrimaryKey}, nil
+}
+
+// ------------------- ResolveIncidentHandler -------------------
+
+// ResolveIncidentHandler will handle ResolveIncidentMsg
+type ResolveIncidentHandler struct {
+	auth x.Authenticator
+	b    orm.SerialModelBucket
+}
+
+var _ weave.Handler = ResolveIncidentHandler{}
+
+// NewResolveIncidentHandler creates an incident resolving message handler
+func NewResolveIncidentHandler(auth x.Authenticator) weave.Handler {
+	return ResolveIncidentHandler{
+		auth: auth,
+		b:    NewIncidentBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ResolveIncidentHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ResolveIncidentMsg, *Incident, error) {
+	var msg ResolveIncidentMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if _, err := signingOperator(ctx, store, h.auth); err != nil {
+		return nil, nil, err
+	}
+	incident, err := loadOpenIncident(store, h.b, msg.IncidentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	incident.ResolvedAt = weave.AsUnixTime(blockTime)
+
+	return &msg, incident, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ResolveIncidentHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver resolves the incident if all preconditions are met
+func (h ResolveIncidentHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, incident, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, incident)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store incident")
+	}
+
+	return &weave.DeliverResult{Data: incident.PrimaryKey}, nil
+}
+
+// ------------------- CreateCurrencyHandler -------------------
+
+// CreateCurrencyHandler will handle currency.CreateMsg
+type CreateCurrencyHandler struct {
+	auth x.Authenticator
+	b    *currency.TokenInfoBucket
+}
+
+var _ weave.Handler = CreateCurrencyHandler{}
+
+// NewCreateCurrencyHandler creates a currency registration message handler.
+// The issuer is read from the metro configuration on every message, so an
+// updated configuration takes effect without restarting the node.
+func NewCreateCurrencyHandler(auth x.Authenticator) weave.Handler {
+	return CreateCurrencyHandler{
+		auth: auth,
+		b:    currency.NewTokenInfoBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CreateCurrencyHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*currency.CreateMsg, error) {
+	var msg currency.CreateMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.CurrencyIssuer) == 0 {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "no currency issuer configured")
+	}
+	if !h.auth.HasAddress(ctx, conf.CurrencyIssuer) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "currency issuer signature required")
+	}
+
+	// Currency can be registered only once and must not be updated.
+	switch obj, err := h.b.Get(store, msg.Ticker); {
+	case err != nil:
+		return nil, errors.Wrap(err, "cannot load currency")
+	case obj != nil:
+		return nil, errors.Wrapf(errors.ErrDuplicate, "ticker %s", msg.Ticker)
+	}
+
+	return &msg, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CreateCurrencyHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver registers the currency if all preconditions are met
+func (h CreateCurrencyHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.b.Save(store, currency.NewTokenInfo(msg.Ticker, msg.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store currency")
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// signingOperator returns the address of the operator that signed the
+// transaction.
+func signingOperator(ctx weave.Context, store weave.KVStore, auth x.Authenticator) (weave.Address, error) {
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range conf.Operators {
+		if auth.HasAddress(ctx, o) {
+			return o, nil
+		}
+	}
+	return nil, errors.Wrap(errors.ErrUnauthorized, "operator signature required")
+}
+
+// loadOpenIncident returns the incident stored under given key. Resolved
+// incidents cannot be changed and result in an error.
+func loadOpenIncident(store weave.ReadOnlyKVStore, incidents orm.SerialModelBucket, key []byte) (*Incident, error) {
+	var incident Incident
+	if err := incidents.ByID(store, key, &incident); err != nil {
+		return nil, errors.Wrap(err, "cannot load incident")
+	}
+	if incident.IsResolved() {
+		return nil, errors.Wrap(errors.ErrState, "incident is resolved")
+	}
+	return &incident, nil
+}
+
+// signingMaintainer returns the address of the maintainer that signed the
+// transaction.
+func signingMaintainer(ctx weave.Context, auth x.Authenticator, conf *Configuration) (weave.Address, error) {
+	for _, m := range conf.Maintainers {
+		if auth.HasAddress(ctx, m) {
+			return m, nil
+		}
+	}
+	return nil, errors.Wrap(errors.ErrUnauthorized, "maintainer signature required")
+}
+
+// loadActiveTrain returns the train stored under given key. Decommissioned
+// trains result in an error.
+func loadActiveTrain(store weave.ReadOnlyKVStore, trains orm.SerialModelBucket, key []byte) (*Train, error) {
+	var train Train
+	if err := trains.ByID(store, key, &train); err != nil {
+		return nil, errors.Wrap(err, "cannot load train")
+	}
+	if train.IsDecommissioned() {
+		return nil, errors.Wrap(errors.ErrState, "train is decommissioned")
+	}
+	return &train, nil
+}
+
+// loadPassenger returns the passenger stored under given key. Deregistered
+// passengers cannot be referenced and result in an error.
+func loadPassenger(store weave.ReadOnlyKVStore, passengers orm.SerialModelBucket, key []byte) (*Passenger, error) {
+	var passenger Passenger
+	if err := passengers.ByID(store, key, &passenger); err != nil {
+		return nil, errors.Wrap(err, "cannot load passenger")
+	}
+	if passenger.IsDeregistered() {
+		return nil, errors.Wrap(errors.ErrState, "passenger is deregistered")
+	}
+	return &passenger, nil
+}
+
+// loadOpenTrip returns the trip that given passenger is currently on. It
+// returns ErrNotFound if the passenger did not tap in.
+func loadOpenTrip(store weave.ReadOnlyKVStore, trips orm.SerialModelBucket, passengerKey []byte) (*Trip, error) {
+	var open []Trip
+	if err := trips.ByIndex(store, "open", passengerKey, &open); err != nil {
+		return nil, errors.Wrap(err, "cannot load open trip")
+	}
+	if len(open) == 0 {
+		return nil, errors.Wrap(errors.ErrNotFound, "passenger did not tap in")
+	}
+	return &open[0], nil
+}
+
+// requireUnboundAddress ensures that no passenger is registered with given
+// address.
+func requireUnboundAddress(store weave.ReadOnlyKVStore, passengers orm.SerialModelBucket, address weave.Address) error {
+	var bound []Passenger
+	if err := passengers.ByIndex(store, "address", address, &bound); err != nil {
+		return errors.Wrap(err, "cannot load passenger")
+	}
+	if len(bound) != 0 {
+		return errors.Wrapf(errors.ErrDuplicate, "passenger already registered with address %s", address)
+	}
+	return nil
+}
+
+// requireGate ensures the transaction is signed by one of the toll gates
+// installed at given station.
+func requireGate(ctx weave.Context, auth x.Authenticator, station *Station) error {
+	for _, gate := range station.Gates {
+		if auth.HasAddress(ctx, gate) {
+			return nil
+		}
+	}
+	return errors.Wrap(errors.ErrUnauthorized, "station gate signature required")
+}
+
+// requireAdmin ensures the transaction is signed by the admin set in the
+// metro configuration.
+func requireAdmin(ctx weave.Context, store weave.KVStore, auth x.Authenticator) error {
+	conf, err := loadConf(store)
+	if err != nil {
+		return err
+	}
+	if !auth.HasAddress(ctx, conf.Admin) {
+		return errors.Wrap(errors.ErrUnauthorized, "admin signature required")
+	}
+	return nil
+}
+
+// requireFleetManager ensures the transaction is signed by the fleet manager
+// set in the metro configuration.
+func requireFleetManager(ctx weave.Context, store weave.KVStore, auth x.Authenticator) error {
+	conf, err := loadConf(store)
+	if err != nil {
+		return err
+	}
+	if !auth.HasAddress(ctx, conf.FleetManager) {
+		return errors.Wrap(errors.ErrUnauthorized, "fleet manager signature required")
+	}
+	return nil
+}
+
+// requireStations ensures that all given station keys reference existing
+// stations.
+func requireStations(store weave.KVStore, stations orm.SerialModelBucket, keys [][]byte) error {
+	for _, k := range keys {
+		if err := stations.Has(store, k); err != nil {
+			return errors.Wrapf(err, "station %x", k)
+		}
+	}
+	return nil
+}
+
+// requireOpenStations ensures that all given station keys reference existing
+// stations that are not retired.
+func requireOpenStations(store weave.KVStore, stations orm.SerialModelBucket, keys [][]byte) error {
+	for _, k := range keys {
+		var station Station
+		if err := stations.ByID(store, k, &station); err != nil {
+			return errors.Wrapf(err, "station %x", k)
+		}
+		if station.IsRetired() {
+			return errors.Wrapf(errors.ErrState, "station %x is retired", k)
+		}
+	}
+	return nil
+}
+
+// requireUnusedLineName ensures that no line other than the one with given
+// key is using the name.
+func requireUnusedLineName(store weave.ReadOnlyKVStore, lines orm.SerialModelBucket, name string, lineKey []byte) error {
+	var named []Line
+	if err := lines.ByIndex(store, "name", []byte(name), &named); err != nil {
+		return errors.Wrap(err, "cannot load line")
+	}
+	for _, l := range named {
+		if !bytes.Equal(l.PrimaryKey, lineKey) {
+			return errors.Wrapf(errors.ErrDuplicate, "line %q already exists", name)
+		}
+	}
+	return nil
 }
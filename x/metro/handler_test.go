@@ -0,0 +1,1194 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
+)
+
+func TestTrainArriveStationEventHandler(t *testing.T) {
+	trainSigner := weavetest.NewCondition()
+	otherSigner := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now())
+
+	cases := map[string]struct {
+		signer         weave.Condition
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+	}{
+		"train reports arrival at a known station": {
+			signer: trainSigner,
+			msg: &TrainArriveStationEventMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				StationKey: weavetest.SequenceID(1),
+				TrainKey:   weavetest.SequenceID(1),
+			},
+		},
+		"unknown station": {
+			signer: trainSigner,
+			msg: &TrainArriveStationEventMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				StationKey: weavetest.SequenceID(99),
+				TrainKey:   weavetest.SequenceID(1),
+			},
+			wantCheckErr:   errors.ErrNotFound,
+			wantDeliverErr: errors.ErrNotFound,
+		},
+		"unknown train": {
+			signer: trainSigner,
+			msg: &TrainArriveStationEventMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				StationKey: weavetest.SequenceID(1),
+				TrainKey:   weavetest.SequenceID(99),
+			},
+			wantCheckErr:   errors.ErrNotFound,
+			wantDeliverErr: errors.ErrNotFound,
+		},
+		"signer is not the train": {
+			signer: otherSigner,
+			msg: &TrainArriveStationEventMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				StationKey: weavetest.SequenceID(1),
+				TrainKey:   weavetest.SequenceID(1),
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"missing station key": {
+			signer: trainSigner,
+			msg: &TrainArriveStationEventMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				TrainKey: weavetest.SequenceID(1),
+			},
+			wantCheckErr:   errors.ErrEmpty,
+			wantDeliverErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			saveAll(t, db, NewStationBucket(), &Station{
+				Metadata: &weave.Metadata{Schema: 1},
+				Station:  "fahrettin altay",
+			})
+			saveAll(t, db, NewTrainBucket(), &Train{
+				Metadata: &weave.Metadata{Schema: 1},
+				Address:  trainSigner.Address(),
+			})
+
+			auth := &weavetest.Auth{Signer: tc.signer}
+			h := NewTrainArriveStationEventHandler(auth, &weavetest.Cron{})
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			if _, err := h.Check(ctx, db, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("check error: want %v, got %+v", tc.wantCheckErr, err)
+			}
+			res, err := h.Deliver(ctx, db, tx)
+			if !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("deliver error: want %v, got %+v", tc.wantDeliverErr, err)
+			}
+			if tc.wantDeliverErr != nil {
+				return
+			}
+
+			var event TrainArriveStationEvent
+			if err := NewTrainArriveStationEventBucket().ByID(db, res.Data, &event); err != nil {
+				t.Fatalf("cannot load stored event: %s", err)
+			}
+			if event.ArrivedAt != now {
+				t.Fatalf("want arrival time %v, got %v", now, event.ArrivedAt)
+			}
+		})
+	}
+}
+
+// saveAll stores given models in the bucket or fails the test.
+func saveAll(t testing.TB, db weave.KVStore, b orm.SerialModelBucket, models ...orm.SerialModel) {
+	t.Helper()
+	for _, m := range models {
+		if err := b.Save(db, m); err != nil {
+			t.Fatalf("cannot save %T: %s", m, err)
+		}
+	}
+}
+
+func TestCreateLineHandler(t *testing.T) {
+	admin := weavetest.NewCondition()
+	other := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		signer         weave.Condition
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+	}{
+		"admin creates a line": {
+			signer: admin,
+			msg: &CreateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Name:        "M2",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+			},
+		},
+		"signer is not the admin": {
+			signer: other,
+			msg: &CreateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Name:        "M2",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"unknown station": {
+			signer: admin,
+			msg: &CreateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Name:        "M2",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(99)},
+			},
+			wantCheckErr:   errors.ErrNotFound,
+			wantDeliverErr: errors.ErrNotFound,
+		},
+		"retired station": {
+			signer: admin,
+			msg: &CreateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Name:        "M2",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(3)},
+			},
+			wantCheckErr:   errors.ErrState,
+			wantDeliverErr: errors.ErrState,
+		},
+		"line name already used": {
+			signer: admin,
+			msg: &CreateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Name:        "M1",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+			},
+			wantCheckErr:   errors.ErrDuplicate,
+			wantDeliverErr: errors.ErrDuplicate,
+		},
+		"station listed twice": {
+			signer: admin,
+			msg: &CreateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Name:        "M2",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(1)},
+			},
+			wantCheckErr:   errors.ErrDuplicate,
+			wantDeliverErr: errors.ErrDuplicate,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := newLineTestStore(t, admin)
+
+			auth := &weavetest.Auth{Signer: tc.signer}
+			h := NewCreateLineHandler(auth)
+			ctx := weave.WithBlockTime(context.Background(), time.Now())
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			if _, err := h.Check(ctx, db, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("check error: want %v, got %+v", tc.wantCheckErr, err)
+			}
+			res, err := h.Deliver(ctx, db, tx)
+			if !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("deliver error: want %v, got %+v", tc.wantDeliverErr, err)
+			}
+			if tc.wantDeliverErr != nil {
+				return
+			}
+
+			var line Line
+			if err := NewLineBucket().ByID(db, res.Data, &line); err != nil {
+				t.Fatalf("cannot load stored line: %s", err)
+			}
+			if line.Name != "M2" || len(line.StationKeys) != 2 {
+				t.Fatalf("unexpected line: %+v", line)
+			}
+		})
+	}
+}
+
+func TestUpdateLineHandler(t *testing.T) {
+	admin := weavetest.NewCondition()
+	other := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		signer         weave.Condition
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+	}{
+		"admin extends a line": {
+			signer: admin,
+			msg: &UpdateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				LineKey:     weavetest.SequenceID(1),
+				Name:        "M1",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(4)},
+			},
+		},
+		"signer is not the admin": {
+			signer: other,
+			msg: &UpdateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				LineKey:     weavetest.SequenceID(1),
+				Name:        "M1",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(4)},
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"unknown line": {
+			signer: admin,
+			msg: &UpdateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				LineKey:     weavetest.SequenceID(99),
+				Name:        "M9",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+			},
+			wantCheckErr:   errors.ErrNotFound,
+			wantDeliverErr: errors.ErrNotFound,
+		},
+		"unknown station": {
+			signer: admin,
+			msg: &UpdateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				LineKey:     weavetest.SequenceID(1),
+				Name:        "M1",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(99)},
+			},
+			wantCheckErr:   errors.ErrNotFound,
+			wantDeliverErr: errors.ErrNotFound,
+		},
+		"retired station": {
+			signer: admin,
+			msg: &UpdateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				LineKey:     weavetest.SequenceID(1),
+				Name:        "M1",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(3)},
+			},
+			wantCheckErr:   errors.ErrState,
+			wantDeliverErr: errors.ErrState,
+		},
+		"renamed to the name of another line": {
+			signer: admin,
+			msg: &UpdateLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				LineKey:     weavetest.SequenceID(2),
+				Name:        "M1",
+				Color:       "#e30613",
+				StationKeys: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(4)},
+			},
+			wantCheckErr:   errors.ErrDuplicate,
+			wantDeliverErr: errors.ErrDuplicate,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := newLineTestStore(t, admin)
+			saveAll(t, db, NewLineBucket(), &Line{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Name:        "M2",
+				Color:       "#ffd500",
+				StationKeys: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(4)},
+			})
+
+			auth := &weavetest.Auth{Signer: tc.signer}
+			h := NewUpdateLineHandler(auth)
+			ctx := weave.WithBlockTime(context.Background(), time.Now())
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			if _, err := h.Check(ctx, db, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("check error: want %v, got %+v", tc.wantCheckErr, err)
+			}
+			if _, err := h.Deliver(ctx, db, tx); !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("deliver error: want %v, got %+v", tc.wantDeliverErr, err)
+			}
+			if tc.wantDeliverErr != nil {
+				return
+			}
+
+			var line Line
+			if err := NewLineBucket().ByID(db, weavetest.SequenceID(1), &line); err != nil {
+				t.Fatalf("cannot load stored line: %s", err)
+			}
+			if len(line.StationKeys) != 3 {
+				t.Fatalf("unexpected line: %+v", line)
+			}
+		})
+	}
+}
+
+// newLineTestStore returns a store with the metro configuration, stations 1,
+// 2 and 4 open, station 3 retired and line "M1" running between stations 1
+// and 2.
+func newLineTestStore(t testing.TB, admin weave.Condition) weave.KVStore {
+	t.Helper()
+	db := store.MemStore()
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Admin:    admin.Address(),
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "levent"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "gayrettepe"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "sisli", RetiredAt: 1},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "taksim"},
+	)
+	saveAll(t, db, NewLineBucket(), &Line{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Name:        "M1",
+		Color:       "#e30613",
+		StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+	})
+	return db
+}
+
+func TestTapInTapOut(t *testing.T) {
+	entryGate := weavetest.NewCondition()
+	exitGate := weavetest.NewCondition()
+	retiredGate := weavetest.NewCondition()
+	passengerSigner := weavetest.NewCondition()
+	collector := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a", Gates: []weave.Address{entryGate.Address()}},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "c", Gates: []weave.Address{exitGate.Address()}},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "d", Gates: []weave.Address{retiredGate.Address()}, RetiredAt: 1},
+	)
+	saveAll(t, db, NewLineBucket(), &Line{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Name:        "M1",
+		Color:       "#e30613",
+		StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(3)},
+	})
+	saveAll(t, db, NewPassengerBucket(), &Passenger{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  passengerSigner.Address(),
+	})
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Admin:         weavetest.NewCondition().Address(),
+		FareCollector: collector,
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if _, err := NewFareTableBucket().Put(db, fareTableKey, &FareTable{
+		Metadata: &weave.Metadata{Schema: 1},
+		BaseFare: coin.NewCoin(1, 0, "METR"),
+		StopFare: coin.NewCoin(0, 500000000, "METR"),
+	}); err != nil {
+		t.Fatalf("cannot save fare table: %s", err)
+	}
+	ctrl := cash.NewController(cash.NewBucket())
+	if err := ctrl.CoinMint(db, passengerSigner.Address(), coin.NewCoin(10, 0, "METR")); err != nil {
+		t.Fatalf("cannot fund passenger: %s", err)
+	}
+
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	passengerKey := weavetest.SequenceID(1)
+
+	tapIn := func(gate weave.Condition, station uint64) error {
+		h := NewTapInHandler(&weavetest.Auth{Signer: gate})
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &TapInMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PassengerKey: passengerKey,
+			StationKey:   weavetest.SequenceID(station),
+		}})
+		return err
+	}
+	tapOut := func(gate weave.Condition, station uint64) error {
+		h := NewTapOutHandler(&weavetest.Auth{Signer: gate}, ctrl)
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &TapOutMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PassengerKey: passengerKey,
+			StationKey:   weavetest.SequenceID(station),
+		}})
+		return err
+	}
+
+	if err := tapOut(exitGate, 3); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want tap out without tap in to fail, got %+v", err)
+	}
+	if err := tapIn(exitGate, 1); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want tap in through a gate of another station to fail, got %+v", err)
+	}
+	if err := tapIn(entryGate, 1); err != nil {
+		t.Fatalf("cannot tap in: %+v", err)
+	}
+	if err := tapIn(entryGate, 1); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want second tap in to fail, got %+v", err)
+	}
+	if err := tapOut(retiredGate, 4); !errors.ErrState.Is(err) {
+		t.Fatalf("want tap out at a retired station to fail, got %+v", err)
+	}
+	if err := tapOut(exitGate, 3); err != nil {
+		t.Fatalf("cannot tap out: %+v", err)
+	}
+
+	// Two stops travelled: 1 METR base fare and 0.5 METR per stop.
+	assertBalance(t, ctrl, db, passengerSigner.Address(), coin.NewCoin(8, 0, "METR"))
+	assertBalance(t, ctrl, db, collector, coin.NewCoin(2, 0, "METR"))
+
+	var trip Trip
+	if err := NewTripBucket().ByID(db, weavetest.SequenceID(1), &trip); err != nil {
+		t.Fatalf("cannot load trip: %s", err)
+	}
+	if trip.IsOpen() {
+		t.Fatal("trip must be closed after tap out")
+	}
+}
+
+func assertBalance(t testing.TB, ctrl cash.Controller, db weave.KVStore, addr weave.Address, want coin.Coin) {
+	t.Helper()
+	coins, err := ctrl.Balance(db, addr)
+	if err != nil {
+		t.Fatalf("cannot get %s balance: %s", addr, err)
+	}
+	if !coins.Equals(coin.Coins{&want}) {
+		t.Fatalf("want %s balance %v, got %v", addr, want, coins)
+	}
+}
+
+func TestSetPassengerCategory(t *testing.T) {
+	issuer := weavetest.NewCondition()
+	now := time.Now()
+	nextYear := weave.AsUnixTime(now.AddDate(1, 0, 0))
+
+	cases := map[string]struct {
+		signer  weave.Condition
+		msg     *SetPassengerCategoryMsg
+		wantErr *errors.Error
+	}{
+		"issuer grants a student concession": {
+			signer: issuer,
+			msg: &SetPassengerCategoryMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				PassengerKey: weavetest.SequenceID(1),
+				Category:     CategoryStudent,
+				ExpiresAt:    nextYear,
+			},
+		},
+		"issuer revokes a concession": {
+			signer: issuer,
+			msg: &SetPassengerCategoryMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				PassengerKey: weavetest.SequenceID(1),
+				Category:     CategoryStandard,
+			},
+		},
+		"only the issuer can grant a concession": {
+			signer: weavetest.NewCondition(),
+			msg: &SetPassengerCategoryMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				PassengerKey: weavetest.SequenceID(1),
+				Category:     CategoryStudent,
+				ExpiresAt:    nextYear,
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"concession must not be expired": {
+			signer: issuer,
+			msg: &SetPassengerCategoryMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				PassengerKey: weavetest.SequenceID(1),
+				Category:     CategorySenior,
+				ExpiresAt:    weave.AsUnixTime(now.Add(-time.Hour)),
+			},
+			wantErr: errors.ErrInput,
+		},
+		"concession requires an expiration": {
+			signer: issuer,
+			msg: &SetPassengerCategoryMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				PassengerKey: weavetest.SequenceID(1),
+				Category:     CategoryTeacher,
+			},
+			wantErr: errors.ErrInput,
+		},
+		"unknown passenger": {
+			signer: issuer,
+			msg: &SetPassengerCategoryMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				PassengerKey: weavetest.SequenceID(9),
+				Category:     CategoryStudent,
+				ExpiresAt:    nextYear,
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			saveAll(t, db, NewPassengerBucket(), &Passenger{
+				Metadata: &weave.Metadata{Schema: 1},
+				Address:  weavetest.NewCondition().Address(),
+			})
+			if err := gconf.Save(db, "metro", &Configuration{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Admin:          weavetest.NewCondition().Address(),
+				CategoryIssuer: issuer.Address(),
+			}); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+
+			h := NewSetPassengerCategoryHandler(&weavetest.Auth{Signer: tc.signer})
+			ctx := weave.WithBlockTime(context.Background(), now)
+			if _, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: tc.msg}); !tc.wantErr.Is(err) {
+				t.Fatalf("want %v error, got %+v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var p Passenger
+			if err := NewPassengerBucket().ByID(db, tc.msg.PassengerKey, &p); err != nil {
+				t.Fatalf("cannot load passenger: %s", err)
+			}
+			if p.Category != tc.msg.Category {
+				t.Fatalf("want %s category, got %s", tc.msg.Category, p.Category)
+			}
+		})
+	}
+}
+
+func TestPayFare(t *testing.T) {
+	student := weavetest.NewCondition()
+	expired := weavetest.NewCondition()
+	collector := weavetest.NewCondition().Address()
+	now := time.Now()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+	)
+	saveAll(t, db, NewLineBucket(), &Line{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Name:        "M1",
+		Color:       "#e30613",
+		StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+	})
+	saveAll(t, db, NewPassengerBucket(),
+		&Passenger{
+			Metadata:          &weave.Metadata{Schema: 1},
+			Address:           student.Address(),
+			Category:          CategoryStudent,
+			CategoryExpiresAt: weave.AsUnixTime(now.AddDate(1, 0, 0)),
+		},
+		&Passenger{
+			Metadata:          &weave.Metadata{Schema: 1},
+			Address:           expired.Address(),
+			Category:          CategoryStudent,
+			CategoryExpiresAt: weave.AsUnixTime(now.AddDate(-1, 0, 0)),
+		},
+	)
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Admin:         weavetest.NewCondition().Address(),
+		FareCollector: collector,
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if _, err := NewFareTableBucket().Put(db, fareTableKey, &FareTable{
+		Metadata:  &weave.Metadata{Schema: 1},
+		BaseFare:  coin.NewCoin(2, 0, "METR"),
+		Discounts: []Discount{{Category: CategoryStudent, Percent: 50}},
+	}); err != nil {
+		t.Fatalf("cannot save fare table: %s", err)
+	}
+	ctrl := cash.NewController(cash.NewBucket())
+	for _, c := range []weave.Condition{student, expired} {
+		if err := ctrl.CoinMint(db, c.Address(), coin.NewCoin(10, 0, "METR")); err != nil {
+			t.Fatalf("cannot fund passenger: %s", err)
+		}
+	}
+
+	ctx := weave.WithBlockTime(context.Background(), now)
+	pay := func(signer weave.Condition, passenger uint64) error {
+		h := NewPayFareHandler(&weavetest.Auth{Signer: signer}, ctrl)
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &PayFareMsg{
+			Metadata:        &weave.Metadata{Schema: 1},
+			PassengerKey:    weavetest.SequenceID(passenger),
+			EntryStationKey: weavetest.SequenceID(1),
+			ExitStationKey:  weavetest.SequenceID(2),
+		}})
+		return err
+	}
+
+	if err := pay(expired, 1); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want paying for another passenger to fail, got %+v", err)
+	}
+	if err := pay(student, 1); err != nil {
+		t.Fatalf("cannot pay student fare: %+v", err)
+	}
+	if err := pay(expired, 2); err != nil {
+		t.Fatalf("cannot pay expired concession fare: %+v", err)
+	}
+
+	assertBalance(t, ctrl, db, student.Address(), coin.NewCoin(9, 0, "METR"))
+	assertBalance(t, ctrl, db, expired.Address(), coin.NewCoin(8, 0, "METR"))
+	assertBalance(t, ctrl, db, collector, coin.NewCoin(3, 0, "METR"))
+}
+
+func TestRegisterPassengerOncePerAddress(t *testing.T) {
+	db := store.MemStore()
+	signer := weavetest.NewCondition()
+	h := NewRegisterPassengerHandler(&weavetest.Auth{Signer: signer})
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	tx := &weavetest.Tx{Msg: &RegisterPassengerMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Name:     "ayse",
+	}}
+
+	res, err := h.Deliver(ctx, db, tx)
+	if err != nil {
+		t.Fatalf("cannot register passenger: %+v", err)
+	}
+	if _, err := h.Check(ctx, db, tx); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want second registration check to fail, got %+v", err)
+	}
+	if _, err := h.Deliver(ctx, db, tx); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want second registration to fail, got %+v", err)
+	}
+
+	var found []Passenger
+	if err := NewPassengerBucket().ByIndex(db, "address", signer.Address(), &found); err != nil {
+		t.Fatalf("cannot query passengers by address: %s", err)
+	}
+	if len(found) != 1 || string(found[0].PrimaryKey) != string(res.Data) {
+		t.Fatalf("want the registered passenger, got %v", found)
+	}
+}
+
+func TestUpdateAndDeregisterPassenger(t *testing.T) {
+	owner := weavetest.NewCondition()
+	rotated := weavetest.NewCondition()
+	other := weavetest.NewCondition()
+	gate := weavetest.NewCondition()
+
+	db := store.MemStore()
+	saveAll(t, db, NewStationBucket(), &Station{
+		Metadata: &weave.Metadata{Schema: 1},
+		Station:  "a",
+		Gates:    []weave.Address{gate.Address()},
+	})
+	saveAll(t, db, NewPassengerBucket(),
+		&Passenger{Metadata: &weave.Metadata{Schema: 1}, Address: owner.Address(), Name: "ayse"},
+		&Passenger{Metadata: &weave.Metadata{Schema: 1}, Address: other.Address(), Name: "fatma"},
+	)
+
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	passengerKey := weavetest.SequenceID(1)
+
+	update := func(signer weave.Condition, addr weave.Address) error {
+		h := NewUpdatePassengerHandler(&weavetest.Auth{Signer: signer})
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &UpdatePassengerMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PassengerKey: passengerKey,
+			Name:         "ayse yilmaz",
+			Address:      addr,
+		}})
+		return err
+	}
+	deregister := func(signer weave.Condition) error {
+		h := NewDeregisterPassengerHandler(&weavetest.Auth{Signer: signer})
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &DeregisterPassengerMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PassengerKey: passengerKey,
+		}})
+		return err
+	}
+
+	if err := update(other, nil); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want update by another signer to fail, got %+v", err)
+	}
+	if err := update(owner, other.Address()); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want binding an address of another passenger to fail, got %+v", err)
+	}
+	if err := update(owner, rotated.Address()); err != nil {
+		t.Fatalf("cannot rotate passenger address: %+v", err)
+	}
+	if err := deregister(owner); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want old address to be no longer authorized, got %+v", err)
+	}
+
+	tapIn := NewTapInHandler(&weavetest.Auth{Signer: gate})
+	tapInTx := &weavetest.Tx{Msg: &TapInMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: passengerKey,
+		StationKey:   weavetest.SequenceID(1),
+	}}
+	if _, err := tapIn.Deliver(ctx, db, tapInTx); err != nil {
+		t.Fatalf("cannot tap in: %+v", err)
+	}
+	if err := deregister(rotated); !errors.ErrState.Is(err) {
+		t.Fatalf("want deregistration during a trip to fail, got %+v", err)
+	}
+	saveAll(t, db, NewTripBucket(), &Trip{
+		Metadata:        &weave.Metadata{Schema: 1},
+		PrimaryKey:      weavetest.SequenceID(1),
+		PassengerKey:    passengerKey,
+		EntryStationKey: weavetest.SequenceID(1),
+		EnteredAt:       weave.AsUnixTime(time.Now()),
+		ExitStationKey:  weavetest.SequenceID(1),
+		ExitedAt:        weave.AsUnixTime(time.Now()),
+	})
+
+	journey := &Journey{
+		Metadata:        &weave.Metadata{Schema: 1},
+		PassengerKey:    passengerKey,
+		EntryStationKey: weavetest.SequenceID(1),
+		StartedAt:       weave.AsUnixTime(time.Now()),
+		Status:          JourneyOpen,
+	}
+	saveAll(t, db, NewJourneyBucket(), journey)
+	if err := deregister(rotated); !errors.ErrState.Is(err) {
+		t.Fatalf("want deregistration during a journey to fail, got %+v", err)
+	}
+	journey.Status = JourneyCompleted
+	journey.ExitStationKey = weavetest.SequenceID(1)
+	journey.EndedAt = weave.AsUnixTime(time.Now())
+	if err := NewJourneyBucket().Save(db, journey); err != nil {
+		t.Fatalf("cannot end journey: %s", err)
+	}
+
+	if err := deregister(rotated); err != nil {
+		t.Fatalf("cannot deregister: %+v", err)
+	}
+
+	if err := deregister(rotated); !errors.ErrState.Is(err) {
+		t.Fatalf("want second deregistration to fail, got %+v", err)
+	}
+	if _, err := tapIn.Deliver(ctx, db, tapInTx); !errors.ErrState.Is(err) {
+		t.Fatalf("want tap in of a deregistered passenger to fail, got %+v", err)
+	}
+
+	// Deregistration releases the address so it can be registered again,
+	// under a new primary key.
+	register := NewRegisterPassengerHandler(&weavetest.Auth{Signer: rotated})
+	res, err := register.Deliver(ctx, db, &weavetest.Tx{Msg: &RegisterPassengerMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Name:     "ayse",
+	}})
+	if err != nil {
+		t.Fatalf("cannot register released address: %+v", err)
+	}
+	if string(res.Data) == string(passengerKey) {
+		t.Fatal("primary key of a deregistered passenger must not be reused")
+	}
+}
+
+func TestStationAdministration(t *testing.T) {
+	admin := weavetest.NewCondition()
+	trainSigner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Admin:    admin.Address(),
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	saveAll(t, db, NewTrainBucket(), &Train{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  trainSigner.Address(),
+	})
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+
+	create := &weavetest.Tx{Msg: &CreateStationMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Station:  "levent",
+		Elevator: 2,
+	}}
+	if _, err := NewCreateStationHandler(&weavetest.Auth{Signer: trainSigner}).Deliver(ctx, db, create); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want station creation by non admin to fail, got %+v", err)
+	}
+	res, err := NewCreateStationHandler(&weavetest.Auth{Signer: admin}).Deliver(ctx, db, create)
+	if err != nil {
+		t.Fatalf("cannot create station: %+v", err)
+	}
+	stationKey := res.Data
+
+	update := &weavetest.Tx{Msg: &UpdateStationMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: stationKey,
+		Station:    "levent",
+		Elevator:   4,
+	}}
+	if _, err := NewUpdateStationHandler(&weavetest.Auth{Signer: admin}).Deliver(ctx, db, update); err != nil {
+		t.Fatalf("cannot update station: %+v", err)
+	}
+
+	arrive := &weavetest.Tx{Msg: &TrainArriveStationEventMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: stationKey,
+		TrainKey:   weavetest.SequenceID(1),
+	}}
+	arrivals := NewTrainArriveStationEventHandler(&weavetest.Auth{Signer: trainSigner}, &weavetest.Cron{})
+	if _, err := arrivals.Deliver(ctx, db, arrive); err != nil {
+		t.Fatalf("cannot arrive at an open station: %+v", err)
+	}
+
+	retire := &weavetest.Tx{Msg: &RetireStationMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: stationKey,
+	}}
+	if _, err := NewRetireStationHandler(&weavetest.Auth{Signer: admin}).Deliver(ctx, db, retire); err != nil {
+		t.Fatalf("cannot retire station: %+v", err)
+	}
+	if _, err := arrivals.Check(ctx, db, arrive); !errors.ErrState.Is(err) {
+		t.Fatalf("want arrival at a retired station to fail, got %+v", err)
+	}
+	if _, err := NewUpdateStationHandler(&weavetest.Auth{Signer: admin}).Deliver(ctx, db, update); !errors.ErrState.Is(err) {
+		t.Fatalf("want update of a retired station to fail, got %+v", err)
+	}
+
+	var station Station
+	if err := NewStationBucket().ByID(db, stationKey, &station); err != nil {
+		t.Fatalf("retired station must stay queryable: %s", err)
+	}
+	if station.Elevator != 4 || !station.IsRetired() {
+		t.Fatalf("unexpected station state: %+v", station)
+	}
+}
+
+func TestTrainFleetManagement(t *testing.T) {
+	manager := weavetest.NewCondition()
+	oldUnit := weavetest.NewCondition()
+	newUnit := weavetest.NewCondition()
+
+	db := store.MemStore()
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Admin:        weavetest.NewCondition().Address(),
+		FleetManager: manager.Address(),
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	saveAll(t, db, NewStationBucket(), &Station{
+		Metadata: &weave.Metadata{Schema: 1},
+		Station:  "taksim",
+	})
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	auth := &weavetest.Auth{Signer: manager}
+
+	register := &weavetest.Tx{Msg: &RegisterTrainMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Address:   oldUnit.Address(),
+		Model:     "Alstom Metropolis",
+		Capacity:  1500,
+		CarCount:  4,
+		HomeDepot: "seyrantepe",
+	}}
+	if _, err := NewRegisterTrainHandler(&weavetest.Auth{Signer: oldUnit}).Deliver(ctx, db, register); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want registration by non manager to fail, got %+v", err)
+	}
+	res, err := NewRegisterTrainHandler(auth).Deliver(ctx, db, register)
+	if err != nil {
+		t.Fatalf("cannot register train: %+v", err)
+	}
+	trainKey := res.Data
+
+	_, err = NewReassignTrainHandler(auth).Deliver(ctx, db, &weavetest.Tx{Msg: &ReassignTrainMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		TrainKey: trainKey,
+		Address:  newUnit.Address(),
+	}})
+	if err != nil {
+		t.Fatalf("cannot reassign train: %+v", err)
+	}
+
+	arrive := func(unit weave.Condition) error {
+		h := NewTrainArriveStationEventHandler(&weavetest.Auth{Signer: unit}, &weavetest.Cron{})
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &TrainArriveStationEventMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			StationKey: weavetest.SequenceID(1),
+			TrainKey:   trainKey,
+		}})
+		return err
+	}
+	if err := arrive(oldUnit); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want the replaced unit to be rejected, got %+v", err)
+	}
+	if err := arrive(newUnit); err != nil {
+		t.Fatalf("cannot report arrival: %+v", err)
+	}
+
+	decommission := &weavetest.Tx{Msg: &DecommissionTrainMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		TrainKey: trainKey,
+	}}
+	if _, err := NewDecommissionTrainHandler(auth).Deliver(ctx, db, decommission); err != nil {
+		t.Fatalf("cannot decommission train: %+v", err)
+	}
+	if _, err := NewDecommissionTrainHandler(auth).Deliver(ctx, db, decommission); !errors.ErrState.Is(err) {
+		t.Fatalf("want second decommission to fail, got %+v", err)
+	}
+	if err := arrive(newUnit); !errors.ErrState.Is(err) {
+		t.Fatalf("want arrival of a decommissioned train to fail, got %+v", err)
+	}
+
+	var changes []TrainChange
+	if err := NewTrainChangeBucket().ByIndex(db, "train", trainKey, &changes); err != nil {
+		t.Fatalf("cannot load audit trail: %s", err)
+	}
+	wantKinds := []TrainChangeKind{TrainRegistered, TrainReassigned, TrainDecommissioned}
+	if len(changes) != len(wantKinds) {
+		t.Fatalf("want %d changes, got %d", len(wantKinds), len(changes))
+	}
+	for i, c := range changes {
+		if c.Kind != wantKinds[i] {
+			t.Errorf("change %d: want %s, got %s", i, wantKinds[i], c.Kind)
+		}
+	}
+	if !changes[1].PreviousAddress.Equals(oldUnit.Address()) {
+		t.Errorf("want reassignment to record the previous address")
+	}
+}
+
+func TestReportEquipmentStatus(t *testing.T) {
+	maintainer := weavetest.NewCondition()
+
+	db := store.MemStore()
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Admin:       weavetest.NewCondition().Address(),
+		Maintainers: []weave.Address{weavetest.NewCondition().Address(), maintainer.Address()},
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+	)
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+
+	report := func(signer weave.Condition, station uint64, typ EquipmentType, id string, status EquipmentStatus) error {
+		h := NewReportEquipmentStatusHandler(&weavetest.Auth{Signer: signer})
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &ReportEquipmentStatusMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			StationKey: weavetest.SequenceID(station),
+			Type:       typ,
+			Identifier: id,
+			Status:     status,
+		}})
+		return err
+	}
+
+	if err := report(weavetest.NewCondition(), 1, EquipmentElevator, "E1", StatusOutOfOrder); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want report by non maintainer to fail, got %+v", err)
+	}
+	if err := report(maintainer, 1, EquipmentElevator, "E1", StatusOperational); err != nil {
+		t.Fatalf("cannot report: %+v", err)
+	}
+	if err := report(maintainer, 1, EquipmentElevator, "E1", StatusOutOfOrder); err != nil {
+		t.Fatalf("cannot report: %+v", err)
+	}
+	if err := report(maintainer, 1, EquipmentEscalator, "E1", StatusOperational); !errors.ErrInput.Is(err) {
+		t.Fatalf("want changing the type of a unit to fail, got %+v", err)
+	}
+	if err := report(maintainer, 1, EquipmentEscalator, "S1", StatusMaintenance); err != nil {
+		t.Fatalf("cannot report: %+v", err)
+	}
+	if err := report(maintainer, 2, EquipmentElevator, "E1", StatusOperational); err != nil {
+		t.Fatalf("cannot report: %+v", err)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	models, err := qr.Handler("/equipment").Query(db, weave.PrefixQueryMod, weavetest.SequenceID(1))
+	if err != nil {
+		t.Fatalf("cannot query station equipment: %s", err)
+	}
+	want := map[string]EquipmentStatus{"E1": StatusOutOfOrder, "S1": StatusMaintenance}
+	if len(models) != len(want) {
+		t.Fatalf("want %d units, got %d", len(want), len(models))
+	}
+	for _, m := range models {
+		var e Equipment
+		if err := e.Unmarshal(m.Value); err != nil {
+			t.Fatalf("cannot unmarshal equipment: %s", err)
+		}
+		if e.Status != want[e.Identifier] {
+			t.Errorf("%s: want %s status, got %s", e.Identifier, want[e.Identifier], e.Status)
+		}
+		if !e.ReportedBy.Equals(maintainer.Address()) {
+			t.Errorf("%s: want reporter to be recorded", e.Identifier)
+		}
+	}
+}
+
+func TestTrainDepartStationEvent(t *testing.T) {
+	trainSigner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+	)
+	saveAll(t, db, NewTrainBucket(), &Train{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  trainSigner.Address(),
+	})
+
+	auth := &weavetest.Auth{Signer: trainSigner}
+	arrived := time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)
+	depart := func(station uint64, at time.Time) (*weave.DeliverResult, error) {
+		ctx := weave.WithBlockTime(context.Background(), at)
+		return NewTrainDepartStationEventHandler(auth).Deliver(ctx, db, &weavetest.Tx{Msg: &TrainDepartStationEventMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			StationKey: weavetest.SequenceID(station),
+			TrainKey:   weavetest.SequenceID(1),
+		}})
+	}
+
+	if _, err := depart(1, arrived); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error before any arrival, got %+v", err)
+	}
+	ctx := weave.WithBlockTime(context.Background(), arrived)
+	arrival, err := NewTrainArriveStationEventHandler(auth, &weavetest.Cron{}).Deliver(ctx, db, &weavetest.Tx{Msg: &TrainArriveStationEventMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		StationKey: weavetest.SequenceID(1),
+		TrainKey:   weavetest.SequenceID(1),
+	}})
+	if err != nil {
+		t.Fatalf("cannot arrive: %+v", err)
+	}
+	if _, err := depart(2, arrived.Add(time.Minute)); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error for another station, got %+v", err)
+	}
+	res, err := depart(1, arrived.Add(45*time.Second))
+	if err != nil {
+		t.Fatalf("cannot depart: %+v", err)
+	}
+	if _, err := depart(1, arrived.Add(time.Minute)); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error when departing twice, got %+v", err)
+	}
+
+	var departure TrainDepartStationEvent
+	if err := NewTrainDepartStationEventBucket().ByID(db, res.Data, &departure); err != nil {
+		t.Fatalf("cannot load stored departure: %s", err)
+	}
+	if departure.Dwell != 45 {
+		t.Fatalf("want 45 seconds dwell, got %d", departure.Dwell)
+	}
+	if string(departure.ArrivalKey) != string(arrival.Data) {
+		t.Fatalf("want departure to close arrival %x, got %x", arrival.Data, departure.ArrivalKey)
+	}
+
+	var byDwell []TrainDepartStationEvent
+	dwellKey := StationDwellIndexKey(weavetest.SequenceID(1), 45)
+	if err := NewTrainDepartStationEventBucket().ByIndex(db, "station_dwell", dwellKey, &byDwell); err != nil {
+		t.Fatalf("cannot query by dwell: %s", err)
+	}
+	if len(byDwell) != 1 {
+		t.Fatalf("want one departure indexed by dwell, got %d", len(byDwell))
+	}
+}
+
+func TestCreateCurrency(t *testing.T) {
+	oldIssuer := weavetest.NewCondition()
+	newIssuer := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "currency")
+
+	setIssuer := func(issuer weave.Address) {
+		t.Helper()
+		if err := gconf.Save(db, "metro", &Configuration{
+			Metadata:       &weave.Metadata{Schema: 1},
+			Admin:          weavetest.NewCondition().Address(),
+			CurrencyIssuer: issuer,
+		}); err != nil {
+			t.Fatalf("cannot save configuration: %s", err)
+		}
+	}
+	create := func(signer weave.Condition, ticker string) error {
+		h := NewCreateCurrencyHandler(&weavetest.Auth{Signer: signer})
+		tx := &weavetest.Tx{Msg: &currency.CreateMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Ticker:   ticker,
+			Name:     "fare credit",
+		}}
+		if _, err := h.Check(context.Background(), db, tx); err != nil {
+			return err
+		}
+		_, err := h.Deliver(context.Background(), db, tx)
+		return err
+	}
+
+	if err := create(oldIssuer, "FARE"); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want missing configuration error, got %+v", err)
+	}
+
+	setIssuer(nil)
+	if err := create(oldIssuer, "FARE"); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want currency registration disabled without issuer, got %+v", err)
+	}
+
+	setIssuer(oldIssuer.Address())
+	if err := create(oldIssuer, "FARE"); err != nil {
+		t.Fatalf("cannot register currency: %+v", err)
+	}
+	if err := create(oldIssuer, "FARE"); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate currency error, got %+v", err)
+	}
+
+	// Configuration update takes effect with the next message.
+	setIssuer(newIssuer.Address())
+	if err := create(oldIssuer, "RIDE"); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want previous issuer to be no longer authorized, got %+v", err)
+	}
+	if err := create(newIssuer, "RIDE"); err != nil {
+		t.Fatalf("cannot register currency: %+v", err)
+	}
+
+	info, err := currency.NewTokenInfoBucket().Get(db, "RIDE")
+	if err != nil {
+		t.Fatalf("cannot load currency: %s", err)
+	}
+	if info == nil {
+		t.Fatal("want currency to be registered")
+	}
+}
@@ -0,0 +1,85 @@
+package metro
+
+import (
+	"encoding/hex"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// IncidentTag is emitted with every arrival at a station affected by an
+// incident. It holds the hex encoded key of the incident.
+const IncidentTag = "metro.incident"
+
+// activeIncidents returns all incidents affecting given station at given
+// time.
+func activeIncidents(db weave.ReadOnlyKVStore, incidents orm.SerialModelBucket, stationKey []byte, now weave.UnixTime) ([]Incident, error) {
+	var open []Incident
+	if err := incidents.ByIndex(db, "open", OpenIncidentIndexKey, &open); err != nil {
+		return nil, errors.Wrap(err, "cannot load open incidents")
+	}
+	var active []Incident
+	for _, i := range open {
+		if i.IsActive(now) && i.Affects(stationKey) {
+			active = append(active, i)
+		}
+	}
+	return active, nil
+}
+
+// incidentTags returns the ABCI tags announcing incidents affecting an
+// arrival.
+func incidentTags(incidentKeys [][]byte) []common.KVPair {
+	tags := make([]common.KVPair, 0, len(incidentKeys))
+	for _, k := range incidentKeys {
+		tags = append(tags, common.KVPair{Key: []byte(IncidentTag), Value: []byte(hex.EncodeToString(k))})
+	}
+	return tags
+}
+
+// activeIncidentsQuery handles the /incidents/active query path. Query data
+// must be a serialized ActiveIncidentsRequest. Incidents affecting the station
+// at the requested time are returned.
+type activeIncidentsQuery struct {
+	incidents orm.SerialModelBucket
+}
+
+var _ weave.QueryHandler = activeIncidentsQuery{}
+
+func (q activeIncidentsQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrInput, "only key queries are supported")
+	}
+	var req ActiveIncidentsRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal request")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+	active, err := activeIncidents(db, q.incidents, req.StationKey, req.At)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]weave.Model, 0, len(active))
+	for _, i := range active {
+		raw, err := i.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal incident")
+		}
+		res = append(res, weave.Pair(i.PrimaryKey, raw))
+	}
+	return res, nil
+}
+
+// Validate ensures the ActiveIncidentsRequest is valid
+func (r *ActiveIncidentsRequest) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(r.StationKey))
+	errs = errors.AppendField(errs, "At", r.At.Validate())
+
+	return errs
+}
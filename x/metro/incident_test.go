@@ -0,0 +1,161 @@
+package metro
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestIncidents(t *testing.T) {
+	operator := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+	trainSigner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Admin:     weavetest.NewCondition().Address(),
+		Operators: []weave.Address{operator.Address()},
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "c"},
+	)
+	saveAll(t, db, NewTrainBucket(), &Train{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  trainSigner.Address(),
+	})
+
+	now := time.Now()
+	ctx := weave.WithBlockTime(context.Background(), now)
+	messages := []LocalizedText{
+		{Language: "en", Text: "M1 suspended between A and B"},
+		{Language: "tr", Text: "M1 A ile B arasında durduruldu"},
+	}
+	openMsg := &OpenIncidentMsg{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Severity:      SeveritySuspended,
+		StationKeys:   [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+		StartedAt:     weave.AsUnixTime(now.Add(-time.Minute)),
+		ExpectedEndAt: weave.AsUnixTime(now.Add(time.Hour)),
+		Messages:      messages,
+	}
+
+	_, err := NewOpenIncidentHandler(&weavetest.Auth{Signer: stranger}).Deliver(ctx, db, &weavetest.Tx{Msg: openMsg})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	auth := &weavetest.Auth{Signer: operator}
+	res, err := NewOpenIncidentHandler(auth).Deliver(ctx, db, &weavetest.Tx{Msg: openMsg})
+	if err != nil {
+		t.Fatalf("cannot open incident: %+v", err)
+	}
+	incidentKey := res.Data
+
+	// Planned works do not affect arrivals until they start.
+	planned := *openMsg
+	planned.StationKeys = [][]byte{weavetest.SequenceID(2)}
+	planned.StartedAt = weave.AsUnixTime(now.Add(time.Hour))
+	planned.ExpectedEndAt = weave.AsUnixTime(now.Add(2 * time.Hour))
+	if _, err := NewOpenIncidentHandler(auth).Deliver(ctx, db, &weavetest.Tx{Msg: &planned}); err != nil {
+		t.Fatalf("cannot open planned incident: %+v", err)
+	}
+
+	arrive := func(station uint64) (*TrainArriveStationEvent, *weave.DeliverResult) {
+		t.Helper()
+		res, err := NewTrainArriveStationEventHandler(&weavetest.Auth{Signer: trainSigner}, &weavetest.Cron{}).Deliver(ctx, db, &weavetest.Tx{Msg: &TrainArriveStationEventMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			StationKey: weavetest.SequenceID(station),
+			TrainKey:   weavetest.SequenceID(1),
+		}})
+		if err != nil {
+			t.Fatalf("cannot arrive: %+v", err)
+		}
+		var event TrainArriveStationEvent
+		if err := NewTrainArriveStationEventBucket().ByID(db, res.Data, &event); err != nil {
+			t.Fatalf("cannot load stored event: %s", err)
+		}
+		return &event, res
+	}
+
+	event, res := arrive(2)
+	if len(event.IncidentKeys) != 1 || string(event.IncidentKeys[0]) != string(incidentKey) {
+		t.Fatalf("want arrival tagged with the incident, got %x", event.IncidentKeys)
+	}
+	if len(res.Tags) != 1 || string(res.Tags[0].Key) != IncidentTag || string(res.Tags[0].Value) != hex.EncodeToString(incidentKey) {
+		t.Fatalf("unexpected tags: %+v", res.Tags)
+	}
+	if event, _ := arrive(3); len(event.IncidentKeys) != 0 {
+		t.Fatalf("unaffected station must not be tagged, got %x", event.IncidentKeys)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	queryActiveAt := func(station uint64, at time.Time) int {
+		t.Helper()
+		req := ActiveIncidentsRequest{StationKey: weavetest.SequenceID(station), At: weave.AsUnixTime(at)}
+		data, err := req.Marshal()
+		if err != nil {
+			t.Fatalf("cannot marshal request: %s", err)
+		}
+		models, err := qr.Handler("/incidents/active").Query(db, weave.KeyQueryMod, data)
+		if err != nil {
+			t.Fatalf("cannot query active incidents: %+v", err)
+		}
+		return len(models)
+	}
+	queryActive := func(station uint64) int {
+		t.Helper()
+		return queryActiveAt(station, now)
+	}
+	if n := queryActive(1); n != 1 {
+		t.Fatalf("want one active incident, got %d", n)
+	}
+	if n := queryActiveAt(2, now.Add(90*time.Minute)); n != 2 {
+		t.Fatalf("want planned works active once started, got %d incidents", n)
+	}
+
+	update := &UpdateIncidentMsg{
+		Metadata:      &weave.Metadata{Schema: 1},
+		IncidentKey:   incidentKey,
+		Severity:      SeverityMinor,
+		StationKeys:   [][]byte{weavetest.SequenceID(2)},
+		ExpectedEndAt: weave.AsUnixTime(now.Add(30 * time.Minute)),
+		Messages:      messages[:1],
+	}
+	early := *update
+	early.ExpectedEndAt = weave.AsUnixTime(now.Add(-2 * time.Minute))
+	if _, err := NewUpdateIncidentHandler(auth).Check(ctx, db, &weavetest.Tx{Msg: &early}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error ending before the start, got %+v", err)
+	}
+	if _, err := NewUpdateIncidentHandler(auth).Deliver(ctx, db, &weavetest.Tx{Msg: update}); err != nil {
+		t.Fatalf("cannot update incident: %+v", err)
+	}
+	if n := queryActive(1); n != 0 {
+		t.Fatalf("station no longer affected, got %d incidents", n)
+	}
+
+	resolve := &ResolveIncidentMsg{Metadata: &weave.Metadata{Schema: 1}, IncidentKey: incidentKey}
+	if _, err := NewResolveIncidentHandler(auth).Deliver(ctx, db, &weavetest.Tx{Msg: resolve}); err != nil {
+		t.Fatalf("cannot resolve incident: %+v", err)
+	}
+	if n := queryActive(2); n != 0 {
+		t.Fatalf("resolved incident must not be active, got %d", n)
+	}
+	if _, err := NewResolveIncidentHandler(auth).Deliver(ctx, db, &weavetest.Tx{Msg: resolve}); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error resolving twice, got %+v", err)
+	}
+	if _, err := NewUpdateIncidentHandler(auth).Deliver(ctx, db, &weavetest.Tx{Msg: update}); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error updating resolved incident, got %+v", err)
+	}
+}
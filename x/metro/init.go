@@ -1,8 +1,13 @@
 package metro
 
 import (
+	"encoding/binary"
+	"strings"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -16,22 +21,58 @@ var _ weave.Initializer = (*Initializer)(nil)
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
 	var input struct {
 		Station []struct {
-			Station      string `json:"station"`
-			Escalator    int64  `json:"escalator"`
-			Elevator     int64  `json:"elevator"`
-			IsPeronAda   bool   `json:"is_peron_ada"`
-			TicketOffice int64  `json:"ticket_office"`
-			TollGateEnt  int64  `json:"toll_gate_ent"`
-			TollGateEx   int64  `json:"toll_gate_ex"`
-			EntranceExit int64  `json:"entrance_exit"`
+			Station      string          `json:"station"`
+			Escalator    int64           `json:"escalator"`
+			Elevator     int64           `json:"elevator"`
+			IsPeronAda   bool            `json:"is_peron_ada"`
+			TicketOffice int64           `json:"ticket_office"`
+			TollGateEnt  int64           `json:"toll_gate_ent"`
+			TollGateEx   int64           `json:"toll_gate_ex"`
+			EntranceExit int64           `json:"entrance_exit"`
+			Gates        []weave.Address `json:"gates"`
 		}
 		Train []struct {
-			Address weave.Address `json:"address"`
+			Address   weave.Address `json:"address"`
+			Model     string        `json:"model"`
+			Capacity  uint32        `json:"capacity"`
+			CarCount  uint32        `json:"car_count"`
+			HomeDepot string        `json:"home_depot"`
 		}
 		Passenger []struct {
 			Address      weave.Address `json:"address"`
 			RegisteredAt int64         `json:"registered_at"`
 		}
+		Line []struct {
+			Name  string `json:"name"`
+			Color string `json:"color"`
+			// Stations is the ordered list of station sequence numbers.
+			Stations []uint64 `json:"stations"`
+		}
+		Connection []struct {
+			From          uint64 `json:"from"`
+			To            uint64 `json:"to"`
+			TravelMinutes uint32 `json:"travel_minutes"`
+		}
+		FareTable *struct {
+			BaseFare coin.Coin `json:"base_fare"`
+			StopFare coin.Coin `json:"stop_fare"`
+			ZoneFare coin.Coin `json:"zone_fare"`
+			Zones    []struct {
+				Station uint64 `json:"station"`
+				Zone    uint32 `json:"zone"`
+			} `json:"zones"`
+			TimeMultipliers []struct {
+				StartMinute uint32 `json:"start_minute"`
+				EndMinute   uint32 `json:"end_minute"`
+				Percent     uint32 `json:"percent"`
+			} `json:"time_multipliers"`
+			Discounts []struct {
+				Category string `json:"category"`
+				Percent  uint32 `json:"percent"`
+			} `json:"discounts"`
+			WeeklyPass  *coin.Coin `json:"weekly_pass"`
+			MonthlyPass *coin.Coin `json:"monthly_pass"`
+		} `json:"fare_table"`
 	}
 
 	switch err := opts.ReadOptions("metro", &input); {
@@ -44,6 +85,12 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		return errors.Wrap(err, "cannot load station data")
 	}
 
+	// Configuration is optional at genesis, so that a chain can start with
+	// station data only. Messages that need it fail until it is set.
+	if err := gconf.InitConfig(kv, opts, packageName, &Configuration{}); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "init config")
+	}
+
 	stations := NewStationBucket()
 	for _, d := range input.Station {
 		station := Station{
@@ -56,20 +103,25 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			TollGateEnt:  d.TollGateEnt,
 			TollGateEx:   d.TollGateEx,
 			EntranceExit: d.EntranceExit,
+			Gates:        d.Gates,
 		}
 		if err := stations.Save(kv, &station); err != nil {
-			return errors.Wrapf(err, "cannot store %d station", d)
+			return errors.Wrapf(err, "cannot store %q station", d.Station)
 		}
 	}
 
 	trains := NewTrainBucket()
 	for _, d := range input.Train {
 		train := Train{
-			Metadata: &weave.Metadata{Schema: 1},
-			Address:  d.Address,
+			Metadata:  &weave.Metadata{Schema: 1},
+			Address:   d.Address,
+			Model:     d.Model,
+			Capacity:  d.Capacity,
+			CarCount:  d.CarCount,
+			HomeDepot: d.HomeDepot,
 		}
 		if err := trains.Save(kv, &train); err != nil {
-			return errors.Wrapf(err, "cannot store %d train", d)
+			return errors.Wrapf(err, "cannot store %s train", d.Address)
 		}
 	}
 
@@ -80,9 +132,98 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			Address:  d.Address,
 		}
 		if err := passengers.Save(kv, &passenger); err != nil {
-			return errors.Wrapf(err, "cannot store %d passenger", d)
+			return errors.Wrapf(err, "cannot store %s passenger", d.Address)
+		}
+	}
+
+	lines := NewLineBucket()
+	for _, d := range input.Line {
+		line := Line{
+			Metadata: &weave.Metadata{Schema: 1},
+			Name:     d.Name,
+			Color:    d.Color,
+		}
+		for _, n := range d.Stations {
+			line.StationKeys = append(line.StationKeys, sequenceKey(n))
+		}
+		if err := requireStations(kv, stations, line.StationKeys); err != nil {
+			return errors.Wrapf(err, "cannot store %q line", d.Name)
+		}
+		if err := lines.Save(kv, &line); err != nil {
+			return errors.Wrapf(err, "cannot store %q line", d.Name)
+		}
+	}
+
+	connections := NewConnectionBucket()
+	for _, d := range input.Connection {
+		conn := Connection{
+			Metadata:       &weave.Metadata{Schema: 1},
+			FromStationKey: sequenceKey(d.From),
+			ToStationKey:   sequenceKey(d.To),
+			TravelMinutes:  d.TravelMinutes,
+		}
+		if err := requireStations(kv, stations, [][]byte{conn.FromStationKey, conn.ToStationKey}); err != nil {
+			return errors.Wrapf(err, "cannot store %d-%d connection", d.From, d.To)
+		}
+		if err := connections.Save(kv, &conn); err != nil {
+			return errors.Wrapf(err, "cannot store %d-%d connection", d.From, d.To)
+		}
+	}
+
+	if d := input.FareTable; d != nil {
+		table := FareTable{
+			Metadata:    &weave.Metadata{Schema: 1},
+			BaseFare:    d.BaseFare,
+			StopFare:    d.StopFare,
+			ZoneFare:    d.ZoneFare,
+			WeeklyPass:  d.WeeklyPass,
+			MonthlyPass: d.MonthlyPass,
+		}
+		for _, z := range d.Zones {
+			table.Zones = append(table.Zones, StationZone{
+				StationKey: sequenceKey(z.Station),
+				Zone:       z.Zone,
+			})
+		}
+		for _, m := range d.TimeMultipliers {
+			table.TimeMultipliers = append(table.TimeMultipliers, TimeMultiplier{
+				StartMinute: m.StartMinute,
+				EndMinute:   m.EndMinute,
+				Percent:     m.Percent,
+			})
+		}
+		for _, disc := range d.Discounts {
+			category, err := ParsePassengerCategory(disc.Category)
+			if err != nil {
+				return errors.Wrap(err, "cannot store fare table")
+			}
+			table.Discounts = append(table.Discounts, Discount{
+				Category: category,
+				Percent:  disc.Percent,
+			})
+		}
+		if _, err := NewFareTableBucket().Put(kv, fareTableKey, &table); err != nil {
+			return errors.Wrap(err, "cannot store fare table")
 		}
 	}
 
 	return nil
 }
+
+// ParsePassengerCategory returns the category of given short name, for
+// example "student".
+func ParsePassengerCategory(name string) (PassengerCategory, error) {
+	c, ok := PassengerCategory_value["PASSENGER_CATEGORY_"+strings.ToUpper(name)]
+	if !ok {
+		return CategoryStandard, errors.Wrapf(errors.ErrInput, "unknown passenger category %q", name)
+	}
+	return PassengerCategory(c), nil
+}
+
+// sequenceKey returns the primary key of the n-th entity stored in a serial
+// model bucket.
+func sequenceKey(n uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, n)
+	return key
+}
@@ -0,0 +1,26 @@
+package metro
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// JourneyCondition returns the condition that authorizes expiring given
+// journey. It is only ever granted to the scheduled cron task.
+func JourneyCondition(journeyKey []byte) weave.Condition {
+	return weave.NewCondition(packageName, "journey", journeyKey)
+}
+
+// loadOpenJourney returns the journey that given passenger is currently on.
+// It returns ErrNotFound if the passenger did not start a journey.
+func loadOpenJourney(store weave.ReadOnlyKVStore, journeys orm.SerialModelBucket, passengerKey []byte) (*Journey, error) {
+	var open []Journey
+	if err := journeys.ByIndex(store, "open", passengerKey, &open); err != nil {
+		return nil, errors.Wrap(err, "cannot load open journey")
+	}
+	if len(open) == 0 {
+		return nil, errors.Wrap(errors.ErrNotFound, "passenger did not start a journey")
+	}
+	return &open[0], nil
+}
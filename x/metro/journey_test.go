@@ -0,0 +1,139 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestJourney(t *testing.T) {
+	entryGate := weavetest.NewCondition()
+	exitGate := weavetest.NewCondition()
+	retiredGate := weavetest.NewCondition()
+	collector := weavetest.NewCondition().Address()
+	passengerSigner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a", Gates: []weave.Address{entryGate.Address()}},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "c", Gates: []weave.Address{exitGate.Address()}},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "d", Gates: []weave.Address{retiredGate.Address()}, RetiredAt: 1},
+	)
+	saveAll(t, db, NewLineBucket(), &Line{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Name:        "M1",
+		Color:       "#e30613",
+		StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(3)},
+	})
+	saveAll(t, db, NewPassengerBucket(), &Passenger{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  passengerSigner.Address(),
+	})
+	maxFare := coin.NewCoin(5, 0, "METR")
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata:          &weave.Metadata{Schema: 1},
+		Admin:             weavetest.NewCondition().Address(),
+		FareCollector:     collector,
+		MaxJourneyMinutes: 90,
+		MaxFare:           &maxFare,
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if _, err := NewFareTableBucket().Put(db, fareTableKey, &FareTable{
+		Metadata: &weave.Metadata{Schema: 1},
+		BaseFare: coin.NewCoin(1, 0, "METR"),
+		StopFare: coin.NewCoin(0, 500000000, "METR"),
+	}); err != nil {
+		t.Fatalf("cannot save fare table: %s", err)
+	}
+	ctrl := cash.NewController(cash.NewBucket())
+	if err := ctrl.CoinMint(db, passengerSigner.Address(), coin.NewCoin(10, 0, "METR")); err != nil {
+		t.Fatalf("cannot fund passenger: %s", err)
+	}
+
+	var scheduler weavetest.Cron
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	passengerKey := weavetest.SequenceID(1)
+
+	start := func(gate weave.Condition, station uint64) (*weave.DeliverResult, error) {
+		h := NewStartJourneyHandler(&weavetest.Auth{Signer: gate}, &scheduler)
+		return h.Deliver(ctx, db, &weavetest.Tx{Msg: &StartJourneyMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PassengerKey: passengerKey,
+			StationKey:   weavetest.SequenceID(station),
+		}})
+	}
+	end := func(gate weave.Condition, station uint64) error {
+		h := NewEndJourneyHandler(&weavetest.Auth{Signer: gate}, ctrl, &scheduler)
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &EndJourneyMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PassengerKey: passengerKey,
+			StationKey:   weavetest.SequenceID(station),
+		}})
+		return err
+	}
+
+	if _, err := start(exitGate, 1); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error for a foreign gate, got %+v", err)
+	}
+	if err := end(exitGate, 3); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error without a journey, got %+v", err)
+	}
+	res, err := start(entryGate, 1)
+	if err != nil {
+		t.Fatalf("cannot start journey: %+v", err)
+	}
+	if _, err := start(entryGate, 1); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+	var journey Journey
+	if err := NewJourneyBucket().ByID(db, res.Data, &journey); err != nil {
+		t.Fatalf("cannot load journey: %s", err)
+	}
+	if !journey.IsOpen() || len(journey.ExpiryTaskID) == 0 {
+		t.Fatalf("want an open journey with an expiry task, got %+v", journey)
+	}
+
+	// Only the scheduled task can expire the journey.
+	_, err = NewExpireJourneyHandler(&weavetest.Auth{Signer: entryGate}, ctrl).Deliver(ctx, db, &weavetest.Tx{Msg: &ExpireJourneyMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		JourneyKey: journey.PrimaryKey,
+	}})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	if err := end(retiredGate, 4); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error ending at a retired station, got %+v", err)
+	}
+
+	taskID := journey.ExpiryTaskID
+	if err := end(exitGate, 3); err != nil {
+		t.Fatalf("cannot end journey: %+v", err)
+	}
+	if err := NewJourneyBucket().ByID(db, res.Data, &journey); err != nil {
+		t.Fatalf("cannot load journey: %s", err)
+	}
+	if journey.Status != JourneyCompleted || !journey.Fare.Equals(coin.NewCoin(2, 0, "METR")) {
+		t.Fatalf("want completed journey with the quoted fare, got %+v", journey)
+	}
+	if err := scheduler.Delete(db, taskID); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("expiry task must be cancelled, got %+v", err)
+	}
+	assertBalance(t, ctrl, db, passengerSigner.Address(), coin.NewCoin(8, 0, "METR"))
+	assertBalance(t, ctrl, db, collector, coin.NewCoin(2, 0, "METR"))
+	if err := end(exitGate, 3); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error ending twice, got %+v", err)
+	}
+}
@@ -1,6 +1,11 @@
 package metro
 
 import (
+	"bytes"
+	"regexp"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 )
@@ -19,11 +24,25 @@ func (m *Station) Validate() error {
 
 	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
 	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	for _, g := range m.Gates {
+		errs = errors.AppendField(errs, "Gates", g.Validate())
+	}
 
 	// validate data
 	return errs
 }
 
+// IsRetired returns true if the station is closed.
+func (m *Station) IsRetired() bool {
+	return m.RetiredAt != 0
+}
+
+// IsAccessible returns true if the station can be used by passengers with
+// reduced mobility.
+func (m *Station) IsAccessible() bool {
+	return m.Elevator > 0 && m.IsPeronAda
+}
+
 var _ orm.SerialModel = (*Train)(nil)
 
 // SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
@@ -44,6 +63,43 @@ func (m *Train) Validate() error {
 	return errs
 }
 
+// IsDecommissioned returns true if the train was taken out of service.
+func (m *Train) IsDecommissioned() bool {
+	return m.DecommissionedAt != 0
+}
+
+// IsSilent returns true if the watchdog marked the train as not reporting.
+func (m *Train) IsSilent() bool {
+	return m.SilentSince != 0
+}
+
+var _ orm.SerialModel = (*TrainChange)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *TrainChange) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates train change fields
+func (m *TrainChange) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+	if _, ok := TrainChangeKind_name[int32(m.Kind)]; !ok || m.Kind == TrainChangeInvalid {
+		errs = errors.AppendField(errs, "Kind", errors.Wrapf(errors.ErrInput, "invalid kind %d", m.Kind))
+	}
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	if m.Kind == TrainReassigned {
+		errs = errors.AppendField(errs, "PreviousAddress", m.PreviousAddress.Validate())
+	}
+	errs = errors.AppendField(errs, "ChangedAt", m.ChangedAt.Validate())
+
+	return errs
+}
+
 var _ orm.SerialModel = (*Passenger)(nil)
 
 // SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
@@ -59,7 +115,622 @@ func (m *Passenger) Validate() error {
 	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
 	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
 	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	errs = errors.AppendField(errs, "Category", validateCategory(m.Category))
+	if m.Category != CategoryStandard {
+		errs = errors.AppendField(errs, "CategoryExpiresAt", m.CategoryExpiresAt.Validate())
+	}
+
+	// validate data
+	return errs
+}
+
+// IsDeregistered returns true if the passenger closed the account.
+func (m *Passenger) IsDeregistered() bool {
+	return m.DeregisteredAt != 0
+}
+
+// ActiveCategory returns the category the passenger is entitled to at given
+// time. Expired concessions fall back to the standard category.
+func (m *Passenger) ActiveCategory(now weave.UnixTime) PassengerCategory {
+	if m.Category == CategoryStandard || m.CategoryExpiresAt <= now {
+		return CategoryStandard
+	}
+	return m.Category
+}
+
+func validateCategory(c PassengerCategory) error {
+	if _, ok := PassengerCategory_name[int32(c)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown category %d", c)
+	}
+	return nil
+}
+
+var _ orm.SerialModel = (*Line)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Line) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates user's fields
+func (m *Line) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "Name", validateLineName(m.Name))
+	errs = errors.AppendField(errs, "Color", validateLineColor(m.Color))
+	errs = errors.AppendField(errs, "StationKeys", validateStationKeys(m.StationKeys))
+
+	// validate data
+	return errs
+}
+
+// HasStation returns true if the line visits given station.
+func (m *Line) HasStation(stationKey []byte) bool {
+	for _, k := range m.StationKeys {
+		if bytes.Equal(k, stationKey) {
+			return true
+		}
+	}
+	return false
+}
+
+var _ orm.SerialModel = (*Trip)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Trip) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates user's fields
+func (m *Trip) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "EntryStationKey", orm.ValidateSequence(m.EntryStationKey))
+	errs = errors.AppendField(errs, "EnteredAt", m.EnteredAt.Validate())
+	if !m.IsOpen() {
+		errs = errors.AppendField(errs, "ExitStationKey", orm.ValidateSequence(m.ExitStationKey))
+		errs = errors.AppendField(errs, "ExitedAt", m.ExitedAt.Validate())
+		if !coin.IsEmpty(m.Fare) {
+			errs = errors.AppendField(errs, "Fare", m.Fare.Validate())
+		}
+	}
 
 	// validate data
 	return errs
 }
+
+// IsOpen returns true if the passenger did not tap out yet.
+func (m *Trip) IsOpen() bool {
+	return len(m.ExitStationKey) == 0
+}
+
+var _ orm.SerialModel = (*Journey)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Journey) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates journey fields
+func (m *Journey) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "EntryStationKey", orm.ValidateSequence(m.EntryStationKey))
+	errs = errors.AppendField(errs, "StartedAt", m.StartedAt.Validate())
+	switch m.Status {
+	case JourneyOpen:
+	case JourneyCompleted:
+		errs = errors.AppendField(errs, "ExitStationKey", orm.ValidateSequence(m.ExitStationKey))
+		errs = errors.AppendField(errs, "EndedAt", m.EndedAt.Validate())
+	case JourneyExpired:
+		errs = errors.AppendField(errs, "EndedAt", m.EndedAt.Validate())
+	default:
+		errs = errors.AppendField(errs, "Status", errors.Wrapf(errors.ErrInput, "unknown status %d", m.Status))
+	}
+	if m.Fare != nil {
+		errs = errors.AppendField(errs, "Fare", validateFare(*m.Fare))
+	}
+
+	return errs
+}
+
+// IsOpen returns true if the passenger did not exit yet.
+func (m *Journey) IsOpen() bool {
+	return m.Status == JourneyOpen
+}
+
+var _ orm.SerialModel = (*Pass)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Pass) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates pass fields
+func (m *Pass) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "Holder", m.Holder.Validate())
+	errs = errors.AppendField(errs, "Kind", validatePassKind(m.Kind))
+	errs = errors.AppendField(errs, "ValidFrom", m.ValidFrom.Validate())
+	errs = errors.AppendField(errs, "ValidUntil", m.ValidUntil.Validate())
+	if m.ValidUntil <= m.ValidFrom {
+		errs = errors.AppendField(errs, "ValidUntil", errors.Wrap(errors.ErrInput, "must be after valid from"))
+	}
+	errs = errors.AppendField(errs, "Price", validateFare(m.Price))
+	errs = errors.AppendField(errs, "Released", validateFare(m.Released))
+	if m.IsCancelled() {
+		errs = errors.AppendField(errs, "CancelledAt", m.CancelledAt.Validate())
+	}
+
+	return errs
+}
+
+// IsCancelled returns true if the holder ended the pass early.
+func (m *Pass) IsCancelled() bool {
+	return m.CancelledAt != 0
+}
+
+// IsValid returns true if the pass can be used at given time.
+func (m *Pass) IsValid(at weave.UnixTime) bool {
+	return !m.IsCancelled() && m.ValidFrom <= at && at < m.ValidUntil
+}
+
+// validatePassKind returns an error if the pass kind is not one that can be
+// sold.
+func validatePassKind(k PassKind) error {
+	if _, ok := passDurations[k]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown pass kind %d", k)
+	}
+	return nil
+}
+
+var _ orm.SerialModel = (*Connection)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Connection) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates connection fields
+func (m *Connection) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "FromStationKey", orm.ValidateSequence(m.FromStationKey))
+	errs = errors.AppendField(errs, "ToStationKey", orm.ValidateSequence(m.ToStationKey))
+	if string(m.FromStationKey) == string(m.ToStationKey) {
+		errs = errors.AppendField(errs, "ToStationKey", errors.Wrap(errors.ErrInput, "station cannot be connected to itself"))
+	}
+	if m.TravelMinutes == 0 {
+		errs = errors.AppendField(errs, "TravelMinutes", errors.Wrap(errors.ErrEmpty, "travel time is required"))
+	}
+
+	return errs
+}
+
+var _ orm.SerialModel = (*ScheduledStop)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *ScheduledStop) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates scheduled stop fields
+func (m *ScheduledStop) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "LineKey", orm.ValidateSequence(m.LineKey))
+	errs = errors.AppendField(errs, "PlannedAt", m.PlannedAt.Validate())
+
+	return errs
+}
+
+var _ orm.Model = (*LineDelay)(nil)
+
+// Validate validates line delay fields
+func (m *LineDelay) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.RecentDelays) > lineDelayWindow {
+		errs = errors.AppendField(errs, "RecentDelays", errors.Wrapf(errors.ErrInput, "must not exceed %d", lineDelayWindow))
+	}
+	errs = errors.AppendField(errs, "UpdatedAt", m.UpdatedAt.Validate())
+
+	return errs
+}
+
+var _ orm.Model = (*TrainPosition)(nil)
+
+// Validate validates train position fields
+func (m *TrainPosition) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "ArrivedAt", m.ArrivedAt.Validate())
+	errs = errors.AppendField(errs, "ArrivalKey", orm.ValidateSequence(m.ArrivalKey))
+	// PreviousStationKey and LineKey fields are optional.
+	if len(m.PreviousStationKey) != 0 {
+		errs = errors.AppendField(errs, "PreviousStationKey", orm.ValidateSequence(m.PreviousStationKey))
+	}
+	if len(m.LineKey) != 0 {
+		errs = errors.AppendField(errs, "LineKey", orm.ValidateSequence(m.LineKey))
+	}
+	if _, ok := Direction_name[int32(m.Direction)]; !ok {
+		errs = errors.AppendField(errs, "Direction", errors.Wrapf(errors.ErrInput, "unknown direction %d", m.Direction))
+	}
+
+	return errs
+}
+
+// IsAtStation returns true if the train arrived at given station and did not
+// depart yet.
+func (m *TrainPosition) IsAtStation(stationKey []byte) bool {
+	return m.DepartedAt == 0 && bytes.Equal(m.StationKey, stationKey)
+}
+
+var _ orm.SerialModel = (*Timetable)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Timetable) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates timetable fields
+func (m *Timetable) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "LineKey", orm.ValidateSequence(m.LineKey))
+	errs = errors.AppendField(errs, "Service", validateServicePattern(m.Service))
+	errs = errors.AppendField(errs, "Direction", validateDirection(m.Direction))
+	errs = errors.AppendField(errs, "Stops", validateTimetableStops(m.Stops))
+	errs = errors.AppendField(errs, "PublishedAt", m.PublishedAt.Validate())
+	if !m.IsActive() {
+		errs = errors.AppendField(errs, "SupersededAt", m.SupersededAt.Validate())
+	}
+	if len(m.SupersededBy) != 0 {
+		errs = errors.AppendField(errs, "SupersededBy", orm.ValidateSequence(m.SupersededBy))
+	}
+
+	return errs
+}
+
+// IsActive returns true if the timetable was not superseded.
+func (m *Timetable) IsActive() bool {
+	return m.SupersededAt == 0
+}
+
+func validateServicePattern(p ServicePattern) error {
+	if _, ok := ServicePattern_name[int32(p)]; !ok || p == ServiceInvalid {
+		return errors.Wrapf(errors.ErrInput, "invalid service pattern %d", p)
+	}
+	return nil
+}
+
+func validateDirection(d Direction) error {
+	if _, ok := Direction_name[int32(d)]; !ok || d == DirectionInvalid {
+		return errors.Wrapf(errors.ErrInput, "invalid direction %d", d)
+	}
+	return nil
+}
+
+// validateTimetableStops ensures every station is listed once and its
+// departures are ascending minutes of the day.
+func validateTimetableStops(stops []TimetableStop) error {
+	if len(stops) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "at least one stop is required")
+	}
+	seen := make(map[string]struct{}, len(stops))
+	for i, stop := range stops {
+		if err := orm.ValidateSequence(stop.StationKey); err != nil {
+			return errors.Wrapf(err, "stop %d", i)
+		}
+		if _, ok := seen[string(stop.StationKey)]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "stop %d", i)
+		}
+		seen[string(stop.StationKey)] = struct{}{}
+		if len(stop.Departures) == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "stop %d has no departures", i)
+		}
+		for j, d := range stop.Departures {
+			if d >= minutesPerDay {
+				return errors.Wrapf(errors.ErrInput, "stop %d departure %d is not a minute of the day", i, j)
+			}
+			if j > 0 && d <= stop.Departures[j-1] {
+				return errors.Wrapf(errors.ErrInput, "stop %d departures are not in ascending order", i)
+			}
+		}
+	}
+	return nil
+}
+
+var _ orm.Model = (*Equipment)(nil)
+
+// Validate validates equipment fields
+func (m *Equipment) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "Type", validateEquipmentType(m.Type))
+	errs = errors.AppendField(errs, "Identifier", validateEquipmentIdentifier(m.Identifier))
+	errs = errors.AppendField(errs, "Status", validateEquipmentStatus(m.Status))
+	errs = errors.AppendField(errs, "UpdatedAt", m.UpdatedAt.Validate())
+	errs = errors.AppendField(errs, "ReportedBy", m.ReportedBy.Validate())
+
+	return errs
+}
+
+func validateEquipmentType(t EquipmentType) error {
+	if _, ok := EquipmentType_name[int32(t)]; !ok || t == EquipmentInvalid {
+		return errors.Wrapf(errors.ErrInput, "invalid type %d", t)
+	}
+	return nil
+}
+
+func validateEquipmentStatus(s EquipmentStatus) error {
+	if _, ok := EquipmentStatus_name[int32(s)]; !ok || s == StatusInvalid {
+		return errors.Wrapf(errors.ErrInput, "invalid status %d", s)
+	}
+	return nil
+}
+
+func validateEquipmentIdentifier(id string) error {
+	if id == "" {
+		return errors.Wrap(errors.ErrEmpty, "identifier is required")
+	}
+	if len(id) > 32 {
+		return errors.Wrap(errors.ErrInput, "identifier too long")
+	}
+	return nil
+}
+
+var _ orm.Model = (*FareTable)(nil)
+
+// Validate validates fare table fields
+func (m *FareTable) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "BaseFare", validateFare(m.BaseFare))
+	errs = errors.AppendField(errs, "StopFare", validateFare(m.StopFare))
+	errs = errors.AppendField(errs, "ZoneFare", validateFare(m.ZoneFare))
+
+	zoned := make(map[string]struct{}, len(m.Zones))
+	for _, z := range m.Zones {
+		if err := orm.ValidateSequence(z.StationKey); err != nil {
+			errs = errors.AppendField(errs, "Zones", err)
+			continue
+		}
+		if _, ok := zoned[string(z.StationKey)]; ok {
+			errs = errors.AppendField(errs, "Zones", errors.Wrapf(errors.ErrDuplicate, "station %x", z.StationKey))
+		}
+		zoned[string(z.StationKey)] = struct{}{}
+	}
+
+	for i, t := range m.TimeMultipliers {
+		switch {
+		case t.StartMinute >= t.EndMinute:
+			errs = errors.AppendField(errs, "TimeMultipliers", errors.Wrapf(errors.ErrInput, "period %d must start before it ends", i))
+		case t.EndMinute > minutesPerDay:
+			errs = errors.AppendField(errs, "TimeMultipliers", errors.Wrapf(errors.ErrInput, "period %d must end within a day", i))
+		case t.Percent == 0:
+			errs = errors.AppendField(errs, "TimeMultipliers", errors.Wrapf(errors.ErrInput, "period %d percent is required", i))
+		}
+	}
+
+	discounted := make(map[PassengerCategory]struct{}, len(m.Discounts))
+	for _, d := range m.Discounts {
+		if err := validateCategory(d.Category); err != nil {
+			errs = errors.AppendField(errs, "Discounts", err)
+		}
+		if d.Percent > 100 {
+			errs = errors.AppendField(errs, "Discounts", errors.Wrapf(errors.ErrInput, "%s discount exceeds 100 percent", d.Category))
+		}
+		if _, ok := discounted[d.Category]; ok {
+			errs = errors.AppendField(errs, "Discounts", errors.Wrapf(errors.ErrDuplicate, "%s discount", d.Category))
+		}
+		discounted[d.Category] = struct{}{}
+	}
+
+	if m.WeeklyPass != nil {
+		errs = errors.AppendField(errs, "WeeklyPass", validateFare(*m.WeeklyPass))
+	}
+	if m.MonthlyPass != nil {
+		errs = errors.AppendField(errs, "MonthlyPass", validateFare(*m.MonthlyPass))
+	}
+
+	return errs
+}
+
+// validateFare ensures a fare amount is not negative. Zero value fares are
+// allowed to be left without a ticker.
+func validateFare(c coin.Coin) error {
+	if c.IsZero() {
+		return nil
+	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if !c.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "fare cannot be negative")
+	}
+	return nil
+}
+
+var _ orm.SerialModel = (*FareChange)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *FareChange) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates fare change fields
+func (m *FareChange) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	if m.FareTable == nil {
+		errs = errors.AppendField(errs, "FareTable", errors.ErrEmpty)
+	} else {
+		errs = errors.AppendField(errs, "FareTable", m.FareTable.Validate())
+	}
+	errs = errors.AppendField(errs, "ActivateAt", validateActivation(m.ActivateAt))
+	errs = errors.AppendField(errs, "ScheduledBy", m.ScheduledBy.Validate())
+
+	return errs
+}
+
+// IsActivated returns true if the fare table of the change is in use.
+func (m *FareChange) IsActivated() bool {
+	return m.ActivatedAt != 0
+}
+
+// validateActivation ensures an activation time is given.
+func validateActivation(t weave.UnixTime) error {
+	if t == 0 {
+		return errors.Wrap(errors.ErrEmpty, "activation time is required")
+	}
+	return t.Validate()
+}
+
+var _ orm.SerialModel = (*Incident)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Incident) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates incident fields
+func (m *Incident) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "Severity", validateIncidentSeverity(m.Severity))
+	errs = errors.AppendField(errs, "StationKeys", validateAffectedStations(m.StationKeys))
+	errs = errors.AppendField(errs, "StartedAt", m.StartedAt.Validate())
+	errs = errors.AppendField(errs, "ExpectedEndAt", validateExpectedEnd(m.StartedAt, m.ExpectedEndAt))
+	errs = errors.AppendField(errs, "Messages", validateLocalizedTexts(m.Messages))
+	errs = errors.AppendField(errs, "OpenedBy", m.OpenedBy.Validate())
+
+	return errs
+}
+
+// IsResolved returns true if the service is back to normal.
+func (m *Incident) IsResolved() bool {
+	return m.ResolvedAt != 0
+}
+
+// IsActive returns true if the incident affects the service at given time.
+func (m *Incident) IsActive(now weave.UnixTime) bool {
+	return !m.IsResolved() && m.StartedAt <= now
+}
+
+// Affects returns true if given station is affected by the incident.
+func (m *Incident) Affects(stationKey []byte) bool {
+	for _, k := range m.StationKeys {
+		if bytes.Equal(k, stationKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateIncidentSeverity(s IncidentSeverity) error {
+	if _, ok := IncidentSeverity_name[int32(s)]; !ok || s == SeverityInvalid {
+		return errors.Wrapf(errors.ErrInput, "invalid severity %d", s)
+	}
+	return nil
+}
+
+// validateAffectedStations ensures an incident affects at least one station
+// and that every station is listed only once.
+func validateAffectedStations(keys [][]byte) error {
+	if len(keys) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "at least one station is required")
+	}
+	seen := make(map[string]struct{}, len(keys))
+	for i, k := range keys {
+		if err := orm.ValidateSequence(k); err != nil {
+			return errors.Wrapf(err, "station %d", i)
+		}
+		if _, ok := seen[string(k)]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "station %d", i)
+		}
+		seen[string(k)] = struct{}{}
+	}
+	return nil
+}
+
+func validateExpectedEnd(start, end weave.UnixTime) error {
+	if err := end.Validate(); err != nil {
+		return err
+	}
+	if end <= start {
+		return errors.Wrap(errors.ErrInput, "must be after the start")
+	}
+	return nil
+}
+
+// languageRule accepts ISO 639-1 language codes.
+var languageRule = regexp.MustCompile(`^[a-z]{2}$`)
+
+// maxLocalizedTextLength limits the length of a single announcement.
+const maxLocalizedTextLength = 512
+
+// validateLocalizedTexts ensures there is at least one text and every
+// language is provided only once.
+func validateLocalizedTexts(texts []LocalizedText) error {
+	if len(texts) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "at least one message is required")
+	}
+	seen := make(map[string]struct{}, len(texts))
+	for _, t := range texts {
+		if !languageRule.MatchString(t.Language) {
+			return errors.Wrapf(errors.ErrInput, "invalid language %q", t.Language)
+		}
+		if _, ok := seen[t.Language]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "%s message", t.Language)
+		}
+		seen[t.Language] = struct{}{}
+		switch n := len(t.Text); {
+		case n == 0:
+			return errors.Wrapf(errors.ErrEmpty, "%s message", t.Language)
+		case n > maxLocalizedTextLength:
+			return errors.Wrapf(errors.ErrInput, "%s message too long", t.Language)
+		}
+	}
+	return nil
+}
@@ -1,13 +1,50 @@
 package metro
 
 import (
+	"regexp"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 )
 
 func init() {
 	migration.MustRegister(1, &RegisterPassengerMsg{}, migration.NoModification)
 	migration.MustRegister(1, &TrainArriveStationEventMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TrainDepartStationEventMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CreateLineMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateLineMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TapInMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TapOutMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateFareTableMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SetPassengerCategoryMsg{}, migration.NoModification)
+	migration.MustRegister(1, &PayFareMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdatePassengerMsg{}, migration.NoModification)
+	migration.MustRegister(1, &DeregisterPassengerMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CreateStationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateStationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RetireStationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RegisterTrainMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReassignTrainMsg{}, migration.NoModification)
+	migration.MustRegister(1, &DecommissionTrainMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReportEquipmentStatusMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SetConnectionMsg{}, migration.NoModification)
+	migration.MustRegister(1, &PublishTimetableMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SupersedeTimetableMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ScheduleStopMsg{}, migration.NoModification)
+	migration.MustRegister(1, &OpenIncidentMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateIncidentMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ResolveIncidentMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MarkTrainSilentMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ScheduleFareChangeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ActivateFareChangeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &StartJourneyMsg{}, migration.NoModification)
+	migration.MustRegister(1, &EndJourneyMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ExpireJourneyMsg{}, migration.NoModification)
+	migration.MustRegister(1, &PurchasePassMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CancelPassMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReleasePassMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*RegisterPassengerMsg)(nil)
@@ -32,6 +69,706 @@ func (TrainArriveStationEventMsg) Path() string {
 
 // Validate ensures the TrainArriveStationEventMsg is valid
 func (m TrainArriveStationEventMsg) Validate() error {
-	// data to validate
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*TrainDepartStationEventMsg)(nil)
+
+// Path returns the routing path for this message.
+func (TrainDepartStationEventMsg) Path() string {
+	return "metro/train_depart_station"
+}
+
+// Validate ensures the TrainDepartStationEventMsg is valid
+func (m TrainDepartStationEventMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*CreateLineMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CreateLineMsg) Path() string {
+	return "metro/create_line"
+}
+
+// Validate ensures the CreateLineMsg is valid
+func (m CreateLineMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Name", validateLineName(m.Name))
+	errs = errors.AppendField(errs, "Color", validateLineColor(m.Color))
+	errs = errors.AppendField(errs, "StationKeys", validateStationKeys(m.StationKeys))
+
+	return errs
+}
+
+var _ weave.Msg = (*UpdateLineMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UpdateLineMsg) Path() string {
+	return "metro/update_line"
+}
+
+// Validate ensures the UpdateLineMsg is valid
+func (m UpdateLineMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "LineKey", orm.ValidateSequence(m.LineKey))
+	errs = errors.AppendField(errs, "Name", validateLineName(m.Name))
+	errs = errors.AppendField(errs, "Color", validateLineColor(m.Color))
+	errs = errors.AppendField(errs, "StationKeys", validateStationKeys(m.StationKeys))
+
+	return errs
+}
+
+var _ weave.Msg = (*TapInMsg)(nil)
+
+// Path returns the routing path for this message.
+func (TapInMsg) Path() string {
+	return "metro/tap_in"
+}
+
+// Validate ensures the TapInMsg is valid
+func (m TapInMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*TapOutMsg)(nil)
+
+// Path returns the routing path for this message.
+func (TapOutMsg) Path() string {
+	return "metro/tap_out"
+}
+
+// Validate ensures the TapOutMsg is valid
+func (m TapOutMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*UpdateFareTableMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UpdateFareTableMsg) Path() string {
+	return "metro/update_fare_table"
+}
+
+// Validate ensures the UpdateFareTableMsg is valid
+func (m UpdateFareTableMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.FareTable == nil {
+		errs = errors.AppendField(errs, "FareTable", errors.ErrEmpty)
+	} else {
+		errs = errors.AppendField(errs, "FareTable", m.FareTable.Validate())
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*SetPassengerCategoryMsg)(nil)
+
+// Path returns the routing path for this message.
+func (SetPassengerCategoryMsg) Path() string {
+	return "metro/set_passenger_category"
+}
+
+// Validate ensures the SetPassengerCategoryMsg is valid
+func (m SetPassengerCategoryMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "Category", validateCategory(m.Category))
+	if m.Category != CategoryStandard {
+		errs = errors.AppendField(errs, "ExpiresAt", m.ExpiresAt.Validate())
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*PayFareMsg)(nil)
+
+// Path returns the routing path for this message.
+func (PayFareMsg) Path() string {
+	return "metro/pay_fare"
+}
+
+// Validate ensures the PayFareMsg is valid
+func (m PayFareMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "EntryStationKey", orm.ValidateSequence(m.EntryStationKey))
+	errs = errors.AppendField(errs, "ExitStationKey", orm.ValidateSequence(m.ExitStationKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*UpdatePassengerMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UpdatePassengerMsg) Path() string {
+	return "metro/update_passenger"
+}
+
+// Validate ensures the UpdatePassengerMsg is valid
+func (m UpdatePassengerMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	// Address field is optional.
+	if len(m.Address) != 0 {
+		errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*DeregisterPassengerMsg)(nil)
+
+// Path returns the routing path for this message.
+func (DeregisterPassengerMsg) Path() string {
+	return "metro/deregister_passenger"
+}
+
+// Validate ensures the DeregisterPassengerMsg is valid
+func (m DeregisterPassengerMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*CreateStationMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CreateStationMsg) Path() string {
+	return "metro/create_station"
+}
+
+// Validate ensures the CreateStationMsg is valid
+func (m CreateStationMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Station", validateStationName(m.Station))
+	errs = errors.AppendField(errs, "Escalator", validateCount(m.Escalator))
+	errs = errors.AppendField(errs, "Elevator", validateCount(m.Elevator))
+	errs = errors.AppendField(errs, "TicketOffice", validateCount(m.TicketOffice))
+	errs = errors.AppendField(errs, "TollGateEnt", validateCount(m.TollGateEnt))
+	errs = errors.AppendField(errs, "TollGateEx", validateCount(m.TollGateEx))
+	errs = errors.AppendField(errs, "EntranceExit", validateCount(m.EntranceExit))
+	for _, g := range m.Gates {
+		errs = errors.AppendField(errs, "Gates", g.Validate())
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*UpdateStationMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UpdateStationMsg) Path() string {
+	return "metro/update_station"
+}
+
+// Validate ensures the UpdateStationMsg is valid
+func (m UpdateStationMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "Station", validateStationName(m.Station))
+	errs = errors.AppendField(errs, "Escalator", validateCount(m.Escalator))
+	errs = errors.AppendField(errs, "Elevator", validateCount(m.Elevator))
+	errs = errors.AppendField(errs, "TicketOffice", validateCount(m.TicketOffice))
+	errs = errors.AppendField(errs, "TollGateEnt", validateCount(m.TollGateEnt))
+	errs = errors.AppendField(errs, "TollGateEx", validateCount(m.TollGateEx))
+	errs = errors.AppendField(errs, "EntranceExit", validateCount(m.EntranceExit))
+	for _, g := range m.Gates {
+		errs = errors.AppendField(errs, "Gates", g.Validate())
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*RetireStationMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RetireStationMsg) Path() string {
+	return "metro/retire_station"
+}
+
+// Validate ensures the RetireStationMsg is valid
+func (m RetireStationMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*RegisterTrainMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RegisterTrainMsg) Path() string {
+	return "metro/register_train"
+}
+
+// Validate ensures the RegisterTrainMsg is valid
+func (m RegisterTrainMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	if m.Model == "" {
+		errs = errors.AppendField(errs, "Model", errors.Wrap(errors.ErrEmpty, "model is required"))
+	}
+	if m.Capacity == 0 {
+		errs = errors.AppendField(errs, "Capacity", errors.Wrap(errors.ErrEmpty, "capacity is required"))
+	}
+	if m.CarCount == 0 {
+		errs = errors.AppendField(errs, "CarCount", errors.Wrap(errors.ErrEmpty, "car count is required"))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*ReassignTrainMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ReassignTrainMsg) Path() string {
+	return "metro/reassign_train"
+}
+
+// Validate ensures the ReassignTrainMsg is valid
+func (m ReassignTrainMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+
+	return errs
+}
+
+var _ weave.Msg = (*DecommissionTrainMsg)(nil)
+
+// Path returns the routing path for this message.
+func (DecommissionTrainMsg) Path() string {
+	return "metro/decommission_train"
+}
+
+// Validate ensures the DecommissionTrainMsg is valid
+func (m DecommissionTrainMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*ReportEquipmentStatusMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ReportEquipmentStatusMsg) Path() string {
+	return "metro/report_equipment_status"
+}
+
+// Validate ensures the ReportEquipmentStatusMsg is valid
+func (m ReportEquipmentStatusMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "Type", validateEquipmentType(m.Type))
+	errs = errors.AppendField(errs, "Identifier", validateEquipmentIdentifier(m.Identifier))
+	errs = errors.AppendField(errs, "Status", validateEquipmentStatus(m.Status))
+
+	return errs
+}
+
+var _ weave.Msg = (*SetConnectionMsg)(nil)
+
+// Path returns the routing path for this message.
+func (SetConnectionMsg) Path() string {
+	return "metro/set_connection"
+}
+
+// Validate ensures the SetConnectionMsg is valid
+func (m SetConnectionMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "FromStationKey", orm.ValidateSequence(m.FromStationKey))
+	errs = errors.AppendField(errs, "ToStationKey", orm.ValidateSequence(m.ToStationKey))
+	if string(m.FromStationKey) == string(m.ToStationKey) {
+		errs = errors.AppendField(errs, "ToStationKey", errors.Wrap(errors.ErrInput, "station cannot be connected to itself"))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*PublishTimetableMsg)(nil)
+
+// Path returns the routing path for this message.
+func (PublishTimetableMsg) Path() string {
+	return "metro/publish_timetable"
+}
+
+// Validate ensures the PublishTimetableMsg is valid
+func (m PublishTimetableMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "LineKey", orm.ValidateSequence(m.LineKey))
+	errs = errors.AppendField(errs, "Service", validateServicePattern(m.Service))
+	errs = errors.AppendField(errs, "Direction", validateDirection(m.Direction))
+	errs = errors.AppendField(errs, "Stops", validateTimetableStops(m.Stops))
+
+	return errs
+}
+
+var _ weave.Msg = (*SupersedeTimetableMsg)(nil)
+
+// Path returns the routing path for this message.
+func (SupersedeTimetableMsg) Path() string {
+	return "metro/supersede_timetable"
+}
+
+// Validate ensures the SupersedeTimetableMsg is valid
+func (m SupersedeTimetableMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "TimetableKey", orm.ValidateSequence(m.TimetableKey))
+	errs = errors.AppendField(errs, "Stops", validateTimetableStops(m.Stops))
+
+	return errs
+}
+
+var _ weave.Msg = (*ScheduleStopMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ScheduleStopMsg) Path() string {
+	return "metro/schedule_stop"
+}
+
+// Validate ensures the ScheduleStopMsg is valid
+func (m ScheduleStopMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+	errs = errors.AppendField(errs, "LineKey", orm.ValidateSequence(m.LineKey))
+	errs = errors.AppendField(errs, "PlannedAt", m.PlannedAt.Validate())
+
+	return errs
+}
+
+var _ weave.Msg = (*OpenIncidentMsg)(nil)
+
+// Path returns the routing path for this message.
+func (OpenIncidentMsg) Path() string {
+	return "metro/open_incident"
+}
+
+// Validate ensures the OpenIncidentMsg is valid
+func (m OpenIncidentMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Severity", validateIncidentSeverity(m.Severity))
+	errs = errors.AppendField(errs, "StationKeys", validateAffectedStations(m.StationKeys))
+	errs = errors.AppendField(errs, "StartedAt", m.StartedAt.Validate())
+	errs = errors.AppendField(errs, "ExpectedEndAt", validateExpectedEnd(m.StartedAt, m.ExpectedEndAt))
+	errs = errors.AppendField(errs, "Messages", validateLocalizedTexts(m.Messages))
+
+	return errs
+}
+
+var _ weave.Msg = (*UpdateIncidentMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UpdateIncidentMsg) Path() string {
+	return "metro/update_incident"
+}
+
+// Validate ensures the UpdateIncidentMsg is valid
+func (m UpdateIncidentMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "IncidentKey", orm.ValidateSequence(m.IncidentKey))
+	errs = errors.AppendField(errs, "Severity", validateIncidentSeverity(m.Severity))
+	errs = errors.AppendField(errs, "StationKeys", validateAffectedStations(m.StationKeys))
+	errs = errors.AppendField(errs, "ExpectedEndAt", m.ExpectedEndAt.Validate())
+	errs = errors.AppendField(errs, "Messages", validateLocalizedTexts(m.Messages))
+
+	return errs
+}
+
+var _ weave.Msg = (*ResolveIncidentMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ResolveIncidentMsg) Path() string {
+	return "metro/resolve_incident"
+}
+
+// Validate ensures the ResolveIncidentMsg is valid
+func (m ResolveIncidentMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "IncidentKey", orm.ValidateSequence(m.IncidentKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*MarkTrainSilentMsg)(nil)
+
+// Path returns the routing path for this message.
+func (MarkTrainSilentMsg) Path() string {
+	return "metro/mark_train_silent"
+}
+
+// Validate ensures the MarkTrainSilentMsg is valid
+func (m MarkTrainSilentMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "TrainKey", orm.ValidateSequence(m.TrainKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*ScheduleFareChangeMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ScheduleFareChangeMsg) Path() string {
+	return "metro/schedule_fare_change"
+}
+
+// Validate ensures the ScheduleFareChangeMsg is valid
+func (m ScheduleFareChangeMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.FareTable == nil {
+		errs = errors.AppendField(errs, "FareTable", errors.ErrEmpty)
+	} else {
+		errs = errors.AppendField(errs, "FareTable", m.FareTable.Validate())
+	}
+	errs = errors.AppendField(errs, "ActivateAt", validateActivation(m.ActivateAt))
+
+	return errs
+}
+
+var _ weave.Msg = (*ActivateFareChangeMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ActivateFareChangeMsg) Path() string {
+	return "metro/activate_fare_change"
+}
+
+// Validate ensures the ActivateFareChangeMsg is valid
+func (m ActivateFareChangeMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "FareChangeKey", orm.ValidateSequence(m.FareChangeKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*StartJourneyMsg)(nil)
+
+// Path returns the routing path for this message.
+func (StartJourneyMsg) Path() string {
+	return "metro/start_journey"
+}
+
+// Validate ensures the StartJourneyMsg is valid
+func (m StartJourneyMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*EndJourneyMsg)(nil)
+
+// Path returns the routing path for this message.
+func (EndJourneyMsg) Path() string {
+	return "metro/end_journey"
+}
+
+// Validate ensures the EndJourneyMsg is valid
+func (m EndJourneyMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(m.StationKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*ExpireJourneyMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ExpireJourneyMsg) Path() string {
+	return "metro/expire_journey"
+}
+
+// Validate ensures the ExpireJourneyMsg is valid
+func (m ExpireJourneyMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "JourneyKey", orm.ValidateSequence(m.JourneyKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*PurchasePassMsg)(nil)
+
+// Path returns the routing path for this message.
+func (PurchasePassMsg) Path() string {
+	return "metro/purchase_pass"
+}
+
+// Validate ensures the PurchasePassMsg is valid
+func (m PurchasePassMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassengerKey", orm.ValidateSequence(m.PassengerKey))
+	errs = errors.AppendField(errs, "Kind", validatePassKind(m.Kind))
+	if m.ValidFrom != 0 {
+		errs = errors.AppendField(errs, "ValidFrom", m.ValidFrom.Validate())
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*CancelPassMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CancelPassMsg) Path() string {
+	return "metro/cancel_pass"
+}
+
+// Validate ensures the CancelPassMsg is valid
+func (m CancelPassMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassKey", orm.ValidateSequence(m.PassKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*ReleasePassMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ReleasePassMsg) Path() string {
+	return "metro/release_pass"
+}
+
+// Validate ensures the ReleasePassMsg is valid
+func (m ReleasePassMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PassKey", orm.ValidateSequence(m.PassKey))
+
+	return errs
+}
+
+func validateStationName(name string) error {
+	if name == "" {
+		return errors.Wrap(errors.ErrEmpty, "name is required")
+	}
+	return nil
+}
+
+func validateCount(n int64) error {
+	if n < 0 {
+		return errors.Wrapf(errors.ErrInput, "negative count %d", n)
+	}
+	return nil
+}
+
+func validateLineName(name string) error {
+	if name == "" {
+		return errors.Wrap(errors.ErrEmpty, "name is required")
+	}
+	return nil
+}
+
+// lineColorRule accepts colors in the #rrggbb hex notation.
+var lineColorRule = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func validateLineColor(color string) error {
+	if !lineColorRule.MatchString(color) {
+		return errors.Wrapf(errors.ErrInput, "invalid color %q", color)
+	}
+	return nil
+}
+
+// validateStationKeys ensures a line visits at least two stations and that
+// every station is listed only once.
+func validateStationKeys(keys [][]byte) error {
+	if len(keys) < 2 {
+		return errors.Wrap(errors.ErrInput, "a line must have at least two stations")
+	}
+	seen := make(map[string]struct{}, len(keys))
+	for i, k := range keys {
+		if err := orm.ValidateSequence(k); err != nil {
+			return errors.Wrapf(err, "station %d", i)
+		}
+		if _, ok := seen[string(k)]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "station %d", i)
+		}
+		seen[string(k)] = struct{}{}
+	}
 	return nil
 }
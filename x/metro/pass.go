@@ -0,0 +1,117 @@
+package metro
+
+import (
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// passDurations is the validity period of every pass kind that can be sold.
+var passDurations = map[PassKind]time.Duration{
+	PassWeekly:  7 * 24 * time.Hour,
+	PassMonthly: 30 * 24 * time.Hour,
+}
+
+// PassCondition returns the condition of the escrow that holds the price of
+// given pass. It is never granted, funds leave the escrow only through the
+// pass handlers.
+func PassCondition(passKey []byte) weave.Condition {
+	return weave.NewCondition(packageName, "pass", passKey)
+}
+
+// passPrice returns the price of given pass kind. It returns ErrState if the
+// fare table does not sell passes of that kind.
+func passPrice(table *FareTable, kind PassKind) (coin.Coin, error) {
+	var price *coin.Coin
+	switch kind {
+	case PassWeekly:
+		price = table.WeeklyPass
+	case PassMonthly:
+		price = table.MonthlyPass
+	}
+	if price == nil {
+		return coin.Coin{}, errors.Wrapf(errors.ErrState, "%s passes are not sold", kind)
+	}
+	return *price, nil
+}
+
+// releasable returns the part of the pass price that is earned by the
+// operator at given time but not released from the escrow yet. The price is
+// earned pro-rata by the second until the pass expires or is cancelled.
+func releasable(p *Pass, at weave.UnixTime) (coin.Coin, error) {
+	end := at
+	if p.IsCancelled() && p.CancelledAt < end {
+		end = p.CancelledAt
+	}
+	if p.ValidUntil < end {
+		end = p.ValidUntil
+	}
+	var elapsed int64
+	if end > p.ValidFrom {
+		elapsed = int64(end - p.ValidFrom)
+	}
+
+	earned, err := p.Price.Multiply(elapsed)
+	if err != nil {
+		return coin.Coin{}, errors.Wrap(err, "earned amount")
+	}
+	if earned, _, err = earned.Divide(int64(p.ValidUntil - p.ValidFrom)); err != nil {
+		return coin.Coin{}, errors.Wrap(err, "earned amount")
+	}
+	due, err := earned.Subtract(p.Released)
+	if err != nil {
+		return coin.Coin{}, errors.Wrap(err, "released amount")
+	}
+	return due, nil
+}
+
+// validPassQuery handles the /passes/valid query path. Query data must be a
+// serialized ValidPassRequest. All passes of the address that can be used at
+// the requested time are returned, none if the address holds no valid pass.
+type validPassQuery struct {
+	passes orm.SerialModelBucket
+}
+
+var _ weave.QueryHandler = validPassQuery{}
+
+func (q validPassQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrInput, "only key queries are supported")
+	}
+	var req ValidPassRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal request")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+	var passes []Pass
+	if err := q.passes.ByIndex(db, "holder", req.Address, &passes); err != nil {
+		return nil, errors.Wrap(err, "cannot load passes")
+	}
+	var res []weave.Model
+	for _, p := range passes {
+		if !p.IsValid(req.At) {
+			continue
+		}
+		raw, err := p.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal pass")
+		}
+		res = append(res, weave.Pair(p.PrimaryKey, raw))
+	}
+	return res, nil
+}
+
+// Validate ensures the ValidPassRequest is valid
+func (r *ValidPassRequest) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Address", r.Address.Validate())
+	errs = errors.AppendField(errs, "At", r.At.Validate())
+
+	return errs
+}
@@ -0,0 +1,247 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestPass(t *testing.T) {
+	collector := weavetest.NewCondition().Address()
+	passengerSigner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	saveAll(t, db, NewPassengerBucket(), &Passenger{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  passengerSigner.Address(),
+	})
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Admin:         weavetest.NewCondition().Address(),
+		FareCollector: collector,
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	weekly := coin.NewCoin(14, 0, "METR")
+	if _, err := NewFareTableBucket().Put(db, fareTableKey, &FareTable{
+		Metadata:   &weave.Metadata{Schema: 1},
+		BaseFare:   coin.NewCoin(1, 0, "METR"),
+		WeeklyPass: &weekly,
+	}); err != nil {
+		t.Fatalf("cannot save fare table: %s", err)
+	}
+	ctrl := cash.NewController(cash.NewBucket())
+	if err := ctrl.CoinMint(db, passengerSigner.Address(), coin.NewCoin(100, 0, "METR")); err != nil {
+		t.Fatalf("cannot fund passenger: %s", err)
+	}
+
+	now := time.Date(2019, time.June, 3, 8, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) weave.Context {
+		return weave.WithBlockTime(context.Background(), now.Add(d))
+	}
+	purchase := func(signer weave.Condition, kind PassKind, from weave.UnixTime) (*weave.DeliverResult, error) {
+		h := NewPurchasePassHandler(&weavetest.Auth{Signer: signer}, ctrl)
+		return h.Deliver(at(0), db, &weavetest.Tx{Msg: &PurchasePassMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PassengerKey: weavetest.SequenceID(1),
+			Kind:         kind,
+			ValidFrom:    from,
+		}})
+	}
+	cancel := func(signer weave.Condition, passKey []byte, d time.Duration) error {
+		h := NewCancelPassHandler(&weavetest.Auth{Signer: signer}, ctrl)
+		_, err := h.Deliver(at(d), db, &weavetest.Tx{Msg: &CancelPassMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			PassKey:  passKey,
+		}})
+		return err
+	}
+	release := func(passKey []byte, d time.Duration) error {
+		h := NewReleasePassHandler(&weavetest.Auth{Signer: stranger}, ctrl)
+		_, err := h.Deliver(at(d), db, &weavetest.Tx{Msg: &ReleasePassMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			PassKey:  passKey,
+		}})
+		return err
+	}
+	validPasses := func(addr weave.Address, d time.Duration) int {
+		t.Helper()
+		req := ValidPassRequest{Address: addr, At: weave.AsUnixTime(now.Add(d))}
+		raw, err := req.Marshal()
+		if err != nil {
+			t.Fatalf("cannot marshal request: %s", err)
+		}
+		res, err := validPassQuery{passes: NewPassBucket()}.Query(db, weave.KeyQueryMod, raw)
+		if err != nil {
+			t.Fatalf("cannot query valid passes: %+v", err)
+		}
+		return len(res)
+	}
+
+	if _, err := purchase(passengerSigner, PassMonthly, 0); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error for a pass that is not sold, got %+v", err)
+	}
+	if _, err := purchase(stranger, PassWeekly, 0); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if _, err := purchase(passengerSigner, PassWeekly, weave.AsUnixTime(now.Add(-time.Hour))); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for a pass starting in the past, got %+v", err)
+	}
+	res, err := purchase(passengerSigner, PassWeekly, 0)
+	if err != nil {
+		t.Fatalf("cannot purchase pass: %+v", err)
+	}
+	passKey := res.Data
+	escrow := PassCondition(passKey).Address()
+	assertBalance(t, ctrl, db, passengerSigner.Address(), coin.NewCoin(86, 0, "METR"))
+	assertBalance(t, ctrl, db, escrow, coin.NewCoin(14, 0, "METR"))
+
+	if n := validPasses(passengerSigner.Address(), time.Hour); n != 1 {
+		t.Fatalf("want a valid pass, got %d", n)
+	}
+	if n := validPasses(passengerSigner.Address(), 8*24*time.Hour); n != 0 {
+		t.Fatalf("want no valid pass after expiry, got %d", n)
+	}
+	if n := validPasses(stranger.Address(), time.Hour); n != 0 {
+		t.Fatalf("want no valid pass for another address, got %d", n)
+	}
+
+	// Two of seven days elapsed.
+	if err := release(passKey, 2*24*time.Hour); err != nil {
+		t.Fatalf("cannot release pass payment: %+v", err)
+	}
+	assertBalance(t, ctrl, db, collector, coin.NewCoin(4, 0, "METR"))
+	assertBalance(t, ctrl, db, escrow, coin.NewCoin(10, 0, "METR"))
+	if err := release(passKey, 2*24*time.Hour); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error releasing twice, got %+v", err)
+	}
+
+	if err := cancel(stranger, passKey, 3*24*time.Hour); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	// Three and a half days elapsed, the rest is refunded.
+	if err := cancel(passengerSigner, passKey, 84*time.Hour); err != nil {
+		t.Fatalf("cannot cancel pass: %+v", err)
+	}
+	assertBalance(t, ctrl, db, collector, coin.NewCoin(7, 0, "METR"))
+	assertBalance(t, ctrl, db, passengerSigner.Address(), coin.NewCoin(93, 0, "METR"))
+	if coins, err := ctrl.Balance(db, escrow); err != nil || len(coins) != 0 {
+		t.Fatalf("want empty escrow, got %v, %v", coins, err)
+	}
+
+	var pass Pass
+	if err := NewPassBucket().ByID(db, passKey, &pass); err != nil {
+		t.Fatalf("cannot load pass: %s", err)
+	}
+	if !pass.IsCancelled() || !pass.Released.Equals(coin.NewCoin(7, 0, "METR")) {
+		t.Fatalf("want cancelled pass with the elapsed price released, got %+v", pass)
+	}
+	if n := validPasses(passengerSigner.Address(), 4*24*time.Hour); n != 0 {
+		t.Fatalf("want no valid pass after cancel, got %d", n)
+	}
+	if err := cancel(passengerSigner, passKey, 4*24*time.Hour); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error cancelling twice, got %+v", err)
+	}
+	if err := release(passKey, 7*24*time.Hour); !errors.ErrState.Is(err) {
+		t.Fatalf("want nothing to release after cancel, got %+v", err)
+	}
+}
+
+func TestPassFollowsPassengerAddress(t *testing.T) {
+	collector := weavetest.NewCondition().Address()
+	oldSigner := weavetest.NewCondition()
+	newSigner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	saveAll(t, db, NewPassengerBucket(), &Passenger{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  oldSigner.Address(),
+	})
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Admin:         weavetest.NewCondition().Address(),
+		FareCollector: collector,
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	weekly := coin.NewCoin(14, 0, "METR")
+	if _, err := NewFareTableBucket().Put(db, fareTableKey, &FareTable{
+		Metadata:   &weave.Metadata{Schema: 1},
+		BaseFare:   coin.NewCoin(1, 0, "METR"),
+		WeeklyPass: &weekly,
+	}); err != nil {
+		t.Fatalf("cannot save fare table: %s", err)
+	}
+	ctrl := cash.NewController(cash.NewBucket())
+	if err := ctrl.CoinMint(db, oldSigner.Address(), coin.NewCoin(100, 0, "METR")); err != nil {
+		t.Fatalf("cannot fund passenger: %s", err)
+	}
+
+	now := time.Date(2019, time.June, 3, 8, 0, 0, 0, time.UTC)
+	ctx := weave.WithBlockTime(context.Background(), now)
+	res, err := NewPurchasePassHandler(&weavetest.Auth{Signer: oldSigner}, ctrl).Deliver(ctx, db, &weavetest.Tx{Msg: &PurchasePassMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: weavetest.SequenceID(1),
+		Kind:         PassWeekly,
+	}})
+	if err != nil {
+		t.Fatalf("cannot purchase pass: %+v", err)
+	}
+	passKey := res.Data
+
+	if _, err := NewUpdatePassengerHandler(&weavetest.Auth{Signer: oldSigner}).Deliver(ctx, db, &weavetest.Tx{Msg: &UpdatePassengerMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		PassengerKey: weavetest.SequenceID(1),
+		Address:      newSigner.Address(),
+	}}); err != nil {
+		t.Fatalf("cannot rotate passenger address: %+v", err)
+	}
+
+	validPasses := func(addr weave.Address) int {
+		t.Helper()
+		req := ValidPassRequest{Address: addr, At: weave.AsUnixTime(now.Add(time.Hour))}
+		raw, err := req.Marshal()
+		if err != nil {
+			t.Fatalf("cannot marshal request: %s", err)
+		}
+		res, err := validPassQuery{passes: NewPassBucket()}.Query(db, weave.KeyQueryMod, raw)
+		if err != nil {
+			t.Fatalf("cannot query valid passes: %+v", err)
+		}
+		return len(res)
+	}
+	if n := validPasses(newSigner.Address()); n != 1 {
+		t.Fatalf("want the pass valid for the new address, got %d", n)
+	}
+	if n := validPasses(oldSigner.Address()); n != 0 {
+		t.Fatalf("want no valid pass for the old address, got %d", n)
+	}
+
+	cancel := func(signer weave.Condition) error {
+		h := NewCancelPassHandler(&weavetest.Auth{Signer: signer}, ctrl)
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &CancelPassMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			PassKey:  passKey,
+		}})
+		return err
+	}
+	if err := cancel(oldSigner); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want old address to be no longer authorized, got %+v", err)
+	}
+	if err := cancel(newSigner); err != nil {
+		t.Fatalf("cannot cancel pass: %+v", err)
+	}
+	assertBalance(t, ctrl, db, newSigner.Address(), coin.NewCoin(14, 0, "METR"))
+}
@@ -0,0 +1,116 @@
+package metro
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// moveTrain updates the position of a train that arrived at given station
+// as reported by the arrival event of given key.
+// The line of a matched scheduled stop is preferred when telling the
+// direction of travel, otherwise any line visiting both of the last two
+// stations is used.
+func moveTrain(db weave.KVStore, positions orm.ModelBucket, lines orm.SerialModelBucket, arrivalKey, trainKey, stationKey, lineKey []byte, now weave.UnixTime) (*TrainPosition, error) {
+	var pos TrainPosition
+	switch err := positions.One(db, trainKey, &pos); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		pos = TrainPosition{
+			Metadata: &weave.Metadata{Schema: 1},
+			TrainKey: trainKey,
+		}
+	default:
+		return nil, errors.Wrap(err, "cannot load train position")
+	}
+
+	if !bytes.Equal(pos.StationKey, stationKey) {
+		pos.PreviousStationKey = pos.StationKey
+		pos.StationKey = stationKey
+	}
+	pos.ArrivalKey = arrivalKey
+	pos.ArrivedAt = now
+	pos.DepartedAt = 0
+
+	line, err := travelledLine(db, lines, lineKey, pos.PreviousStationKey, pos.StationKey)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case line != nil:
+		pos.LineKey = line.PrimaryKey
+		pos.Direction = impliedDirection(line, pos.PreviousStationKey, pos.StationKey)
+	case len(lineKey) != 0:
+		pos.LineKey = lineKey
+		pos.Direction = DirectionInvalid
+	default:
+		pos.LineKey = nil
+		pos.Direction = DirectionInvalid
+	}
+
+	if _, err := positions.Put(db, trainKey, &pos); err != nil {
+		return nil, errors.Wrap(err, "cannot store train position")
+	}
+	return &pos, nil
+}
+
+// travelledLine returns the line that visits both given stations, or nil if
+// there is none. The line of given key is checked first.
+func travelledLine(db weave.ReadOnlyKVStore, lines orm.SerialModelBucket, lineKey, from, to []byte) (*Line, error) {
+	if len(from) == 0 {
+		return nil, nil
+	}
+	if len(lineKey) != 0 {
+		var line Line
+		if err := lines.ByID(db, lineKey, &line); err != nil {
+			return nil, errors.Wrap(err, "cannot load line")
+		}
+		if line.HasStation(from) && line.HasStation(to) {
+			return &line, nil
+		}
+	}
+
+	it, err := lines.PrefixScan(db, nil, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot scan lines")
+	}
+	defer it.Release()
+	for {
+		var line Line
+		switch err := it.LoadNext(&line); {
+		case err == nil:
+		case errors.ErrIteratorDone.Is(err):
+			return nil, nil
+		default:
+			return nil, errors.Wrap(err, "cannot load line")
+		}
+		if line.HasStation(from) && line.HasStation(to) {
+			return &line, nil
+		}
+	}
+}
+
+// impliedDirection returns the direction of travel between two stations of
+// given line. Travelling in the order the line lists its stations is
+// outbound.
+func impliedDirection(line *Line, from, to []byte) Direction {
+	fromIdx, toIdx := -1, -1
+	for i, k := range line.StationKeys {
+		switch {
+		case bytes.Equal(k, from):
+			fromIdx = i
+		case bytes.Equal(k, to):
+			toIdx = i
+		}
+	}
+	switch {
+	case fromIdx < 0 || toIdx < 0:
+		return DirectionInvalid
+	case fromIdx < toIdx:
+		return DirectionOutbound
+	default:
+		return DirectionInbound
+	}
+}
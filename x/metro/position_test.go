@@ -0,0 +1,101 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestTrainPosition(t *testing.T) {
+	firstSigner := weavetest.NewCondition()
+	secondSigner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "c"},
+	)
+	saveAll(t, db, NewTrainBucket(),
+		&Train{Metadata: &weave.Metadata{Schema: 1}, Address: firstSigner.Address()},
+		&Train{Metadata: &weave.Metadata{Schema: 1}, Address: secondSigner.Address()},
+	)
+	saveAll(t, db, NewLineBucket(), &Line{
+		Metadata: &weave.Metadata{Schema: 1},
+		Name:     "M1",
+		Color:    "#e30613",
+		StationKeys: [][]byte{
+			weavetest.SequenceID(1),
+			weavetest.SequenceID(2),
+			weavetest.SequenceID(3),
+		},
+	})
+
+	now := time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)
+	arrive := func(signer weave.Condition, train, station uint64) {
+		t.Helper()
+		now = now.Add(2 * time.Minute)
+		ctx := weave.WithBlockTime(context.Background(), now)
+		h := NewTrainArriveStationEventHandler(&weavetest.Auth{Signer: signer}, &weavetest.Cron{})
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &TrainArriveStationEventMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			StationKey: weavetest.SequenceID(station),
+			TrainKey:   weavetest.SequenceID(train),
+		}})
+		if err != nil {
+			t.Fatalf("cannot arrive: %+v", err)
+		}
+	}
+	position := func(train uint64) TrainPosition {
+		t.Helper()
+		var pos TrainPosition
+		if err := NewTrainPositionBucket().One(db, weavetest.SequenceID(train), &pos); err != nil {
+			t.Fatalf("cannot load position: %s", err)
+		}
+		return pos
+	}
+
+	arrive(firstSigner, 1, 1)
+	if pos := position(1); len(pos.PreviousStationKey) != 0 || pos.Direction != DirectionInvalid {
+		t.Fatalf("first arrival cannot imply direction: %+v", pos)
+	}
+
+	arrive(firstSigner, 1, 2)
+	pos := position(1)
+	if string(pos.StationKey) != string(weavetest.SequenceID(2)) ||
+		string(pos.PreviousStationKey) != string(weavetest.SequenceID(1)) {
+		t.Fatalf("unexpected stations: %+v", pos)
+	}
+	if pos.Direction != DirectionOutbound || string(pos.LineKey) != string(weavetest.SequenceID(1)) {
+		t.Fatalf("want outbound on the line, got %+v", pos)
+	}
+	if !pos.ArrivedAt.Time().Equal(now) {
+		t.Fatalf("want arrival at %s, got %s", now, pos.ArrivedAt.Time())
+	}
+
+	arrive(secondSigner, 2, 3)
+	arrive(secondSigner, 2, 2)
+	if pos := position(2); pos.Direction != DirectionInbound {
+		t.Fatalf("want inbound, got %+v", pos)
+	}
+
+	// Reporting the same station again keeps the direction.
+	arrive(secondSigner, 2, 2)
+	if pos := position(2); pos.Direction != DirectionInbound || string(pos.PreviousStationKey) != string(weavetest.SequenceID(3)) {
+		t.Fatalf("repeated arrival must not change the position: %+v", pos)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	models, err := qr.Handler("/positions").Query(db, weave.PrefixQueryMod, nil)
+	if err != nil {
+		t.Fatalf("cannot query positions: %+v", err)
+	}
+	if len(models) != 2 {
+		t.Fatalf("want positions of both trains, got %d", len(models))
+	}
+}
@@ -0,0 +1,169 @@
+package metro
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// routeQuery handles the /routes query path. Query data must be a serialized
+// RouteRequest.
+type routeQuery struct {
+	stations    orm.SerialModelBucket
+	connections orm.SerialModelBucket
+}
+
+var _ weave.QueryHandler = routeQuery{}
+
+func (q routeQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrInput, "only key queries are supported")
+	}
+	var req RouteRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal request")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+	route, err := planRoute(db, q.stations, q.connections, &req)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := route.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal route")
+	}
+	return []weave.Model{weave.Pair(data, raw)}, nil
+}
+
+// Validate ensures the RouteRequest is valid
+func (r *RouteRequest) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "FromStationKey", orm.ValidateSequence(r.FromStationKey))
+	errs = errors.AppendField(errs, "ToStationKey", orm.ValidateSequence(r.ToStationKey))
+
+	return errs
+}
+
+// planRoute returns the fastest route between two stations. Retired stations
+// are never used. Accessible routes also avoid stations without an elevator
+// or an island platform.
+func planRoute(db weave.ReadOnlyKVStore, stations, connections orm.SerialModelBucket, req *RouteRequest) (*Route, error) {
+	usable := make(map[string]bool)
+	isUsable := func(key string) (bool, error) {
+		if ok, seen := usable[key]; seen {
+			return ok, nil
+		}
+		var s Station
+		if err := stations.ByID(db, []byte(key), &s); err != nil {
+			return false, errors.Wrapf(err, "cannot load station %x", key)
+		}
+		ok := !s.IsRetired() && (!req.Accessible || s.IsAccessible())
+		usable[key] = ok
+		return ok, nil
+	}
+
+	from, to := string(req.FromStationKey), string(req.ToStationKey)
+	for _, key := range []string{from, to} {
+		switch ok, err := isUsable(key); {
+		case err != nil:
+			return nil, err
+		case !ok:
+			return nil, errors.Wrapf(errors.ErrInput, "station %x cannot be used", key)
+		}
+	}
+
+	graph, err := loadConnections(db, connections)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dijkstra's algorithm. The network is small enough to pick the closest
+	// station with a linear scan.
+	minutes := map[string]uint32{from: 0}
+	previous := make(map[string]string)
+	done := make(map[string]bool)
+	for {
+		current, found := "", false
+		for key, m := range minutes {
+			if done[key] {
+				continue
+			}
+			if !found || m < minutes[current] {
+				current, found = key, true
+			}
+		}
+		if !found {
+			return nil, errors.Wrap(errors.ErrNotFound, "no route")
+		}
+		if current == to {
+			break
+		}
+		done[current] = true
+
+		for _, e := range graph[current] {
+			if done[e.station] {
+				continue
+			}
+			ok, err := isUsable(e.station)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			m := minutes[current] + e.minutes
+			if known, seen := minutes[e.station]; !seen || m < known {
+				minutes[e.station] = m
+				previous[e.station] = current
+			}
+		}
+	}
+
+	var path []string
+	for key := to; key != from; key = previous[key] {
+		path = append(path, key)
+	}
+	path = append(path, from)
+
+	route := &Route{TotalMinutes: minutes[to]}
+	for i := len(path) - 1; i >= 0; i-- {
+		route.Hops = append(route.Hops, RouteHop{
+			StationKey: []byte(path[i]),
+			Minutes:    minutes[path[i]],
+		})
+	}
+	return route, nil
+}
+
+type routeEdge struct {
+	station string
+	minutes uint32
+}
+
+// loadConnections returns the station adjacency list built from all
+// connections.
+func loadConnections(db weave.ReadOnlyKVStore, connections orm.SerialModelBucket) (map[string][]routeEdge, error) {
+	it, err := connections.PrefixScan(db, nil, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot scan connections")
+	}
+	defer it.Release()
+
+	graph := make(map[string][]routeEdge)
+	for {
+		var c Connection
+		switch err := it.LoadNext(&c); {
+		case err == nil:
+		case errors.ErrIteratorDone.Is(err):
+			return graph, nil
+		default:
+			return nil, errors.Wrap(err, "cannot load connection")
+		}
+		a, b := string(c.FromStationKey), string(c.ToStationKey)
+		graph[a] = append(graph[a], routeEdge{station: b, minutes: c.TravelMinutes})
+		graph[b] = append(graph[b], routeEdge{station: a, minutes: c.TravelMinutes})
+	}
+}
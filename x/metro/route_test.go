@@ -0,0 +1,135 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestPlanRoute(t *testing.T) {
+	admin := weavetest.NewCondition()
+
+	db := store.MemStore()
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Admin:    admin.Address(),
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a", Elevator: 1, IsPeronAda: true},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "c", Elevator: 2, IsPeronAda: true},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "d", Elevator: 1, IsPeronAda: true},
+	)
+
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	h := NewSetConnectionHandler(&weavetest.Auth{Signer: admin})
+	connect := func(from, to uint64, minutes uint32) {
+		t.Helper()
+		_, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: &SetConnectionMsg{
+			Metadata:       &weave.Metadata{Schema: 1},
+			FromStationKey: weavetest.SequenceID(from),
+			ToStationKey:   weavetest.SequenceID(to),
+			TravelMinutes:  minutes,
+		}})
+		if err != nil {
+			t.Fatalf("cannot connect %d and %d: %+v", from, to, err)
+		}
+	}
+	connect(1, 2, 2)
+	connect(2, 4, 9)
+	connect(1, 3, 3)
+	connect(3, 4, 3)
+	// Updating a connection works in both directions.
+	connect(4, 2, 2)
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	plan := func(from, to uint64, accessible bool) (*Route, error) {
+		req := RouteRequest{
+			FromStationKey: weavetest.SequenceID(from),
+			ToStationKey:   weavetest.SequenceID(to),
+			Accessible:     accessible,
+		}
+		raw, err := req.Marshal()
+		if err != nil {
+			t.Fatalf("cannot marshal request: %s", err)
+		}
+		models, err := qr.Handler("/routes").Query(db, weave.KeyQueryMod, raw)
+		if err != nil {
+			return nil, err
+		}
+		var route Route
+		if err := route.Unmarshal(models[0].Value); err != nil {
+			t.Fatalf("cannot unmarshal route: %s", err)
+		}
+		return &route, nil
+	}
+
+	cases := map[string]struct {
+		from, to    uint64
+		accessible  bool
+		wantErr     *errors.Error
+		wantHops    []uint64
+		wantMinutes uint32
+	}{
+		"fastest route": {
+			from: 1, to: 4,
+			wantHops:    []uint64{1, 2, 4},
+			wantMinutes: 4,
+		},
+		"accessible route avoids stations without an elevator": {
+			from: 1, to: 4, accessible: true,
+			wantHops:    []uint64{1, 3, 4},
+			wantMinutes: 6,
+		},
+		"same station": {
+			from: 3, to: 3,
+			wantHops: []uint64{3},
+		},
+		"inaccessible destination": {
+			from: 1, to: 2, accessible: true,
+			wantErr: errors.ErrInput,
+		},
+		"unknown station": {
+			from: 1, to: 9,
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			route, err := plan(tc.from, tc.to, tc.accessible)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("want %v error, got %+v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if route.TotalMinutes != tc.wantMinutes {
+				t.Errorf("want %d minutes, got %d", tc.wantMinutes, route.TotalMinutes)
+			}
+			if len(route.Hops) != len(tc.wantHops) {
+				t.Fatalf("want %d hops, got %d", len(tc.wantHops), len(route.Hops))
+			}
+			for i, hop := range route.Hops {
+				if string(hop.StationKey) != string(weavetest.SequenceID(tc.wantHops[i])) {
+					t.Errorf("hop %d: want station %d, got %x", i, tc.wantHops[i], hop.StationKey)
+				}
+			}
+		})
+	}
+
+	// Removing the only accessible connection leaves no accessible route.
+	connect(3, 4, 0)
+	if _, err := plan(1, 4, true); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want no route, got %+v", err)
+	}
+}
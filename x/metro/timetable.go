@@ -0,0 +1,170 @@
+package metro
+
+import (
+	"sort"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+const (
+	minutesPerDay = 24 * 60
+
+	// maxDepartures limits the number of departures a single query can
+	// return.
+	maxDepartures = 50
+	// defaultDepartures is returned when the query does not set the limit.
+	defaultDepartures = 5
+	// departuresLookahead is the number of days searched for departures.
+	departuresLookahead = 7
+)
+
+// servicePatternOf returns the service pattern that applies to the day of
+// given time. Sundays are served by the holiday timetable.
+func servicePatternOf(t time.Time) ServicePattern {
+	switch t.UTC().Weekday() {
+	case time.Saturday:
+		return ServiceSaturday
+	case time.Sunday:
+		return ServiceHoliday
+	default:
+		return ServiceWeekday
+	}
+}
+
+// requireLineStops ensures all stops of a timetable are stations of given
+// line.
+func requireLineStops(line *Line, stops []TimetableStop) error {
+	for _, stop := range stops {
+		if !line.HasStation(stop.StationKey) {
+			return errors.Wrapf(errors.ErrInput, "station %x is not on the line", stop.StationKey)
+		}
+	}
+	return nil
+}
+
+// departuresQuery handles the /departures query path. Query data must be a
+// serialized DeparturesRequest.
+type departuresQuery struct {
+	timetables orm.SerialModelBucket
+}
+
+var _ weave.QueryHandler = departuresQuery{}
+
+func (q departuresQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrInput, "only key queries are supported")
+	}
+	var req DeparturesRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal request")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultDepartures
+	}
+	deps, err := nextDepartures(db, q.timetables, req.StationKey, req.At.Time(), limit)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := (&Departures{Departures: deps}).Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal departures")
+	}
+	return []weave.Model{weave.Pair(data, raw)}, nil
+}
+
+// Validate ensures the DeparturesRequest is valid
+func (r *DeparturesRequest) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "StationKey", orm.ValidateSequence(r.StationKey))
+	errs = errors.AppendField(errs, "At", r.At.Validate())
+	if r.Limit > maxDepartures {
+		errs = errors.AppendField(errs, "Limit", errors.Wrapf(errors.ErrInput, "must not exceed %d", maxDepartures))
+	}
+
+	return errs
+}
+
+// nextDepartures returns up to limit departures from given station, scheduled
+// at or after given time by the active timetables.
+func nextDepartures(db weave.ReadOnlyKVStore, timetables orm.SerialModelBucket, stationKey []byte, at time.Time, limit int) ([]Departure, error) {
+	byService, err := loadStationTimetables(db, timetables, stationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	at = at.UTC()
+	midnight := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	var res []Departure
+	for day := 0; day < departuresLookahead && len(res) < limit; day++ {
+		date := midnight.AddDate(0, 0, day)
+		var today []Departure
+		for _, s := range byService[servicePatternOf(date)] {
+			for _, minute := range s.departures {
+				departsAt := date.Add(time.Duration(minute) * time.Minute)
+				if departsAt.Before(at) {
+					continue
+				}
+				today = append(today, Departure{
+					LineKey:   s.timetable.LineKey,
+					Direction: s.timetable.Direction,
+					DepartsAt: weave.AsUnixTime(departsAt),
+				})
+			}
+		}
+		sort.SliceStable(today, func(i, j int) bool {
+			return today[i].DepartsAt < today[j].DepartsAt
+		})
+		res = append(res, today...)
+	}
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+type stationSchedule struct {
+	timetable  *Timetable
+	departures []uint32
+}
+
+// loadStationTimetables returns departures from given station of all active
+// timetables, grouped by service pattern.
+func loadStationTimetables(db weave.ReadOnlyKVStore, timetables orm.SerialModelBucket, stationKey []byte) (map[ServicePattern][]stationSchedule, error) {
+	it, err := timetables.PrefixScan(db, nil, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot scan timetables")
+	}
+	defer it.Release()
+
+	res := make(map[ServicePattern][]stationSchedule)
+	for {
+		var t Timetable
+		switch err := it.LoadNext(&t); {
+		case err == nil:
+		case errors.ErrIteratorDone.Is(err):
+			return res, nil
+		default:
+			return nil, errors.Wrap(err, "cannot load timetable")
+		}
+		if !t.IsActive() {
+			continue
+		}
+		for _, stop := range t.Stops {
+			if string(stop.StationKey) == string(stationKey) {
+				tt := t
+				res[t.Service] = append(res[t.Service], stationSchedule{
+					timetable:  &tt,
+					departures: stop.Departures,
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package metro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestTimetables(t *testing.T) {
+	admin := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	db := store.MemStore()
+	if err := gconf.Save(db, "metro", &Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Admin:    admin.Address(),
+	}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	saveAll(t, db, NewStationBucket(),
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "a"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "b"},
+		&Station{Metadata: &weave.Metadata{Schema: 1}, Station: "c"},
+	)
+	saveAll(t, db, NewLineBucket(),
+		&Line{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Name:        "M1",
+			Color:       "#e30613",
+			StationKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+		},
+	)
+
+	// Friday evening.
+	now := time.Date(2024, time.January, 5, 23, 0, 0, 0, time.UTC)
+	ctx := weave.WithBlockTime(context.Background(), now)
+	publish := NewPublishTimetableHandler(&weavetest.Auth{Signer: admin})
+	supersede := NewSupersedeTimetableHandler(&weavetest.Auth{Signer: admin})
+
+	stops := func(departures ...uint32) []TimetableStop {
+		return []TimetableStop{
+			{StationKey: weavetest.SequenceID(1), Departures: departures},
+			{StationKey: weavetest.SequenceID(2), Departures: []uint32{10, 20}},
+		}
+	}
+	publishMsg := func(service ServicePattern, stops []TimetableStop) *PublishTimetableMsg {
+		return &PublishTimetableMsg{
+			Metadata:  &weave.Metadata{Schema: 1},
+			LineKey:   weavetest.SequenceID(1),
+			Service:   service,
+			Direction: DirectionOutbound,
+			Stops:     stops,
+		}
+	}
+
+	res, err := publish.Deliver(ctx, db, &weavetest.Tx{Msg: publishMsg(ServiceWeekday, stops(6*60, 22*60))})
+	if err != nil {
+		t.Fatalf("cannot publish timetable: %+v", err)
+	}
+	weekdayKey := res.Data
+	if _, err := publish.Deliver(ctx, db, &weavetest.Tx{Msg: publishMsg(ServiceSaturday, stops(8*60))}); err != nil {
+		t.Fatalf("cannot publish timetable: %+v", err)
+	}
+
+	_, err = publish.Deliver(ctx, db, &weavetest.Tx{Msg: publishMsg(ServiceWeekday, stops(7*60))})
+	if !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+	offLine := []TimetableStop{{StationKey: weavetest.SequenceID(3), Departures: []uint32{60}}}
+	_, err = publish.Deliver(ctx, db, &weavetest.Tx{Msg: publishMsg(ServiceHoliday, offLine)})
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+	_, err = NewPublishTimetableHandler(&weavetest.Auth{Signer: stranger}).Deliver(ctx, db, &weavetest.Tx{Msg: publishMsg(ServiceHoliday, stops(60))})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	supersedeMsg := &SupersedeTimetableMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		TimetableKey: weekdayKey,
+		Stops:        stops(6*60, 23*60+30),
+	}
+	res, err = supersede.Deliver(ctx, db, &weavetest.Tx{Msg: supersedeMsg})
+	if err != nil {
+		t.Fatalf("cannot supersede timetable: %+v", err)
+	}
+	var old Timetable
+	if err := NewTimetableBucket().ByID(db, weekdayKey, &old); err != nil {
+		t.Fatalf("cannot load superseded timetable: %s", err)
+	}
+	if old.IsActive() || string(old.SupersededBy) != string(res.Data) {
+		t.Fatalf("timetable not superseded: %+v", old)
+	}
+	_, err = supersede.Deliver(ctx, db, &weavetest.Tx{Msg: supersedeMsg})
+	if !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	raw, err := (&DeparturesRequest{
+		StationKey: weavetest.SequenceID(1),
+		At:         weave.AsUnixTime(now),
+		Limit:      3,
+	}).Marshal()
+	if err != nil {
+		t.Fatalf("cannot marshal request: %s", err)
+	}
+	models, err := qr.Handler("/departures").Query(db, weave.KeyQueryMod, raw)
+	if err != nil {
+		t.Fatalf("cannot query departures: %+v", err)
+	}
+	var deps Departures
+	if err := deps.Unmarshal(models[0].Value); err != nil {
+		t.Fatalf("cannot unmarshal departures: %s", err)
+	}
+
+	// Sunday is served by the holiday timetable that was never published.
+	want := []time.Time{
+		time.Date(2024, time.January, 5, 23, 30, 0, 0, time.UTC),
+		time.Date(2024, time.January, 6, 8, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 8, 6, 0, 0, 0, time.UTC),
+	}
+	if len(deps.Departures) != len(want) {
+		t.Fatalf("want %d departures, got %+v", len(want), deps.Departures)
+	}
+	for i, d := range deps.Departures {
+		if !d.DepartsAt.Time().Equal(want[i]) {
+			t.Errorf("departure %d: want %s, got %s", i, want[i], d.DepartsAt.Time())
+		}
+		if string(d.LineKey) != string(weavetest.SequenceID(1)) || d.Direction != DirectionOutbound {
+			t.Errorf("departure %d: unexpected line or direction: %+v", i, d)
+		}
+	}
+}
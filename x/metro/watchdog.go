@@ -0,0 +1,52 @@
+package metro
+
+import (
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// WatchdogCondition returns the condition that authorizes marking given
+// train as silent. It is only ever granted to scheduled cron tasks, so a
+// transaction cannot mark a train silent.
+func WatchdogCondition(trainKey []byte) weave.Condition {
+	return weave.NewCondition(packageName, "watchdog", trainKey)
+}
+
+// rearmWatchdog clears the silent flag of a train that reported an arrival
+// and replaces its pending watchdog task with one due after given silence
+// period. A zero silence period disables the watchdog.
+func rearmWatchdog(db weave.KVStore, scheduler weave.Scheduler, trains orm.SerialModelBucket, trainKey []byte, silence time.Duration, now time.Time) error {
+	var train Train
+	if err := trains.ByID(db, trainKey, &train); err != nil {
+		return errors.Wrap(err, "cannot load train")
+	}
+
+	if len(train.WatchdogTaskID) != 0 {
+		if err := scheduler.Delete(db, train.WatchdogTaskID); err != nil && !errors.ErrNotFound.Is(err) {
+			return errors.Wrap(err, "cannot cancel watchdog task")
+		}
+		train.WatchdogTaskID = nil
+	}
+	train.SilentSince = 0
+
+	if silence > 0 {
+		msg := &MarkTrainSilentMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			TrainKey: trainKey,
+		}
+		auth := []weave.Condition{WatchdogCondition(trainKey)}
+		taskID, err := scheduler.Schedule(db, now.Add(silence), auth, msg)
+		if err != nil {
+			return errors.Wrap(err, "cannot schedule watchdog task")
+		}
+		train.WatchdogTaskID = taskID
+	}
+
+	if err := trains.Save(db, &train); err != nil {
+		return errors.Wrap(err, "cannot store train")
+	}
+	return nil
+}